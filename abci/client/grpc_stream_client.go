@@ -0,0 +1,478 @@
+package abcicli
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+var _ Client = (*grpcStreamClient)(nil)
+
+// grpcStreamClient sends requests over one or more ABCIStream streams, which
+// share a single gRPC connection.
+//
+// CheckTx, Query, Info and Echo requests go over the least busy stream, so up
+// to `concurrency` of them can be processed by the app at once. All other
+// requests go over the first stream, in order. Whichever stream a request
+// takes, callbacks are called in the order the requests were made, like with
+// the socket client.
+type grpcStreamClient struct {
+	service.BaseService
+
+	addr        string
+	mustConnect bool
+	concurrency int
+
+	conn    *grpc.ClientConn
+	cancel  context.CancelFunc
+	streams []*abciStream
+
+	mtx     sync.Mutex
+	err     error
+	reqSent *list.List // requests made, waiting for their callbacks to be called
+	resCb   Callback   // called on all requests, if set.
+}
+
+// abciStream is one of the streams of a grpcStreamClient.
+type abciStream struct {
+	stream types.ABCIStream_StreamClient
+
+	sendMtx sync.Mutex // serialises writes to stream
+	sent    *list.List // requests sent, waiting for a response; guarded by the client's mtx
+}
+
+// streamReqRes is a ReqRes with a flag telling whether waiters were released,
+// which is done as soon as the response is received.
+type streamReqRes struct {
+	*ReqRes
+	released bool
+}
+
+// NewGRPCStreamClient returns a client opening concurrency streams to the
+// server at addr.
+func NewGRPCStreamClient(addr string, mustConnect bool, concurrency int) *grpcStreamClient {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	cli := &grpcStreamClient{
+		addr:        addr,
+		mustConnect: mustConnect,
+		concurrency: concurrency,
+		reqSent:     list.New(),
+	}
+	cli.BaseService = *service.NewBaseService(nil, "grpcStreamClient", cli)
+	return cli
+}
+
+func (cli *grpcStreamClient) OnStart() error {
+	if err := cli.BaseService.OnStart(); err != nil {
+		return err
+	}
+RETRY_LOOP:
+	for {
+		conn, err := grpc.Dial(cli.addr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
+		if err == nil {
+			cli.Logger.Info("Dialed server. Opening streams.", "addr", cli.addr, "streams", cli.concurrency)
+			err = cli.openStreams(conn)
+			if err != nil {
+				conn.Close()
+			}
+		}
+		if err != nil {
+			if cli.mustConnect {
+				return err
+			}
+			cli.Logger.Error(fmt.Sprintf("abci.grpcStreamClient failed to connect to %v.  Retrying...", cli.addr), "err", err)
+			time.Sleep(time.Second * dialRetryIntervalSeconds)
+			continue RETRY_LOOP
+		}
+
+		cli.conn = conn
+		for _, s := range cli.streams {
+			go cli.recvResponsesRoutine(s)
+		}
+		return nil
+	}
+}
+
+// openStreams opens the streams on conn. Unless the client must connect, it
+// waits for the server to be ready.
+func (cli *grpcStreamClient) openStreams(conn *grpc.ClientConn) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := types.NewABCIStreamClient(conn)
+	streams := make([]*abciStream, cli.concurrency)
+	for i := range streams {
+		stream, err := client.Stream(ctx, grpc.WaitForReady(!cli.mustConnect))
+		if err != nil {
+			cancel()
+			return err
+		}
+		streams[i] = &abciStream{stream: stream, sent: list.New()}
+	}
+	cli.cancel = cancel
+	cli.streams = streams
+	return nil
+}
+
+func (cli *grpcStreamClient) OnStop() {
+	cli.BaseService.OnStop()
+
+	if cli.cancel != nil {
+		cli.cancel()
+	}
+	if cli.conn != nil {
+		cli.conn.Close()
+	}
+
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	// Requests which are still pending get an error rather than no response.
+	if cli.err == nil {
+		cli.err = errors.New("client is stopped")
+	}
+	for e := cli.reqSent.Front(); e != nil; e = e.Next() {
+		cli.release(e.Value.(*streamReqRes))
+	}
+	cli.reqSent.Init()
+}
+
+// Stop the client and set the error
+func (cli *grpcStreamClient) StopForError(err error) {
+	if !cli.IsRunning() {
+		return
+	}
+
+	cli.mtx.Lock()
+	if cli.err == nil {
+		cli.err = err
+	}
+	cli.mtx.Unlock()
+
+	cli.Logger.Error(fmt.Sprintf("Stopping abci.grpcStreamClient for error: %v", err.Error()))
+	cli.Stop()
+}
+
+func (cli *grpcStreamClient) Error() error {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	return cli.err
+}
+
+// Set listener for all responses
+// NOTE: callback may get internally generated flush responses.
+func (cli *grpcStreamClient) SetResponseCallback(resCb Callback) {
+	cli.mtx.Lock()
+	cli.resCb = resCb
+	cli.mtx.Unlock()
+}
+
+//----------------------------------------
+
+func (cli *grpcStreamClient) queueRequest(req *types.Request) *ReqRes {
+	reqres := &streamReqRes{ReqRes: NewReqRes(req)}
+
+	cli.mtx.Lock()
+	if cli.err != nil || !cli.IsRunning() {
+		cli.release(reqres)
+		cli.mtx.Unlock()
+		return reqres.ReqRes
+	}
+	cli.reqSent.PushBack(reqres)
+	if _, ok := req.Value.(*types.Request_Flush); ok {
+		// There's nothing to flush: the response is ready once all the
+		// requests before it got theirs.
+		reqres.Response = types.ToResponseFlush()
+		cli.notifyCallbacks()
+		cli.mtx.Unlock()
+		return reqres.ReqRes
+	}
+	s := cli.pickStream(req)
+	cli.mtx.Unlock()
+
+	// Requests must be written to the stream in the order they're added to
+	// s.sent, but the client mutex must not be held while writing, as that
+	// may block until the server reads them.
+	s.sendMtx.Lock()
+	cli.mtx.Lock()
+	s.sent.PushBack(reqres)
+	cli.mtx.Unlock()
+	err := s.stream.Send(req)
+	s.sendMtx.Unlock()
+	if err != nil {
+		cli.StopForError(fmt.Errorf("error sending request: %v", err))
+	}
+
+	return reqres.ReqRes
+}
+
+// pickStream returns the stream to send req over. cli.mtx must be held.
+func (cli *grpcStreamClient) pickStream(req *types.Request) *abciStream {
+	switch req.Value.(type) {
+	case *types.Request_CheckTx, *types.Request_Query, *types.Request_Info, *types.Request_Echo:
+		least := cli.streams[0]
+		for _, s := range cli.streams[1:] {
+			if s.sent.Len() < least.sent.Len() {
+				least = s
+			}
+		}
+		return least
+	default:
+		return cli.streams[0]
+	}
+}
+
+func (cli *grpcStreamClient) recvResponsesRoutine(s *abciStream) {
+	for {
+		res, err := s.stream.Recv()
+		if err != nil {
+			cli.StopForError(err)
+			return
+		}
+		switch r := res.Value.(type) {
+		case *types.Response_Exception:
+			cli.StopForError(errors.New(r.Exception.Error))
+			return
+		default:
+			if err := cli.didRecvResponse(s, res); err != nil {
+				cli.StopForError(err)
+				return
+			}
+		}
+	}
+}
+
+func (cli *grpcStreamClient) didRecvResponse(s *abciStream, res *types.Response) error {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	next := s.sent.Front()
+	if next == nil {
+		return fmt.Errorf("unexpected result type %v when nothing expected", reflect.TypeOf(res.Value))
+	}
+	reqres := next.Value.(*streamReqRes)
+	if !resMatchesReq(reqres.Request, res) {
+		return fmt.Errorf("unexpected result type %v when response to %v expected",
+			reflect.TypeOf(res.Value), reflect.TypeOf(reqres.Request.Value))
+	}
+	s.sent.Remove(next)
+	if reqres.released {
+		// The client was stopped in the meantime.
+		return nil
+	}
+
+	reqres.Response = res
+	cli.release(reqres)
+	cli.notifyCallbacks()
+	return nil
+}
+
+// notifyCallbacks calls the callbacks of the requests at the front of
+// cli.reqSent which got their response. cli.mtx must be held.
+func (cli *grpcStreamClient) notifyCallbacks() {
+	for next := cli.reqSent.Front(); next != nil; next = cli.reqSent.Front() {
+		reqres := next.Value.(*streamReqRes)
+		if reqres.Response == nil {
+			return
+		}
+		cli.reqSent.Remove(next)
+		cli.release(reqres) // flush requests are released here
+
+		// Notify client listener if set (global callback).
+		if cli.resCb != nil {
+			cli.resCb(reqres.Request, reqres.Response)
+		}
+
+		// Notify reqRes listener if set (request specific callback). If it
+		// gets set later on, SetCallback calls it right away.
+		reqres.SetDone()
+		if cb := reqres.GetCallback(); cb != nil {
+			cb(reqres.Response)
+		}
+	}
+}
+
+// release releases the waiters of reqres, if not done yet. cli.mtx must be
+// held.
+func (cli *grpcStreamClient) release(reqres *streamReqRes) {
+	if !reqres.released {
+		reqres.released = true
+		reqres.Done()
+	}
+}
+
+//----------------------------------------
+
+func (cli *grpcStreamClient) EchoAsync(msg string) *ReqRes {
+	return cli.queueRequest(types.ToRequestEcho(msg))
+}
+
+func (cli *grpcStreamClient) FlushAsync() *ReqRes {
+	return cli.queueRequest(types.ToRequestFlush())
+}
+
+func (cli *grpcStreamClient) InfoAsync(req types.RequestInfo) *ReqRes {
+	return cli.queueRequest(types.ToRequestInfo(req))
+}
+
+func (cli *grpcStreamClient) SetOptionAsync(req types.RequestSetOption) *ReqRes {
+	return cli.queueRequest(types.ToRequestSetOption(req))
+}
+
+func (cli *grpcStreamClient) DeliverTxAsync(req types.RequestDeliverTx) *ReqRes {
+	return cli.queueRequest(types.ToRequestDeliverTx(req))
+}
+
+func (cli *grpcStreamClient) CheckTxAsync(req types.RequestCheckTx) *ReqRes {
+	return cli.queueRequest(types.ToRequestCheckTx(req))
+}
+
+func (cli *grpcStreamClient) QueryAsync(req types.RequestQuery) *ReqRes {
+	return cli.queueRequest(types.ToRequestQuery(req))
+}
+
+func (cli *grpcStreamClient) CommitAsync() *ReqRes {
+	return cli.queueRequest(types.ToRequestCommit())
+}
+
+func (cli *grpcStreamClient) InitChainAsync(req types.RequestInitChain) *ReqRes {
+	return cli.queueRequest(types.ToRequestInitChain(req))
+}
+
+func (cli *grpcStreamClient) BeginBlockAsync(req types.RequestBeginBlock) *ReqRes {
+	return cli.queueRequest(types.ToRequestBeginBlock(req))
+}
+
+func (cli *grpcStreamClient) EndBlockAsync(req types.RequestEndBlock) *ReqRes {
+	return cli.queueRequest(types.ToRequestEndBlock(req))
+}
+
+func (cli *grpcStreamClient) PrepareProposalAsync(req types.RequestPrepareProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestPrepareProposal(req))
+}
+
+func (cli *grpcStreamClient) ProcessProposalAsync(req types.RequestProcessProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestProcessProposal(req))
+}
+
+func (cli *grpcStreamClient) ExtendVoteAsync(req types.RequestExtendVote) *ReqRes {
+	return cli.queueRequest(types.ToRequestExtendVote(req))
+}
+
+func (cli *grpcStreamClient) VerifyVoteExtensionAsync(req types.RequestVerifyVoteExtension) *ReqRes {
+	return cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+}
+
+func (cli *grpcStreamClient) ExportStateAsync(req types.RequestExportState) *ReqRes {
+	return cli.queueRequest(types.ToRequestExportState(req))
+}
+
+//----------------------------------------
+
+func (cli *grpcStreamClient) FlushSync() error {
+	cli.queueRequest(types.ToRequestFlush()).Wait()
+	return cli.Error()
+}
+
+func (cli *grpcStreamClient) EchoSync(msg string) (*types.ResponseEcho, error) {
+	reqres := cli.queueRequest(types.ToRequestEcho(msg))
+	reqres.Wait()
+	return reqres.Response.GetEcho(), cli.Error()
+}
+
+func (cli *grpcStreamClient) InfoSync(req types.RequestInfo) (*types.ResponseInfo, error) {
+	reqres := cli.queueRequest(types.ToRequestInfo(req))
+	reqres.Wait()
+	return reqres.Response.GetInfo(), cli.Error()
+}
+
+func (cli *grpcStreamClient) SetOptionSync(req types.RequestSetOption) (*types.ResponseSetOption, error) {
+	reqres := cli.queueRequest(types.ToRequestSetOption(req))
+	reqres.Wait()
+	return reqres.Response.GetSetOption(), cli.Error()
+}
+
+func (cli *grpcStreamClient) DeliverTxSync(req types.RequestDeliverTx) (*types.ResponseDeliverTx, error) {
+	reqres := cli.queueRequest(types.ToRequestDeliverTx(req))
+	reqres.Wait()
+	return reqres.Response.GetDeliverTx(), cli.Error()
+}
+
+func (cli *grpcStreamClient) CheckTxSync(req types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	reqres := cli.queueRequest(types.ToRequestCheckTx(req))
+	reqres.Wait()
+	return reqres.Response.GetCheckTx(), cli.Error()
+}
+
+func (cli *grpcStreamClient) QuerySync(req types.RequestQuery) (*types.ResponseQuery, error) {
+	reqres := cli.queueRequest(types.ToRequestQuery(req))
+	reqres.Wait()
+	return reqres.Response.GetQuery(), cli.Error()
+}
+
+func (cli *grpcStreamClient) CommitSync() (*types.ResponseCommit, error) {
+	reqres := cli.queueRequest(types.ToRequestCommit())
+	reqres.Wait()
+	return reqres.Response.GetCommit(), cli.Error()
+}
+
+func (cli *grpcStreamClient) InitChainSync(req types.RequestInitChain) (*types.ResponseInitChain, error) {
+	reqres := cli.queueRequest(types.ToRequestInitChain(req))
+	reqres.Wait()
+	return reqres.Response.GetInitChain(), cli.Error()
+}
+
+func (cli *grpcStreamClient) BeginBlockSync(req types.RequestBeginBlock) (*types.ResponseBeginBlock, error) {
+	reqres := cli.queueRequest(types.ToRequestBeginBlock(req))
+	reqres.Wait()
+	return reqres.Response.GetBeginBlock(), cli.Error()
+}
+
+func (cli *grpcStreamClient) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
+	reqres := cli.queueRequest(types.ToRequestEndBlock(req))
+	reqres.Wait()
+	return reqres.Response.GetEndBlock(), cli.Error()
+}
+
+func (cli *grpcStreamClient) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestPrepareProposal(req))
+	reqres.Wait()
+	return reqres.Response.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *grpcStreamClient) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestProcessProposal(req))
+	reqres.Wait()
+	return reqres.Response.GetProcessProposal(), cli.Error()
+}
+
+func (cli *grpcStreamClient) ExtendVoteSync(
+	req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	reqres := cli.queueRequest(types.ToRequestExtendVote(req))
+	reqres.Wait()
+	return reqres.Response.GetExtendVote(), cli.Error()
+}
+
+func (cli *grpcStreamClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+	reqres.Wait()
+	return reqres.Response.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *grpcStreamClient) ExportStateSync(
+	req types.RequestExportState) (*types.ResponseExportState, error) {
+	reqres := cli.queueRequest(types.ToRequestExportState(req))
+	reqres.Wait()
+	return reqres.Response.GetExportState(), cli.Error()
+}
@@ -0,0 +1,147 @@
+package abcicli_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/server"
+	"github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+func TestGRPCStreamConcurrentQueries(t *testing.T) {
+	// Each query blocks until the other one arrived, so they can only
+	// complete if they run concurrently.
+	app := &barrierApp{arrived: make(chan struct{})}
+	s, c := setupGRPCStreamClientServer(t, app, 2)
+	defer s.Stop()
+	defer c.Stop()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.QuerySync(types.RequestQuery{})
+			errs <- err
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("queries didn't run concurrently")
+	}
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
+func TestGRPCStreamCallbackOrder(t *testing.T) {
+	s, c := setupGRPCStreamClientServer(t, slowCheckTxApp{}, 4)
+	defer s.Stop()
+	defer c.Stop()
+
+	var mtx sync.Mutex
+	var order []string
+	c.SetResponseCallback(func(req *types.Request, res *types.Response) {
+		if r, ok := req.Value.(*types.Request_CheckTx); ok {
+			mtx.Lock()
+			order = append(order, string(r.CheckTx.Tx))
+			mtx.Unlock()
+		}
+	})
+
+	// The slow tx is answered last, but its callback must be called first.
+	expected := []string{"slow", "a", "b", "c"}
+	for _, tx := range expected {
+		c.CheckTxAsync(types.RequestCheckTx{Tx: []byte(tx)})
+	}
+	require.NoError(t, c.FlushSync())
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Equal(t, expected, order)
+}
+
+func TestGRPCStreamStopReleasesRequests(t *testing.T) {
+	s, c := setupGRPCStreamClientServer(t, slowApp{}, 1)
+	defer s.Stop()
+
+	resp := make(chan error, 1)
+	go func() {
+		_, err := c.BeginBlockSync(types.RequestBeginBlock{})
+		resp <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	c.Stop()
+
+	select {
+	case <-time.After(time.Second):
+		require.Fail(t, "request wasn't released")
+	case err := <-resp:
+		assert.Error(t, err)
+	}
+}
+
+func setupGRPCStreamClientServer(t *testing.T, app types.Application, concurrency int) (
+	service.Service, abcicli.Client) {
+	// some port between 20k and 30k
+	port := 20000 + tmrand.Int32()%10000
+	addr := fmt.Sprintf("localhost:%d", port)
+
+	s, err := server.NewServer(addr, "grpc-stream", app)
+	require.NoError(t, err)
+	err = s.Start()
+	require.NoError(t, err)
+
+	c := abcicli.NewGRPCStreamClient(addr, true, concurrency)
+	err = c.Start()
+	require.NoError(t, err)
+
+	return s, c
+}
+
+type barrierApp struct {
+	types.BaseApplication
+
+	mtx     sync.Mutex
+	count   int
+	arrived chan struct{}
+}
+
+func (app *barrierApp) Query(req types.RequestQuery) types.ResponseQuery {
+	app.mtx.Lock()
+	app.count++
+	if app.count == 2 {
+		close(app.arrived)
+	}
+	app.mtx.Unlock()
+	<-app.arrived
+	return types.ResponseQuery{}
+}
+
+type slowCheckTxApp struct {
+	types.BaseApplication
+}
+
+func (slowCheckTxApp) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
+	if string(req.Tx) == "slow" {
+		time.Sleep(200 * time.Millisecond)
+	}
+	return types.ResponseCheckTx{}
+}
@@ -0,0 +1,286 @@
+package abcicli
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+// Recorder writes ABCI requests and their responses to a log, in the order
+// the responses are received. The log can be fed back to an app with Replay.
+//
+// The requests of several connections to the app (eg. "consensus" and
+// "mempool") are recorded in the same log, each record tagged with the name
+// of its connection, so they can be replayed on the same connections.
+//
+// Each record is the length-prefixed name of the connection, followed by a
+// length-prefixed Request and a length-prefixed Response, as written by
+// types.WriteMessage. Records are buffered until the next Commit is
+// recorded, so the records of a block being executed when the process
+// crashed may be lost.
+type Recorder struct {
+	mtx    sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+	err    error
+}
+
+// NewRecorder returns a recorder writing to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: bufio.NewWriter(w)}
+}
+
+// OpenRecorder returns a recorder appending to the file at path, which is
+// created if needed.
+func OpenRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	rec := NewRecorder(f)
+	rec.closer = f
+	return rec, nil
+}
+
+// Record writes req and res, made on the named connection, to the log. Flush
+// requests are skipped. Once writing failed, nothing more is recorded and the
+// error is returned by Close.
+func (rec *Recorder) Record(conn string, req *types.Request, res *types.Response) {
+	if _, ok := req.Value.(*types.Request_Flush); ok {
+		return
+	}
+
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	if rec.err != nil {
+		return
+	}
+	if rec.err = writeConnName(rec.w, conn); rec.err != nil {
+		return
+	}
+	if rec.err = types.WriteMessage(req, rec.w); rec.err != nil {
+		return
+	}
+	if rec.err = types.WriteMessage(res, rec.w); rec.err != nil {
+		return
+	}
+	if _, ok := req.Value.(*types.Request_Commit); ok {
+		rec.err = rec.w.Flush()
+	}
+}
+
+// Close flushes the log and closes the underlying file, if the recorder was
+// made by OpenRecorder. It returns the first error met while recording.
+func (rec *Recorder) Close() error {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+	if rec.err == nil {
+		rec.err = rec.w.Flush()
+	}
+	if rec.closer != nil {
+		if err := rec.closer.Close(); err != nil && rec.err == nil {
+			rec.err = err
+		}
+	}
+	return rec.err
+}
+
+// ReadRecord reads the next record from a log written by a Recorder: the name
+// of the connection, the request and the response. It returns io.EOF at the
+// end of the log.
+func ReadRecord(r *bufio.Reader) (string, *types.Request, *types.Response, error) {
+	conn, err := readConnName(r)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	req := &types.Request{}
+	if err := types.ReadMessage(r, req); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", nil, nil, err
+	}
+	res := &types.Response{}
+	if err := types.ReadMessage(r, res); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", nil, nil, err
+	}
+	return conn, req, res, nil
+}
+
+// maxConnNameSize bounds the names of connections read from a log.
+const maxConnNameSize = 64
+
+func writeConnName(w io.Writer, conn string) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], int64(len(conn)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, conn)
+	return err
+}
+
+func readConnName(r *bufio.Reader) (string, error) {
+	size, err := binary.ReadVarint(r)
+	if err != nil {
+		return "", err
+	}
+	if size < 0 || size > maxConnNameSize {
+		return "", fmt.Errorf("invalid connection name size %d", size)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	return string(buf), nil
+}
+
+//----------------------------------------
+
+var _ Client = (*recordingClient)(nil)
+
+// recordingClient is a Client recording all the requests made through it,
+// and their responses, with a Recorder, tagged with the name of the
+// connection.
+//
+// Responses are recorded from the response callback of the underlying
+// client, so sync calls are made as async calls followed by a flush.
+type recordingClient struct {
+	Client
+
+	rec  *Recorder
+	conn string
+
+	mtx   sync.Mutex
+	resCb Callback
+}
+
+// NewRecordingClient returns a client making requests through client, and
+// recording them with rec as made on the named connection. rec may be shared
+// by the clients of several connections.
+func NewRecordingClient(client Client, rec *Recorder, conn string) Client {
+	cli := &recordingClient{
+		Client: client,
+		rec:    rec,
+		conn:   conn,
+	}
+	client.SetResponseCallback(cli.recordResponse)
+	return cli
+}
+
+func (cli *recordingClient) recordResponse(req *types.Request, res *types.Response) {
+	cli.rec.Record(cli.conn, req, res)
+
+	cli.mtx.Lock()
+	resCb := cli.resCb
+	cli.mtx.Unlock()
+	if resCb != nil {
+		resCb(req, res)
+	}
+}
+
+func (cli *recordingClient) SetResponseCallback(resCb Callback) {
+	cli.mtx.Lock()
+	cli.resCb = resCb
+	cli.mtx.Unlock()
+}
+
+//----------------------------------------
+
+func (cli *recordingClient) flush(reqres *ReqRes) *types.Response {
+	if err := cli.FlushSync(); err != nil {
+		return nil
+	}
+	return reqres.Response
+}
+
+func (cli *recordingClient) EchoSync(msg string) (*types.ResponseEcho, error) {
+	res := cli.flush(cli.EchoAsync(msg))
+	return res.GetEcho(), cli.Error()
+}
+
+func (cli *recordingClient) InfoSync(req types.RequestInfo) (*types.ResponseInfo, error) {
+	res := cli.flush(cli.InfoAsync(req))
+	return res.GetInfo(), cli.Error()
+}
+
+func (cli *recordingClient) SetOptionSync(req types.RequestSetOption) (*types.ResponseSetOption, error) {
+	res := cli.flush(cli.SetOptionAsync(req))
+	return res.GetSetOption(), cli.Error()
+}
+
+func (cli *recordingClient) DeliverTxSync(req types.RequestDeliverTx) (*types.ResponseDeliverTx, error) {
+	res := cli.flush(cli.DeliverTxAsync(req))
+	return res.GetDeliverTx(), cli.Error()
+}
+
+func (cli *recordingClient) CheckTxSync(req types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	res := cli.flush(cli.CheckTxAsync(req))
+	return res.GetCheckTx(), cli.Error()
+}
+
+func (cli *recordingClient) QuerySync(req types.RequestQuery) (*types.ResponseQuery, error) {
+	res := cli.flush(cli.QueryAsync(req))
+	return res.GetQuery(), cli.Error()
+}
+
+func (cli *recordingClient) CommitSync() (*types.ResponseCommit, error) {
+	res := cli.flush(cli.CommitAsync())
+	return res.GetCommit(), cli.Error()
+}
+
+func (cli *recordingClient) InitChainSync(req types.RequestInitChain) (*types.ResponseInitChain, error) {
+	res := cli.flush(cli.InitChainAsync(req))
+	return res.GetInitChain(), cli.Error()
+}
+
+func (cli *recordingClient) BeginBlockSync(req types.RequestBeginBlock) (*types.ResponseBeginBlock, error) {
+	res := cli.flush(cli.BeginBlockAsync(req))
+	return res.GetBeginBlock(), cli.Error()
+}
+
+func (cli *recordingClient) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
+	res := cli.flush(cli.EndBlockAsync(req))
+	return res.GetEndBlock(), cli.Error()
+}
+
+func (cli *recordingClient) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	res := cli.flush(cli.PrepareProposalAsync(req))
+	return res.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *recordingClient) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	res := cli.flush(cli.ProcessProposalAsync(req))
+	return res.GetProcessProposal(), cli.Error()
+}
+
+func (cli *recordingClient) ExtendVoteSync(
+	req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	res := cli.flush(cli.ExtendVoteAsync(req))
+	return res.GetExtendVote(), cli.Error()
+}
+
+func (cli *recordingClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	res := cli.flush(cli.VerifyVoteExtensionAsync(req))
+	return res.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *recordingClient) ExportStateSync(
+	req types.RequestExportState) (*types.ResponseExportState, error) {
+	res := cli.flush(cli.ExportStateAsync(req))
+	return res.GetExportState(), cli.Error()
+}
@@ -0,0 +1,195 @@
+package abcicli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+// Mismatch describes a recorded response which the app didn't reproduce.
+type Mismatch struct {
+	// Conn is the name of the connection the request was made on.
+	Conn string
+	// Height of the block being executed, or of the last block if the
+	// request was made between blocks.
+	Height int64
+	// Index of the tx in the block for DeliverTx, -1 otherwise.
+	Index int
+
+	Request  *types.Request
+	Expected *types.Response
+	Actual   *types.Response
+}
+
+func (m *Mismatch) String() string {
+	return fmt.Sprintf("%T on the %s connection at height %d (index %d): expected %v, got %v",
+		m.Request.Value, m.Conn, m.Height, m.Index, m.Expected, m.Actual)
+}
+
+// Replay feeds the requests of a log written by a Recorder to the app, in
+// the order they were recorded, and compares the responses, of any type,
+// with the recorded ones. Each request is made on the client returned by
+// newClient for its connection, which is called once per connection. It
+// stops at the first response which differs, and returns it as a Mismatch.
+// It also returns the number of requests replayed.
+//
+// The app must be in the same state as the recorded one was when the
+// recording started, eg. both started from genesis.
+func Replay(newClient func(conn string) (Client, error), r io.Reader) (*Mismatch, int, error) {
+	var (
+		br      = bufio.NewReader(r)
+		clients = make(map[string]Client)
+		height  int64
+		index   = -1
+		count   int
+	)
+	for {
+		conn, req, expected, err := ReadRecord(br)
+		if err == io.EOF {
+			return nil, count, nil
+		}
+		if err != nil {
+			return nil, count, err
+		}
+		client, ok := clients[conn]
+		if !ok {
+			if client, err = newClient(conn); err != nil {
+				return nil, count, fmt.Errorf("can't connect to the app (%s connection): %v", conn, err)
+			}
+			clients[conn] = client
+		}
+
+		switch r := req.Value.(type) {
+		case *types.Request_InitChain:
+			height = 0
+		case *types.Request_BeginBlock:
+			height = r.BeginBlock.Header.Height
+			index = 0
+		}
+
+		actual, err := doRequest(client, req)
+		if err != nil {
+			return nil, count, err
+		}
+		count++
+
+		if !actual.Equal(expected) {
+			m := &Mismatch{Conn: conn, Height: height, Index: -1, Request: req, Expected: expected, Actual: actual}
+			if _, ok := req.Value.(*types.Request_DeliverTx); ok {
+				m.Index = index
+			}
+			return m, count, nil
+		}
+
+		switch req.Value.(type) {
+		case *types.Request_DeliverTx:
+			index++
+		case *types.Request_Commit:
+			index = -1
+		}
+	}
+}
+
+// doRequest makes req with the matching sync method of client.
+func doRequest(client Client, req *types.Request) (*types.Response, error) {
+	var res *types.Response
+	switch r := req.Value.(type) {
+	case *types.Request_Echo:
+		v, err := client.EchoSync(r.Echo.Message)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseEcho(v.Message)
+	case *types.Request_Flush:
+		return types.ToResponseFlush(), client.FlushSync()
+	case *types.Request_Info:
+		v, err := client.InfoSync(*r.Info)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseInfo(*v)
+	case *types.Request_SetOption:
+		v, err := client.SetOptionSync(*r.SetOption)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseSetOption(*v)
+	case *types.Request_DeliverTx:
+		v, err := client.DeliverTxSync(*r.DeliverTx)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseDeliverTx(*v)
+	case *types.Request_CheckTx:
+		v, err := client.CheckTxSync(*r.CheckTx)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseCheckTx(*v)
+	case *types.Request_Query:
+		v, err := client.QuerySync(*r.Query)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseQuery(*v)
+	case *types.Request_Commit:
+		v, err := client.CommitSync()
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseCommit(*v)
+	case *types.Request_InitChain:
+		v, err := client.InitChainSync(*r.InitChain)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseInitChain(*v)
+	case *types.Request_BeginBlock:
+		v, err := client.BeginBlockSync(*r.BeginBlock)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseBeginBlock(*v)
+	case *types.Request_EndBlock:
+		v, err := client.EndBlockSync(*r.EndBlock)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseEndBlock(*v)
+	case *types.Request_PrepareProposal:
+		v, err := client.PrepareProposalSync(*r.PrepareProposal)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponsePrepareProposal(*v)
+	case *types.Request_ProcessProposal:
+		v, err := client.ProcessProposalSync(*r.ProcessProposal)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseProcessProposal(*v)
+	case *types.Request_ExtendVote:
+		v, err := client.ExtendVoteSync(*r.ExtendVote)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseExtendVote(*v)
+	case *types.Request_VerifyVoteExtension:
+		v, err := client.VerifyVoteExtensionSync(*r.VerifyVoteExtension)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseVerifyVoteExtension(*v)
+	case *types.Request_ExportState:
+		v, err := client.ExportStateSync(*r.ExportState)
+		if err != nil {
+			return nil, err
+		}
+		res = types.ToResponseExportState(*v)
+	default:
+		return nil, fmt.Errorf("unknown request %T", req.Value)
+	}
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package abcicli_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func recordBlocks(t *testing.T, app types.Application) []byte {
+	buf := new(bytes.Buffer)
+	rec := abcicli.NewRecorder(buf)
+	mtx := new(sync.Mutex)
+	newClient := func(conn string) abcicli.Client {
+		c := abcicli.NewRecordingClient(abcicli.NewLocalClient(mtx, app), rec, conn)
+		require.NoError(t, c.Start())
+		return c
+	}
+	c, mem, query := newClient("consensus"), newClient("mempool"), newClient("query")
+	defer c.Stop()
+	defer mem.Stop()
+	defer query.Stop()
+
+	_, err := c.InitChainSync(types.RequestInitChain{})
+	require.NoError(t, err)
+	for h := int64(1); h <= 2; h++ {
+		_, err = mem.CheckTxSync(types.RequestCheckTx{Tx: []byte("a=1")})
+		require.NoError(t, err)
+		_, err = c.BeginBlockSync(types.RequestBeginBlock{Header: types.Header{Height: h}})
+		require.NoError(t, err)
+		for _, tx := range []string{"a=1", "b=2", "c=3"} {
+			c.DeliverTxAsync(types.RequestDeliverTx{Tx: []byte(tx)})
+		}
+		_, err = c.EndBlockSync(types.RequestEndBlock{Height: h})
+		require.NoError(t, err)
+		_, err = c.CommitSync()
+		require.NoError(t, err)
+	}
+	_, err = query.QuerySync(types.RequestQuery{Data: []byte("a")})
+	require.NoError(t, err)
+
+	require.NoError(t, rec.Close())
+	return buf.Bytes()
+}
+
+// replay replays log to app, with a client per connection, and returns the
+// connections replayed.
+func replay(t *testing.T, app types.Application, log []byte) (*abcicli.Mismatch, int, []string) {
+	mtx := new(sync.Mutex)
+	var conns []string
+	newClient := func(conn string) (abcicli.Client, error) {
+		conns = append(conns, conn)
+		return abcicli.NewLocalClient(mtx, app), nil
+	}
+	mismatch, count, err := abcicli.Replay(newClient, bytes.NewReader(log))
+	require.NoError(t, err)
+	return mismatch, count, conns
+}
+
+func TestReplay(t *testing.T) {
+	log := recordBlocks(t, kvstore.NewApplication())
+
+	mismatch, count, conns := replay(t, kvstore.NewApplication(), log)
+	assert.Nil(t, mismatch)
+	assert.Equal(t, 1+2*(1+3+3)+1, count)
+	assert.Equal(t, []string{"consensus", "mempool", "query"}, conns)
+
+	// An app which handles a tx differently at height 2.
+	mismatch, count, _ = replay(t, &divergingApp{
+		Application: kvstore.NewApplication(),
+		height:      2,
+		tx:          "b=2",
+	}, log)
+	require.NotNil(t, mismatch)
+	assert.Equal(t, 1+7+4, count)
+	assert.Equal(t, "consensus", mismatch.Conn)
+	assert.EqualValues(t, 2, mismatch.Height)
+	assert.Equal(t, 1, mismatch.Index)
+	assert.Equal(t, []byte("b=2"), mismatch.Request.GetDeliverTx().Tx)
+	assert.NotEqual(t, mismatch.Expected, mismatch.Actual)
+}
+
+func TestReplayComparesAllResponses(t *testing.T) {
+	log := recordBlocks(t, kvstore.NewApplication())
+
+	// An app whose CheckTx differs.
+	mismatch, count, _ := replay(t, &checkTxApp{Application: kvstore.NewApplication()}, log)
+	require.NotNil(t, mismatch)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "mempool", mismatch.Conn)
+	assert.Equal(t, -1, mismatch.Index)
+	assert.NotNil(t, mismatch.Request.GetCheckTx())
+
+	// An app whose app hash differs.
+	mismatch, count, _ = replay(t, &commitApp{Application: kvstore.NewApplication()}, log)
+	require.NotNil(t, mismatch)
+	assert.Equal(t, 1+7, count)
+	assert.Equal(t, "consensus", mismatch.Conn)
+	assert.EqualValues(t, 1, mismatch.Height)
+	assert.NotNil(t, mismatch.Request.GetCommit())
+}
+
+type divergingApp struct {
+	types.Application
+
+	height  int64
+	tx      string
+	current int64
+}
+
+func (app *divergingApp) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock {
+	app.current = req.Header.Height
+	return app.Application.BeginBlock(req)
+}
+
+func (app *divergingApp) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+	res := app.Application.DeliverTx(req)
+	if app.current == app.height && string(req.Tx) == app.tx {
+		res.Code = 1
+	}
+	return res
+}
+
+type checkTxApp struct {
+	types.Application
+}
+
+func (app *checkTxApp) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
+	res := app.Application.CheckTx(req)
+	res.GasWanted++
+	return res
+}
+
+type commitApp struct {
+	types.Application
+}
+
+func (app *commitApp) Commit() types.ResponseCommit {
+	res := app.Application.Commit()
+	res.Data = append(res.Data, 0)
+	return res
+}
@@ -87,6 +87,10 @@ func (cli *socketClient) OnStop() {
 
 	cli.mtx.Lock()
 	defer cli.mtx.Unlock()
+	// Requests which are still pending get an error rather than no response.
+	if cli.err == nil {
+		cli.err = errors.New("client is stopped")
+	}
 	cli.flushQueue()
 }
 
@@ -266,6 +270,26 @@ func (cli *socketClient) EndBlockAsync(req types.RequestEndBlock) *ReqRes {
 	return cli.queueRequest(types.ToRequestEndBlock(req))
 }
 
+func (cli *socketClient) PrepareProposalAsync(req types.RequestPrepareProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestPrepareProposal(req))
+}
+
+func (cli *socketClient) ProcessProposalAsync(req types.RequestProcessProposal) *ReqRes {
+	return cli.queueRequest(types.ToRequestProcessProposal(req))
+}
+
+func (cli *socketClient) ExtendVoteAsync(req types.RequestExtendVote) *ReqRes {
+	return cli.queueRequest(types.ToRequestExtendVote(req))
+}
+
+func (cli *socketClient) VerifyVoteExtensionAsync(req types.RequestVerifyVoteExtension) *ReqRes {
+	return cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+}
+
+func (cli *socketClient) ExportStateAsync(req types.RequestExportState) *ReqRes {
+	return cli.queueRequest(types.ToRequestExportState(req))
+}
+
 //----------------------------------------
 
 func (cli *socketClient) FlushSync() error {
@@ -337,13 +361,60 @@ func (cli *socketClient) EndBlockSync(req types.RequestEndBlock) (*types.Respons
 	return reqres.Response.GetEndBlock(), cli.Error()
 }
 
+func (cli *socketClient) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestPrepareProposal(req))
+	cli.FlushSync()
+	return reqres.Response.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *socketClient) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestProcessProposal(req))
+	cli.FlushSync()
+	return reqres.Response.GetProcessProposal(), cli.Error()
+}
+
+func (cli *socketClient) ExtendVoteSync(
+	req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	reqres := cli.queueRequest(types.ToRequestExtendVote(req))
+	cli.FlushSync()
+	return reqres.Response.GetExtendVote(), cli.Error()
+}
+
+func (cli *socketClient) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.queueRequest(types.ToRequestVerifyVoteExtension(req))
+	cli.FlushSync()
+	return reqres.Response.GetVerifyVoteExtension(), cli.Error()
+}
+
+func (cli *socketClient) ExportStateSync(
+	req types.RequestExportState) (*types.ResponseExportState, error) {
+	reqres := cli.queueRequest(types.ToRequestExportState(req))
+	cli.FlushSync()
+	return reqres.Response.GetExportState(), cli.Error()
+}
+
 //----------------------------------------
 
 func (cli *socketClient) queueRequest(req *types.Request) *ReqRes {
 	reqres := NewReqRes(req)
 
 	// TODO: set cli.err if reqQueue times out
-	cli.reqQueue <- reqres
+	// Once stopped, nobody is left to send the request or drain the queue.
+	select {
+	case <-cli.Quit():
+		reqres.Done()
+		return reqres
+	default:
+	}
+	select {
+	case cli.reqQueue <- reqres:
+	case <-cli.Quit():
+		reqres.Done()
+		return reqres
+	}
 
 	// Maybe auto-flush, or unset auto-flush
 	switch req.Value.(type) {
@@ -401,6 +472,16 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_BeginBlock)
 	case *types.Request_EndBlock:
 		_, ok = res.Value.(*types.Response_EndBlock)
+	case *types.Request_PrepareProposal:
+		_, ok = res.Value.(*types.Response_PrepareProposal)
+	case *types.Request_ProcessProposal:
+		_, ok = res.Value.(*types.Response_ProcessProposal)
+	case *types.Request_ExtendVote:
+		_, ok = res.Value.(*types.Response_ExtendVote)
+	case *types.Request_VerifyVoteExtension:
+		_, ok = res.Value.(*types.Response_VerifyVoteExtension)
+	case *types.Request_ExportState:
+		_, ok = res.Value.(*types.Response_ExportState)
 	}
 	return ok
 }
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/tendermint/tendermint/abci/example/counter"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"github.com/tendermint/tendermint/abci/server"
+	"github.com/tendermint/tendermint/abci/tests/conformance"
 	servertest "github.com/tendermint/tendermint/abci/tests/server"
 	"github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/abci/version"
@@ -49,6 +52,15 @@ var (
 
 	// kvstore
 	flagPersist string
+
+	// replay
+	flagApp string
+
+	// conformance
+	flagExec        string
+	flagTxs         []string
+	flagQuery       string
+	flagPubKeyTypes []string
 )
 
 var RootCmd = &cobra.Command{
@@ -62,6 +74,12 @@ var RootCmd = &cobra.Command{
 			return nil
 		case "version": // skip running for version command
 			return nil
+		case "replay": // replaying to an app in-process doesn't need a client
+			if flagApp != "" {
+				return nil
+			}
+		case "conformance": // the suite starts the app and connects to it
+			return nil
 		}
 
 		if logger == nil {
@@ -71,7 +89,7 @@ var RootCmd = &cobra.Command{
 			}
 			logger = log.NewFilter(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), allowLevel)
 		}
-		if client == nil {
+		if client == nil && cmd.Use != "replay" { // replay connects once per recorded connection
 			var err error
 			client, err = abcicli.NewClient(flagAddress, flagAbci, false)
 			if err != nil {
@@ -116,7 +134,7 @@ func addGlobalFlags() {
 		"",
 		"tcp://0.0.0.0:26658",
 		"address of application socket")
-	RootCmd.PersistentFlags().StringVarP(&flagAbci, "abci", "", "socket", "either socket or grpc")
+	RootCmd.PersistentFlags().StringVarP(&flagAbci, "abci", "", "socket", "either socket, grpc or grpc-stream")
 	RootCmd.PersistentFlags().BoolVarP(&flagVerbose,
 		"verbose",
 		"v",
@@ -143,6 +161,27 @@ func addKVStoreFlags() {
 	kvstoreCmd.PersistentFlags().StringVarP(&flagPersist, "persist", "", "", "directory to use for a database")
 }
 
+func addReplayFlags() {
+	replayCmd.PersistentFlags().StringVarP(&flagApp, "app", "", "",
+		"replay to an example app in-process rather than to the app at --address: "+
+			"'kvstore', 'persistent_kvstore', 'counter' or 'counter_serial'")
+	replayCmd.PersistentFlags().StringVarP(&flagPersist, "persist", "", "",
+		"directory to use for the database of the persistent_kvstore app")
+}
+
+func addConformanceFlags() {
+	conformanceCmd.PersistentFlags().StringVarP(&flagExec, "exec", "", "",
+		"command starting the app, with its state in $ABCI_HOME")
+	conformanceCmd.PersistentFlags().StringSliceVarP(&flagTxs, "txs", "", nil,
+		"valid txs, quoted or 0x-prefixed hex, each executed in a block of its own (at least 3; "+
+			"defaults to txs for the kvstore app)")
+	conformanceCmd.PersistentFlags().StringVarP(&flagQuery, "query", "", "",
+		"data of a query to make with prove=true once the txs were committed, quoted or 0x-prefixed hex")
+	conformanceCmd.PersistentFlags().StringVarP(&flagPath, "path", "", "/store", "path of the query")
+	conformanceCmd.PersistentFlags().StringSliceVarP(&flagPubKeyTypes, "pub_key_types", "", nil,
+		"validator key types the chain accepts (defaults to ed25519, sr25519, secp256k1 and bls12_381)")
+}
+
 func addCommands() {
 	RootCmd.AddCommand(batchCmd)
 	RootCmd.AddCommand(consoleCmd)
@@ -156,6 +195,10 @@ func addCommands() {
 	RootCmd.AddCommand(testCmd)
 	addQueryFlags()
 	RootCmd.AddCommand(queryCmd)
+	addReplayFlags()
+	RootCmd.AddCommand(replayCmd)
+	addConformanceFlags()
+	RootCmd.AddCommand(conformanceCmd)
 
 	// examples
 	addCounterFlags()
@@ -267,6 +310,41 @@ var queryCmd = &cobra.Command{
 	RunE:  cmdQuery,
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "replay a log of recorded requests to an application",
+	Long: `replay a log of recorded requests to an application
+
+The log is written by a node with abci_record_file set. Its requests are made
+again, in order, and the responses compared with the recorded ones. The first
+response which differs is reported, along with the height of the block and,
+for DeliverTx, the index of the tx in the block.
+
+The app must start from the same state as the recorded one, eg. from genesis:
+
+    abci-cli replay --app kvstore abci_record.log
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: cmdReplay,
+}
+
+var conformanceCmd = &cobra.Command{
+	Use:   "conformance",
+	Short: "check that an application follows the ABCI semantics",
+	Long: `check that an application follows the ABCI semantics
+
+The app is started with the --exec command, possibly several times, and must
+listen on --address with the --abci transport. Each time it's started afresh,
+$ABCI_HOME is a new empty directory, in which the app must keep its state:
+
+    abci-cli conformance --exec 'abci-cli kvstore --persist $ABCI_HOME'
+
+The results of the tests are reported one per line.
+`,
+	Args: cobra.ExactArgs(0),
+	RunE: cmdConformance,
+}
+
 var counterCmd = &cobra.Command{
 	Use:   "counter",
 	Short: "ABCI demo example",
@@ -649,6 +727,128 @@ func cmdCounter(cmd *cobra.Command, args []string) error {
 	select {}
 }
 
+func cmdReplay(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Each connection recorded is replayed on a connection of its own.
+	var newClient func(conn string) (abcicli.Client, error)
+	if flagApp == "" {
+		var clients []abcicli.Client
+		defer func() {
+			for _, cli := range clients {
+				cli.Stop() // nolint: errcheck
+			}
+		}()
+		newClient = func(conn string) (abcicli.Client, error) {
+			cli, err := abcicli.NewClient(flagAddress, flagAbci, false)
+			if err != nil {
+				return nil, err
+			}
+			cli.SetLogger(logger.With("module", "abci-client", "connection", conn))
+			if err := cli.Start(); err != nil {
+				return nil, err
+			}
+			clients = append(clients, cli)
+			return cli, nil
+		}
+	} else {
+		var app types.Application
+		switch flagApp {
+		case "kvstore":
+			app = kvstore.NewApplication()
+		case "persistent_kvstore":
+			if flagPersist == "" {
+				return errors.New("--persist is required for the persistent_kvstore app")
+			}
+			app = kvstore.NewPersistentKVStoreApplication(flagPersist)
+		case "counter":
+			app = counter.NewApplication(false)
+		case "counter_serial":
+			app = counter.NewApplication(true)
+		default:
+			return fmt.Errorf("unknown app %q", flagApp)
+		}
+		mtx := new(sync.Mutex)
+		newClient = func(string) (abcicli.Client, error) {
+			return abcicli.NewLocalClient(mtx, app), nil
+		}
+	}
+
+	mismatch, count, err := abcicli.Replay(newClient, f)
+	if err != nil {
+		return fmt.Errorf("replay failed after %d requests: %v", count, err)
+	}
+	if mismatch == nil {
+		fmt.Printf("-> replayed %d requests, all responses match\n", count)
+		return nil
+	}
+	fmt.Printf("-> request %d: response to %v differs\n", count, reflect.TypeOf(mismatch.Request.Value))
+	fmt.Printf("-> connection: %s\n", mismatch.Conn)
+	fmt.Printf("-> height: %d\n", mismatch.Height)
+	if mismatch.Index >= 0 {
+		fmt.Printf("-> index: %d\n", mismatch.Index)
+	}
+	if commit, ok := mismatch.Expected.Value.(*types.Response_Commit); ok {
+		fmt.Printf("-> expected app hash: %X\n", commit.Commit.Data)
+		fmt.Printf("-> actual app hash: %X\n", mismatch.Actual.GetCommit().Data)
+	} else {
+		fmt.Printf("-> expected: %v\n", mismatch.Expected)
+		fmt.Printf("-> actual: %v\n", mismatch.Actual)
+	}
+	return errors.New("responses differ")
+}
+
+func cmdConformance(cmd *cobra.Command, args []string) error {
+	if flagExec == "" {
+		return errors.New("--exec is required")
+	}
+	txs := flagTxs
+	if len(txs) == 0 {
+		txs = []string{`"a=1"`, `"b=2"`, `"c=3"`, `"d=4"`}
+	}
+	blocks := make([][][]byte, len(txs))
+	for i, s := range txs {
+		tx, err := stringOrHexToBytes(s)
+		if err != nil {
+			return err
+		}
+		blocks[i] = [][]byte{tx}
+	}
+
+	p := &conformance.Process{Command: flagExec, Addr: flagAddress}
+	if flagVerbose {
+		p.Output = os.Stdout
+	}
+	defer p.Cleanup()
+	cfg := conformance.Config{
+		Transport:   flagAbci,
+		Start:       p.Start,
+		Restart:     p.Restart,
+		Stop:        p.Stop,
+		Blocks:      blocks,
+		PubKeyTypes: flagPubKeyTypes,
+	}
+	if flagQuery != "" {
+		data, err := stringOrHexToBytes(flagQuery)
+		if err != nil {
+			return err
+		}
+		cfg.Query = &types.RequestQuery{Path: flagPath, Data: data}
+	}
+
+	results, err := conformance.Run(cfg)
+	if err != nil {
+		return err
+	}
+	// Failures are reported along with the results.
+	cmd.SilenceUsage = true
+	return conformance.Report(os.Stdout, results)
+}
+
 func cmdKVStore(cmd *cobra.Command, args []string) error {
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 
@@ -154,3 +154,39 @@ func (app *Application) Query(reqQuery types.RequestQuery) (resQuery types.Respo
 
 	return resQuery
 }
+
+// InitChain loads the key-value pairs exported by ExportState, if the genesis
+// has an app state.
+func (app *Application) InitChain(req types.RequestInitChain) types.ResponseInitChain {
+	if len(req.AppStateBytes) == 0 {
+		return types.ResponseInitChain{}
+	}
+	var pairs []kv.Pair
+	if err := json.Unmarshal(req.AppStateBytes, &pairs); err != nil {
+		panic(fmt.Sprintf("invalid app state: %v", err))
+	}
+	for _, pair := range pairs {
+		app.state.db.Set(prefixKey(pair.Key), pair.Value)
+	}
+	app.state.Size = int64(len(pairs))
+	return types.ResponseInitChain{}
+}
+
+// ExportState returns the key-value pairs, to start a new chain from.
+func (app *Application) ExportState(req types.RequestExportState) types.ResponseExportState {
+	itr, err := dbm.IteratePrefix(app.state.db, kvPairPrefixKey)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	pairs := []kv.Pair{}
+	for ; itr.Valid(); itr.Next() {
+		pairs = append(pairs, kv.Pair{Key: itr.Key()[len(kvPairPrefixKey):], Value: itr.Value()})
+	}
+	appState, err := json.Marshal(pairs)
+	if err != nil {
+		panic(err)
+	}
+	return types.ResponseExportState{AppState: appState}
+}
@@ -61,6 +61,23 @@ func TestKVStoreKV(t *testing.T) {
 	testKVStore(t, kvstore, tx, key, value)
 }
 
+func TestKVStoreExportState(t *testing.T) {
+	kvstore := NewApplication()
+	kvstore.InitChain(types.RequestInitChain{})
+	kvstore.DeliverTx(types.RequestDeliverTx{Tx: []byte(testKey + "=" + testValue)})
+	kvstore.DeliverTx(types.RequestDeliverTx{Tx: []byte("foo")})
+	kvstore.Commit()
+
+	res := kvstore.ExportState(types.RequestExportState{Height: 1})
+
+	// a new chain starts with the exported pairs
+	kvstore2 := NewApplication()
+	kvstore2.InitChain(types.RequestInitChain{AppStateBytes: res.AppState})
+	require.EqualValues(t, 2, kvstore2.state.Size)
+	testKVStore(t, kvstore2, []byte("bar"), testKey, testValue)
+	testKVStore(t, kvstore2, []byte("bar"), "foo", "foo")
+}
+
 func TestPersistentKVStoreKV(t *testing.T) {
 	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
 	if err != nil {
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/abci/types"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+var _ types.ABCIStreamServer = (*GRPCStreamServer)(nil)
+
+// GRPCStreamServer serves the ABCIStream gRPC service. Each stream is handled
+// by its own goroutine, answering its requests in order.
+//
+// CheckTx, Query, Info and Echo requests from different streams run
+// concurrently with each other, so the app must be safe for that if the
+// client opens more than one stream. All other requests have exclusive access
+// to the app.
+type GRPCStreamServer struct {
+	service.BaseService
+
+	proto    string
+	addr     string
+	listener net.Listener
+	server   *grpc.Server
+
+	appMtx sync.RWMutex
+	app    types.Application
+}
+
+// NewGRPCStreamServer returns a new streaming gRPC ABCI server.
+func NewGRPCStreamServer(protoAddr string, app types.Application) service.Service {
+	proto, addr := tmnet.ProtocolAndAddress(protoAddr)
+	s := &GRPCStreamServer{
+		proto:    proto,
+		addr:     addr,
+		listener: nil,
+		app:      app,
+	}
+	s.BaseService = *service.NewBaseService(nil, "ABCIServer", s)
+	return s
+}
+
+// OnStart starts the gRPC service
+func (s *GRPCStreamServer) OnStart() error {
+	if err := s.BaseService.OnStart(); err != nil {
+		return err
+	}
+	ln, err := net.Listen(s.proto, s.addr)
+	if err != nil {
+		return err
+	}
+	s.Logger.Info("Listening", "proto", s.proto, "addr", s.addr)
+	s.listener = ln
+	s.server = grpc.NewServer()
+	types.RegisterABCIStreamServer(s.server, s)
+	go s.server.Serve(s.listener)
+	return nil
+}
+
+// OnStop stops the gRPC server
+func (s *GRPCStreamServer) OnStop() {
+	s.BaseService.OnStop()
+	s.server.Stop()
+}
+
+// Stream implements types.ABCIStreamServer.
+func (s *GRPCStreamServer) Stream(stream types.ABCIStream_StreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res, err := s.handleRequest(req)
+		if err != nil {
+			s.Logger.Error("Closing stream", "err", err)
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *GRPCStreamServer) handleRequest(req *types.Request) (res *types.Response, err error) {
+	switch req.Value.(type) {
+	case *types.Request_CheckTx, *types.Request_Query, *types.Request_Info, *types.Request_Echo:
+		s.appMtx.RLock()
+		defer s.appMtx.RUnlock()
+	default:
+		s.appMtx.Lock()
+		defer s.appMtx.Unlock()
+	}
+
+	defer func() {
+		// make sure to recover from any app-related panics, so only the stream is closed
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+	return handleRequest(s.app, req), nil
+}
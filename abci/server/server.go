@@ -1,8 +1,9 @@
 /*
 Package server is used to start a new ABCI server.
 
-It contains two server implementation:
+It contains three server implementations:
  * gRPC server
+ * streaming gRPC server
  * socket server
 
 */
@@ -23,6 +24,8 @@ func NewServer(protoAddr, transport string, app types.Application) (service.Serv
 		s = NewSocketServer(protoAddr, app)
 	case "grpc":
 		s = NewGRPCServer(protoAddr, types.NewGRPCApplication(app))
+	case "grpc-stream":
+		s = NewGRPCStreamServer(protoAddr, app)
 	default:
 		err = fmt.Errorf("unknown server type %s", transport)
 	}
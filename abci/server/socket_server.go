@@ -172,46 +172,62 @@ func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, resp
 		}
 		s.appMtx.Lock()
 		count++
-		s.handleRequest(req, responses)
+		responses <- handleRequest(s.app, req)
 		s.appMtx.Unlock()
 	}
 }
 
-func (s *SocketServer) handleRequest(req *types.Request, responses chan<- *types.Response) {
+// handleRequest passes req to app and returns its response.
+func handleRequest(app types.Application, req *types.Request) *types.Response {
 	switch r := req.Value.(type) {
 	case *types.Request_Echo:
-		responses <- types.ToResponseEcho(r.Echo.Message)
+		return types.ToResponseEcho(r.Echo.Message)
 	case *types.Request_Flush:
-		responses <- types.ToResponseFlush()
+		return types.ToResponseFlush()
 	case *types.Request_Info:
-		res := s.app.Info(*r.Info)
-		responses <- types.ToResponseInfo(res)
+		res := app.Info(*r.Info)
+		return types.ToResponseInfo(res)
 	case *types.Request_SetOption:
-		res := s.app.SetOption(*r.SetOption)
-		responses <- types.ToResponseSetOption(res)
+		res := app.SetOption(*r.SetOption)
+		return types.ToResponseSetOption(res)
 	case *types.Request_DeliverTx:
-		res := s.app.DeliverTx(*r.DeliverTx)
-		responses <- types.ToResponseDeliverTx(res)
+		res := app.DeliverTx(*r.DeliverTx)
+		return types.ToResponseDeliverTx(res)
 	case *types.Request_CheckTx:
-		res := s.app.CheckTx(*r.CheckTx)
-		responses <- types.ToResponseCheckTx(res)
+		res := app.CheckTx(*r.CheckTx)
+		return types.ToResponseCheckTx(res)
 	case *types.Request_Commit:
-		res := s.app.Commit()
-		responses <- types.ToResponseCommit(res)
+		res := app.Commit()
+		return types.ToResponseCommit(res)
 	case *types.Request_Query:
-		res := s.app.Query(*r.Query)
-		responses <- types.ToResponseQuery(res)
+		res := app.Query(*r.Query)
+		return types.ToResponseQuery(res)
 	case *types.Request_InitChain:
-		res := s.app.InitChain(*r.InitChain)
-		responses <- types.ToResponseInitChain(res)
+		res := app.InitChain(*r.InitChain)
+		return types.ToResponseInitChain(res)
 	case *types.Request_BeginBlock:
-		res := s.app.BeginBlock(*r.BeginBlock)
-		responses <- types.ToResponseBeginBlock(res)
+		res := app.BeginBlock(*r.BeginBlock)
+		return types.ToResponseBeginBlock(res)
 	case *types.Request_EndBlock:
-		res := s.app.EndBlock(*r.EndBlock)
-		responses <- types.ToResponseEndBlock(res)
+		res := app.EndBlock(*r.EndBlock)
+		return types.ToResponseEndBlock(res)
+	case *types.Request_PrepareProposal:
+		res := app.PrepareProposal(*r.PrepareProposal)
+		return types.ToResponsePrepareProposal(res)
+	case *types.Request_ProcessProposal:
+		res := app.ProcessProposal(*r.ProcessProposal)
+		return types.ToResponseProcessProposal(res)
+	case *types.Request_ExtendVote:
+		res := app.ExtendVote(*r.ExtendVote)
+		return types.ToResponseExtendVote(res)
+	case *types.Request_VerifyVoteExtension:
+		res := app.VerifyVoteExtension(*r.VerifyVoteExtension)
+		return types.ToResponseVerifyVoteExtension(res)
+	case *types.Request_ExportState:
+		res := app.ExportState(*r.ExportState)
+		return types.ToResponseExportState(res)
 	default:
-		responses <- types.ToResponseException("Unknown request")
+		return types.ToResponseException("Unknown request")
 	}
 }
 
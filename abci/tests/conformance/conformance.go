@@ -0,0 +1,579 @@
+/*
+Package conformance checks that an ABCI application follows the semantics
+Tendermint relies on, whatever its language, over the socket or gRPC
+transport.
+
+The suite checks:
+  - the handshake: Echo, and Info before and after blocks were committed
+  - InitChain: the validators returned by the app, if any, are the ones it was
+    given, with one validator of each key type the chain accepts
+  - Commit: app hashes are deterministic, including across restarts
+  - Query: proofs returned by the app decode and verify against the app hash
+  - CheckTx: valid txs which were not included in a block pass the recheck
+
+Each check starts the app afresh through the Config, and results are
+reported per check.
+*/
+package conformance
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const chainID = "abci-conformance"
+
+// Config describes the app under test.
+type Config struct {
+	// Transport used to connect to the app: "socket" or "grpc".
+	Transport string
+
+	// Start starts the app with an empty state, and returns the address it
+	// listens on. The app is stopped before it's started again.
+	Start func() (addr string, err error)
+	// Restart stops the app and starts it again with the state it had. The
+	// checks across restarts are skipped if it's nil.
+	Restart func() (addr string, err error)
+	// Stop stops the app.
+	Stop func() error
+
+	// Blocks are the txs of the blocks executed by the checks, in order. All
+	// of them must be valid. At least 3 blocks are needed.
+	Blocks [][][]byte
+
+	// Query is made once all the blocks were committed, to check the proof
+	// returned by the app. The check is skipped if it's nil, or if the app
+	// doesn't return a proof.
+	Query *types.RequestQuery
+	// ProofRuntime decodes the proofs returned by the app. Defaults to
+	// merkle.DefaultProofRuntime().
+	ProofRuntime *merkle.ProofRuntime
+
+	// PubKeyTypes are the validator key types the chain accepts, sent to the
+	// app in the consensus params of InitChain. Defaults to all the types
+	// Tendermint supports.
+	PubKeyTypes []string
+}
+
+// Result is the outcome of a check.
+type Result struct {
+	Name    string
+	Skipped bool
+	// Err is why the check failed, or why it was skipped.
+	Err error
+}
+
+// Passed returns true if the check passed.
+func (r Result) Passed() bool {
+	return !r.Skipped && r.Err == nil
+}
+
+func (r Result) String() string {
+	switch {
+	case r.Skipped:
+		return fmt.Sprintf("Skipped test: %s - %v", r.Name, r.Err)
+	case r.Err != nil:
+		return fmt.Sprintf("Failed test: %s - %v", r.Name, r.Err)
+	default:
+		return fmt.Sprintf("Passed test: %s", r.Name)
+	}
+}
+
+// skipError is returned by checks which can't run with the given Config.
+type skipError struct{ reason string }
+
+func (e skipError) Error() string { return e.reason }
+
+var checks = []struct {
+	name string
+	run  func(*suite) error
+}{
+	{"Handshake", (*suite).checkHandshake},
+	{"InitChain", (*suite).checkInitChain},
+	{"DeterministicCommit", (*suite).checkDeterministicCommit},
+	{"CommitAcrossRestart", (*suite).checkCommitAcrossRestart},
+	{"QueryProof", (*suite).checkQueryProof},
+	{"Recheck", (*suite).checkRecheck},
+}
+
+// Run runs all the checks against the app described by cfg.
+func Run(cfg Config) ([]Result, error) {
+	if len(cfg.Blocks) < 3 {
+		return nil, errors.New("at least 3 blocks are needed")
+	}
+	if cfg.ProofRuntime == nil {
+		cfg.ProofRuntime = merkle.DefaultProofRuntime()
+	}
+	if len(cfg.PubKeyTypes) == 0 {
+		cfg.PubKeyTypes = []string{
+			tmtypes.ABCIPubKeyTypeEd25519,
+			tmtypes.ABCIPubKeyTypeSr25519,
+			tmtypes.ABCIPubKeyTypeSecp256k1,
+			tmtypes.ABCIPubKeyTypeBls12381,
+		}
+	}
+	for _, keyType := range cfg.PubKeyTypes {
+		if _, ok := tmtypes.ABCIPubKeyTypesToAminoNames[keyType]; !ok {
+			return nil, fmt.Errorf("unknown key type %q", keyType)
+		}
+	}
+	s := &suite{cfg: cfg}
+
+	results := make([]Result, len(checks))
+	for i, c := range checks {
+		err := s.run(c.run)
+		results[i] = Result{Name: c.name, Err: err}
+		if _, ok := err.(skipError); ok {
+			results[i].Skipped = true
+		}
+	}
+	return results, nil
+}
+
+// Report writes results to w, one line per check, followed by a summary. It
+// returns an error if any check failed.
+func Report(w io.Writer, results []Result) error {
+	failed := 0
+	for _, r := range results {
+		fmt.Fprintln(w, r)
+		if !r.Passed() && !r.Skipped {
+			failed++
+		}
+	}
+	if failed > 0 {
+		fmt.Fprintf(w, "%d of %d tests failed\n", failed, len(results))
+		return fmt.Errorf("%d tests failed", failed)
+	}
+	fmt.Fprintf(w, "All tests passed\n")
+	return nil
+}
+
+//----------------------------------------
+
+type suite struct {
+	cfg    Config
+	client abcicli.Client
+
+	// pubKeyTypes are the key types accepted since the last InitChain: the
+	// ones of the Config, unless the app changed them.
+	pubKeyTypes []string
+}
+
+// run starts the app afresh, runs check and stops the app.
+func (s *suite) run(check func(*suite) error) (err error) {
+	addr, err := s.cfg.Start()
+	if err != nil {
+		return fmt.Errorf("starting the app: %v", err)
+	}
+	defer func() {
+		s.disconnect()
+		if stopErr := s.cfg.Stop(); stopErr != nil && err == nil {
+			err = fmt.Errorf("stopping the app: %v", stopErr)
+		}
+	}()
+	if err := s.connect(addr); err != nil {
+		return err
+	}
+	return check(s)
+}
+
+// restart restarts the app, keeping its state.
+func (s *suite) restart() error {
+	s.disconnect()
+	addr, err := s.cfg.Restart()
+	if err != nil {
+		return fmt.Errorf("restarting the app: %v", err)
+	}
+	return s.connect(addr)
+}
+
+func (s *suite) connect(addr string) error {
+	client, err := abcicli.NewClient(addr, s.cfg.Transport, true)
+	if err != nil {
+		return err
+	}
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("connecting to the app: %v", err)
+	}
+	s.client = client
+	return nil
+}
+
+func (s *suite) disconnect() {
+	if s.client != nil && s.client.IsRunning() {
+		s.client.Stop()
+	}
+	s.client = nil
+}
+
+// initChain sends InitChain with validators, and checks the validators
+// returned by the app against them.
+func (s *suite) initChain(validators []types.ValidatorUpdate) error {
+	params := tmtypes.TM2PB.ConsensusParams(tmtypes.DefaultConsensusParams())
+	params.Validator.PubKeyTypes = s.cfg.PubKeyTypes
+	res, err := s.client.InitChainSync(types.RequestInitChain{
+		ChainId:         chainID,
+		ConsensusParams: params,
+		Validators:      validators,
+	})
+	if err != nil {
+		return fmt.Errorf("InitChain: %v", err)
+	}
+	s.pubKeyTypes = s.cfg.PubKeyTypes
+	if res.ConsensusParams != nil && res.ConsensusParams.Validator != nil &&
+		len(res.ConsensusParams.Validator.PubKeyTypes) > 0 {
+		s.pubKeyTypes = res.ConsensusParams.Validator.PubKeyTypes
+	}
+	if err := s.checkValidatorUpdates(res.Validators); err != nil {
+		return fmt.Errorf("InitChain: %v", err)
+	}
+	// The app either keeps the validators it was given, or returns them.
+	if len(res.Validators) > 0 {
+		if err := sameValidators(validators, res.Validators); err != nil {
+			return fmt.Errorf("InitChain: %v", err)
+		}
+	}
+	return nil
+}
+
+// execBlocks executes the blocks from height from, and returns their app
+// hashes.
+func (s *suite) execBlocks(from int64, blocks [][][]byte) ([][]byte, error) {
+	hashes := make([][]byte, len(blocks))
+	for i, txs := range blocks {
+		height := from + int64(i)
+		_, err := s.client.BeginBlockSync(types.RequestBeginBlock{
+			Header: types.Header{ChainID: chainID, Height: height},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("BeginBlock at height %d: %v", height, err)
+		}
+		for j, tx := range txs {
+			res, err := s.client.DeliverTxSync(types.RequestDeliverTx{Tx: tx})
+			if err != nil {
+				return nil, fmt.Errorf("DeliverTx at height %d: %v", height, err)
+			}
+			if res.IsErr() {
+				return nil, fmt.Errorf("tx %d at height %d was rejected with code %d: %s", j, height, res.Code, res.Log)
+			}
+		}
+		res, err := s.client.EndBlockSync(types.RequestEndBlock{Height: height})
+		if err != nil {
+			return nil, fmt.Errorf("EndBlock at height %d: %v", height, err)
+		}
+		if err := s.checkValidatorUpdates(res.ValidatorUpdates); err != nil {
+			return nil, fmt.Errorf("EndBlock at height %d: %v", height, err)
+		}
+		commit, err := s.client.CommitSync()
+		if err != nil {
+			return nil, fmt.Errorf("commit at height %d: %v", height, err)
+		}
+		hashes[i] = commit.Data
+	}
+	return hashes, nil
+}
+
+// checkInfo checks that the app reports height as its last block height, and
+// appHash as its hash.
+func (s *suite) checkInfo(height int64, appHash []byte) error {
+	res, err := s.client.InfoSync(types.RequestInfo{})
+	if err != nil {
+		return fmt.Errorf("info: %v", err)
+	}
+	if res.LastBlockHeight != height {
+		return fmt.Errorf("info: expected last block height %d, got %d", height, res.LastBlockHeight)
+	}
+	if !bytes.Equal(res.LastBlockAppHash, appHash) {
+		return fmt.Errorf("info: expected last block app hash %X, got %X", appHash, res.LastBlockAppHash)
+	}
+	return nil
+}
+
+// checkValidatorUpdates checks that the validators added or updated have a key
+// of a type the chain accepts, of the right size, and for BLS12-381 keys a
+// valid proof of possession. Validators being removed may have any key.
+func (s *suite) checkValidatorUpdates(updates []types.ValidatorUpdate) error {
+	for _, v := range updates {
+		if v.Power < 0 {
+			return fmt.Errorf("validator %X has negative power %d", v.PubKey.Data, v.Power)
+		}
+		if v.Power == 0 {
+			continue
+		}
+		if !s.acceptsPubKeyType(v.PubKey.Type) {
+			return fmt.Errorf("validator %X has key type %q, the chain accepts %v",
+				v.PubKey.Data, v.PubKey.Type, s.pubKeyTypes)
+		}
+		if _, err := tmtypes.PB2TM.ValidatorUpdates([]types.ValidatorUpdate{v}); err != nil {
+			return fmt.Errorf("validator %X: %v", v.PubKey.Data, err)
+		}
+	}
+	return nil
+}
+
+func (s *suite) acceptsPubKeyType(keyType string) bool {
+	for _, t := range s.pubKeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
+
+// sameValidators checks that got has the validators of expected, in any
+// order.
+func sameValidators(expected, got []types.ValidatorUpdate) error {
+	if len(got) != len(expected) {
+		return fmt.Errorf("expected %d validators, got %d", len(expected), len(got))
+	}
+	powers := make(map[string]int64, len(expected))
+	for _, v := range expected {
+		powers[v.PubKey.Type+string(v.PubKey.Data)] = v.Power
+	}
+	for _, v := range got {
+		power, ok := powers[v.PubKey.Type+string(v.PubKey.Data)]
+		if !ok {
+			return fmt.Errorf("validator %X (%s) wasn't in the request", v.PubKey.Data, v.PubKey.Type)
+		}
+		if v.Power != power {
+			return fmt.Errorf("validator %X has power %d, expected %d", v.PubKey.Data, v.Power, power)
+		}
+	}
+	return nil
+}
+
+// newValidator returns an update adding a validator with a new key of
+// keyType.
+func newValidator(keyType string, power int64) types.ValidatorUpdate {
+	var priv crypto.PrivKey
+	switch keyType {
+	case tmtypes.ABCIPubKeyTypeEd25519:
+		priv = ed25519.GenPrivKey()
+	case tmtypes.ABCIPubKeyTypeSr25519:
+		priv = sr25519.GenPrivKey()
+	case tmtypes.ABCIPubKeyTypeSecp256k1:
+		priv = secp256k1.GenPrivKey()
+	case tmtypes.ABCIPubKeyTypeBls12381:
+		blsPriv := bls12381.GenPrivKey()
+		pubKey := blsPriv.PubKey().(bls12381.PubKeyBls12381)
+		return types.Bls12381ValidatorUpdate(pubKey[:], blsPriv.ProofOfPossession(), power)
+	default:
+		panic(fmt.Sprintf("unknown key type %q", keyType))
+	}
+	return types.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(priv.PubKey()), Power: power}
+}
+
+//----------------------------------------
+
+func (s *suite) checkHandshake() error {
+	msg := "conformance"
+	echo, err := s.client.EchoSync(msg)
+	if err != nil {
+		return fmt.Errorf("echo: %v", err)
+	}
+	if echo.Message != msg {
+		return fmt.Errorf("echo: expected %q, got %q", msg, echo.Message)
+	}
+
+	// A fresh app must ask for InitChain.
+	if err := s.checkInfo(0, nil); err != nil {
+		return err
+	}
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	hashes, err := s.execBlocks(1, s.cfg.Blocks[:1])
+	if err != nil {
+		return err
+	}
+	return s.checkInfo(1, hashes[0])
+}
+
+func (s *suite) checkInitChain() error {
+	validators := make([]types.ValidatorUpdate, len(s.cfg.PubKeyTypes))
+	for i, keyType := range s.cfg.PubKeyTypes {
+		validators[i] = newValidator(keyType, int64(i+1))
+	}
+	return s.initChain(validators)
+}
+
+func (s *suite) checkDeterministicCommit() error {
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	expected, err := s.execBlocks(1, s.cfg.Blocks)
+	if err != nil {
+		return err
+	}
+
+	// Execute the same blocks on a fresh app.
+	s.disconnect()
+	if err := s.cfg.Stop(); err != nil {
+		return fmt.Errorf("stopping the app: %v", err)
+	}
+	addr, err := s.cfg.Start()
+	if err != nil {
+		return fmt.Errorf("starting the app: %v", err)
+	}
+	if err := s.connect(addr); err != nil {
+		return err
+	}
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	hashes, err := s.execBlocks(1, s.cfg.Blocks)
+	if err != nil {
+		return err
+	}
+	for i := range hashes {
+		if !bytes.Equal(hashes[i], expected[i]) {
+			return fmt.Errorf("app hash at height %d is %X on the first run, %X on the second", i+1, expected[i], hashes[i])
+		}
+	}
+	return nil
+}
+
+func (s *suite) checkCommitAcrossRestart() error {
+	if s.cfg.Restart == nil {
+		return skipError{"the app can't be restarted"}
+	}
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	half := len(s.cfg.Blocks) / 2
+	hashes, err := s.execBlocks(1, s.cfg.Blocks[:half])
+	if err != nil {
+		return err
+	}
+	if err := s.restart(); err != nil {
+		return err
+	}
+	if err := s.checkInfo(int64(half), hashes[half-1]); err != nil {
+		return fmt.Errorf("after restart: %v", err)
+	}
+	rest, err := s.execBlocks(int64(half)+1, s.cfg.Blocks[half:])
+	if err != nil {
+		return err
+	}
+	hashes = append(hashes, rest...)
+
+	// Compare with the hashes of an app which wasn't restarted.
+	s.disconnect()
+	if err := s.cfg.Stop(); err != nil {
+		return fmt.Errorf("stopping the app: %v", err)
+	}
+	addr, err := s.cfg.Start()
+	if err != nil {
+		return fmt.Errorf("starting the app: %v", err)
+	}
+	if err := s.connect(addr); err != nil {
+		return err
+	}
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	expected, err := s.execBlocks(1, s.cfg.Blocks)
+	if err != nil {
+		return err
+	}
+	for i := range hashes {
+		if !bytes.Equal(hashes[i], expected[i]) {
+			return fmt.Errorf("app hash at height %d is %X with a restart at height %d, %X without",
+				i+1, hashes[i], half, expected[i])
+		}
+	}
+	return nil
+}
+
+func (s *suite) checkQueryProof() error {
+	if s.cfg.Query == nil {
+		return skipError{"no query to make"}
+	}
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	hashes, err := s.execBlocks(1, s.cfg.Blocks)
+	if err != nil {
+		return err
+	}
+	appHash := hashes[len(hashes)-1]
+
+	req := *s.cfg.Query
+	req.Prove = true
+	res, err := s.client.QuerySync(req)
+	if err != nil {
+		return fmt.Errorf("query: %v", err)
+	}
+	if res.IsErr() {
+		return fmt.Errorf("query failed with code %d: %s", res.Code, res.Log)
+	}
+	if res.Proof == nil || len(res.Proof.Ops) == 0 {
+		return skipError{"the app returned no proof"}
+	}
+	if res.Height != 0 && res.Height != int64(len(hashes)) {
+		return fmt.Errorf("query: expected height %d, got %d", len(hashes), res.Height)
+	}
+
+	ops, err := s.cfg.ProofRuntime.DecodeProof(res.Proof)
+	if err != nil {
+		return fmt.Errorf("decoding proof: %v", err)
+	}
+	var args [][]byte
+	if len(res.Value) > 0 {
+		args = [][]byte{res.Value}
+	}
+	for i, op := range ops {
+		args, err = op.Run(args)
+		if err != nil {
+			return fmt.Errorf("proof op %d (key %X): %v", i, op.GetKey(), err)
+		}
+	}
+	if len(args) != 1 || !bytes.Equal(args[0], appHash) {
+		return fmt.Errorf("proof doesn't lead to the app hash %X", appHash)
+	}
+	return nil
+}
+
+func (s *suite) checkRecheck() error {
+	if err := s.initChain(nil); err != nil {
+		return err
+	}
+	pending := append(append([][]byte{}, s.cfg.Blocks[1]...), s.cfg.Blocks[2]...)
+	for _, tx := range pending {
+		res, err := s.client.CheckTxSync(types.RequestCheckTx{Tx: tx, Type: types.CheckTxType_New})
+		if err != nil {
+			return fmt.Errorf("CheckTx: %v", err)
+		}
+		if res.IsErr() {
+			return fmt.Errorf("valid tx %X was rejected with code %d: %s", tx, res.Code, res.Log)
+		}
+	}
+
+	// Once the block with the first txs is committed, the others are still
+	// valid.
+	if _, err := s.execBlocks(1, s.cfg.Blocks[:2]); err != nil {
+		return err
+	}
+	for _, tx := range s.cfg.Blocks[2] {
+		res, err := s.client.CheckTxSync(types.RequestCheckTx{Tx: tx, Type: types.CheckTxType_Recheck})
+		if err != nil {
+			return fmt.Errorf("CheckTx: %v", err)
+		}
+		if res.IsErr() {
+			return fmt.Errorf("tx %X was rejected on recheck with code %d: %s", tx, res.Code, res.Log)
+		}
+	}
+	return nil
+}
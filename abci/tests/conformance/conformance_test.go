@@ -0,0 +1,187 @@
+package conformance
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/counter"
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	"github.com/tendermint/tendermint/abci/server"
+	"github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+// inProcessApp serves an app in-process. Restarting it restarts the server
+// only, so the app keeps its state.
+type inProcessApp struct {
+	t         *testing.T
+	transport string
+	newApp    func() types.Application
+
+	app    types.Application
+	server service.Service
+	addr   string
+}
+
+func (a *inProcessApp) Start() (string, error) {
+	a.app = a.newApp()
+	return a.serve()
+}
+
+func (a *inProcessApp) Restart() (string, error) {
+	if err := a.Stop(); err != nil {
+		return "", err
+	}
+	return a.serve()
+}
+
+func (a *inProcessApp) Stop() error {
+	return a.server.Stop()
+}
+
+func (a *inProcessApp) serve() (string, error) {
+	// some port between 20k and 30k
+	a.addr = fmt.Sprintf("tcp://127.0.0.1:%d", 20000+tmrand.Int32()%10000)
+	s, err := server.NewServer(a.addr, a.transport, a.app)
+	require.NoError(a.t, err)
+	a.server = s
+	return a.addr, s.Start()
+}
+
+func kvstoreBlocks() [][][]byte {
+	return [][][]byte{
+		{[]byte("a=1")},
+		{[]byte("b=2"), []byte("c=3")},
+		{[]byte("d=4")},
+		{[]byte("e=5")},
+	}
+}
+
+func TestConformanceKVStore(t *testing.T) {
+	for _, transport := range []string{"socket", "grpc"} {
+		transport := transport
+		t.Run(transport, func(t *testing.T) {
+			app := &inProcessApp{t: t, transport: transport, newApp: func() types.Application {
+				return kvstore.NewApplication()
+			}}
+			results, err := Run(Config{
+				Transport: transport,
+				Start:     app.Start,
+				Restart:   app.Restart,
+				Stop:      app.Stop,
+				Blocks:    kvstoreBlocks(),
+				Query:     &types.RequestQuery{Path: "/store", Data: []byte("a")},
+			})
+			require.NoError(t, err)
+
+			buf := new(bytes.Buffer)
+			assert.NoError(t, Report(buf, results), buf.String())
+			for _, r := range results {
+				if r.Name == "QueryProof" {
+					// the kvstore app doesn't return proofs
+					assert.True(t, r.Skipped)
+				} else {
+					assert.True(t, r.Passed(), r.String())
+				}
+			}
+		})
+	}
+}
+
+func TestConformanceReportsFailures(t *testing.T) {
+	// The counter app doesn't report its last block height.
+	app := &inProcessApp{t: t, transport: "socket", newApp: func() types.Application {
+		return counter.NewApplication(false)
+	}}
+	results, err := Run(Config{
+		Transport: "socket",
+		Start:     app.Start,
+		Stop:      app.Stop,
+		Blocks:    [][][]byte{{{0x00}}, {{0x01}}, {{0x02}}},
+	})
+	require.NoError(t, err)
+
+	byName := make(map[string]Result)
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+	assert.False(t, byName["Handshake"].Passed())
+	assert.Contains(t, byName["Handshake"].Err.Error(), "last block height")
+	assert.True(t, byName["CommitAcrossRestart"].Skipped)
+	assert.True(t, byName["DeterministicCommit"].Passed(), byName["DeterministicCommit"].String())
+	assert.Error(t, Report(new(bytes.Buffer), results))
+}
+
+// initChainApp is a kvstore app returning the validators computed by
+// validators from those of InitChain.
+type initChainApp struct {
+	*kvstore.Application
+	validators func([]types.ValidatorUpdate) []types.ValidatorUpdate
+}
+
+func (app initChainApp) InitChain(req types.RequestInitChain) types.ResponseInitChain {
+	app.Application.InitChain(req)
+	return types.ResponseInitChain{Validators: app.validators(req.Validators)}
+}
+
+func TestConformanceInitChainValidators(t *testing.T) {
+	testCases := []struct {
+		name       string
+		validators func([]types.ValidatorUpdate) []types.ValidatorUpdate
+		err        string
+	}{
+		{"same validators", func(vals []types.ValidatorUpdate) []types.ValidatorUpdate {
+			return vals
+		}, ""},
+		{"missing validator", func(vals []types.ValidatorUpdate) []types.ValidatorUpdate {
+			return vals[1:]
+		}, "expected 4 validators"},
+		{"other power", func(vals []types.ValidatorUpdate) []types.ValidatorUpdate {
+			vals = append([]types.ValidatorUpdate{}, vals...)
+			vals[0].Power++
+			return vals
+		}, "has power"},
+		{"no proof of possession", func(vals []types.ValidatorUpdate) []types.ValidatorUpdate {
+			vals = append([]types.ValidatorUpdate{}, vals...)
+			for i := range vals {
+				vals[i].ProofOfPossession = nil
+			}
+			return vals
+		}, "proof of possession"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := &inProcessApp{t: t, transport: "socket", newApp: func() types.Application {
+				return initChainApp{kvstore.NewApplication(), tc.validators}
+			}}
+			results, err := Run(Config{
+				Transport: "socket",
+				Start:     app.Start,
+				Stop:      app.Stop,
+				Blocks:    kvstoreBlocks(),
+			})
+			require.NoError(t, err)
+			for _, r := range results {
+				if r.Name != "InitChain" {
+					continue
+				}
+				if tc.err == "" {
+					assert.True(t, r.Passed(), r.String())
+				} else if assert.Error(t, r.Err) {
+					assert.Contains(t, r.Err.Error(), tc.err)
+				}
+			}
+		})
+	}
+}
+
+func TestConformanceUnknownKeyType(t *testing.T) {
+	_, err := Run(Config{Blocks: kvstoreBlocks(), PubKeyTypes: []string{"rsa"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"time"
+
+	tmnet "github.com/tendermint/tendermint/libs/net"
+)
+
+const (
+	processStartTimeout = 10 * time.Second
+	processStopTimeout  = 5 * time.Second
+)
+
+// Process runs the app under test as a separate process, so any app can be
+// checked. Its functions can be used in a Config:
+//
+//	p := &conformance.Process{Command: "abci-cli kvstore", Addr: "tcp://127.0.0.1:26658"}
+//	cfg := conformance.Config{Start: p.Start, Restart: p.Restart, Stop: p.Stop, ...}
+//
+// The app must keep its state in the directory in the ABCI_HOME environment
+// variable, which is a new empty directory each time it's started afresh.
+type Process struct {
+	// Command starting the app, run with "sh -c".
+	Command string
+	// Addr is the address the app listens on.
+	Addr string
+	// Output receives the output of the app, if set.
+	Output io.Writer
+
+	cmd    *exec.Cmd
+	exited chan struct{}
+	home   string
+}
+
+// Start starts the app with a new home directory.
+func (p *Process) Start() (string, error) {
+	if err := p.Cleanup(); err != nil {
+		return "", err
+	}
+	home, err := ioutil.TempDir("", "abci-conformance")
+	if err != nil {
+		return "", err
+	}
+	p.home = home
+	return p.Addr, p.start()
+}
+
+// Restart stops the app and starts it again with the same home directory.
+func (p *Process) Restart() (string, error) {
+	if err := p.Stop(); err != nil {
+		return "", err
+	}
+	return p.Addr, p.start()
+}
+
+// Stop stops the app, killing it if it doesn't exit in time.
+func (p *Process) Stop() error {
+	if p.cmd == nil {
+		return nil
+	}
+	defer func() { p.cmd = nil }()
+
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		return nil // already exited
+	}
+	select {
+	case <-p.exited:
+		return nil
+	case <-time.After(processStopTimeout):
+		if err := p.cmd.Process.Kill(); err != nil {
+			return err
+		}
+		<-p.exited
+		return nil
+	}
+}
+
+// Cleanup removes the home directory of the app, which must be stopped.
+func (p *Process) Cleanup() error {
+	if p.home == "" {
+		return nil
+	}
+	home := p.home
+	p.home = ""
+	return os.RemoveAll(home)
+}
+
+// start starts the app and waits until it accepts connections.
+func (p *Process) start() error {
+	if p.cmd != nil {
+		return errors.New("the app is already running")
+	}
+	cmd := exec.Command("sh", "-c", "exec "+p.Command)
+	cmd.Env = append(os.Environ(), "ABCI_HOME="+p.home)
+	cmd.Stdout = p.Output
+	cmd.Stderr = p.Output
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	exited := make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(exited)
+	}()
+	p.cmd = cmd
+	p.exited = exited
+
+	deadline := time.Now().Add(processStartTimeout)
+	for {
+		conn, err := tmnet.Connect(p.Addr)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		select {
+		case <-exited:
+			p.cmd = nil
+			return fmt.Errorf("the app exited: %v", cmd.ProcessState)
+		case <-time.After(50 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			p.Stop()
+			return fmt.Errorf("the app didn't listen on %s within %v", p.Addr, processStartTimeout)
+		}
+	}
+}
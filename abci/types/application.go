@@ -23,6 +23,17 @@ type Application interface {
 	DeliverTx(RequestDeliverTx) ResponseDeliverTx    // Deliver a tx for full processing
 	EndBlock(RequestEndBlock) ResponseEndBlock       // Signals the end of a block, returns changes to the validator set
 	Commit() ResponseCommit                          // Commit the state and return the application Merkle root hash
+
+	// Block construction and validation (PrepareProposal is only called on the proposer)
+	PrepareProposal(RequestPrepareProposal) ResponsePrepareProposal // Choose the txs to include in a proposal block
+	ProcessProposal(RequestProcessProposal) ResponseProcessProposal // Accept or reject a proposal block before prevoting
+
+	// Vote extensions
+	ExtendVote(RequestExtendVote) ResponseExtendVote                            // Attach app data to our precommit
+	VerifyVoteExtension(RequestVerifyVoteExtension) ResponseVerifyVoteExtension // Validate the data attached to another validator's precommit
+
+	// Chain upgrades
+	ExportState(RequestExportState) ResponseExportState // Export the app state for the genesis of a new chain
 }
 
 //-------------------------------------------------------
@@ -73,6 +84,26 @@ func (BaseApplication) EndBlock(req RequestEndBlock) ResponseEndBlock {
 	return ResponseEndBlock{}
 }
 
+func (BaseApplication) PrepareProposal(req RequestPrepareProposal) ResponsePrepareProposal {
+	return ResponsePrepareProposal{Txs: req.Txs}
+}
+
+func (BaseApplication) ProcessProposal(req RequestProcessProposal) ResponseProcessProposal {
+	return ResponseProcessProposal{Status: ProposalStatus_Accept}
+}
+
+func (BaseApplication) ExtendVote(req RequestExtendVote) ResponseExtendVote {
+	return ResponseExtendVote{}
+}
+
+func (BaseApplication) VerifyVoteExtension(req RequestVerifyVoteExtension) ResponseVerifyVoteExtension {
+	return ResponseVerifyVoteExtension{Status: ResponseVerifyVoteExtension_Accept}
+}
+
+func (BaseApplication) ExportState(req RequestExportState) ResponseExportState {
+	return ResponseExportState{}
+}
+
 //-------------------------------------------------------
 
 // GRPCApplication is a GRPC wrapper for Application
@@ -136,3 +167,33 @@ func (app *GRPCApplication) EndBlock(ctx context.Context, req *RequestEndBlock)
 	res := app.app.EndBlock(*req)
 	return &res, nil
 }
+
+func (app *GRPCApplication) PrepareProposal(
+	ctx context.Context, req *RequestPrepareProposal) (*ResponsePrepareProposal, error) {
+	res := app.app.PrepareProposal(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ProcessProposal(
+	ctx context.Context, req *RequestProcessProposal) (*ResponseProcessProposal, error) {
+	res := app.app.ProcessProposal(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ExtendVote(
+	ctx context.Context, req *RequestExtendVote) (*ResponseExtendVote, error) {
+	res := app.app.ExtendVote(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) VerifyVoteExtension(
+	ctx context.Context, req *RequestVerifyVoteExtension) (*ResponseVerifyVoteExtension, error) {
+	res := app.app.VerifyVoteExtension(*req)
+	return &res, nil
+}
+
+func (app *GRPCApplication) ExportState(
+	ctx context.Context, req *RequestExportState) (*ResponseExportState, error) {
+	res := app.app.ExportState(*req)
+	return &res, nil
+}
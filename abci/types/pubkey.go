@@ -1,7 +1,8 @@
 package types
 
 const (
-	PubKeyEd25519 = "ed25519"
+	PubKeyEd25519  = "ed25519"
+	PubKeyBls12381 = "bls12_381"
 )
 
 func Ed25519ValidatorUpdate(pubkey []byte, power int64) ValidatorUpdate {
@@ -14,3 +15,16 @@ func Ed25519ValidatorUpdate(pubkey []byte, power int64) ValidatorUpdate {
 		Power: power,
 	}
 }
+
+// Bls12381ValidatorUpdate returns an update of a validator with a BLS12-381
+// key. The proof of possession of the key is required to add it.
+func Bls12381ValidatorUpdate(pubkey, proofOfPossession []byte, power int64) ValidatorUpdate {
+	return ValidatorUpdate{
+		PubKey: PubKey{
+			Type: PubKeyBls12381,
+			Data: pubkey,
+		},
+		Power:             power,
+		ProofOfPossession: proofOfPossession,
+	}
+}
@@ -41,6 +41,26 @@ func (r ResponseQuery) IsErr() bool {
 	return r.Code != CodeTypeOK
 }
 
+// IsAccepted returns true if the app accepted the proposal.
+func (r ResponseProcessProposal) IsAccepted() bool {
+	return r.Status == ProposalStatus_Accept
+}
+
+// IsStatusUnknown returns true if the app did not set a status.
+func (r ResponseProcessProposal) IsStatusUnknown() bool {
+	return r.Status == ProposalStatus_Unknown
+}
+
+// IsAccepted returns true if the app accepted the vote extension.
+func (r ResponseVerifyVoteExtension) IsAccepted() bool {
+	return r.Status == ResponseVerifyVoteExtension_Accept
+}
+
+// IsStatusUnknown returns true if the app did not set a status.
+func (r ResponseVerifyVoteExtension) IsStatusUnknown() bool {
+	return r.Status == ResponseVerifyVoteExtension_Unknown
+}
+
 //---------------------------------------------------------------------------
 // override JSON marshalling so we emit defaults (ie. disable omitempty)
 
@@ -345,6 +345,13 @@ FOR_LOOP:
 				}
 				blocksSynced++
 
+				if bcR.blockExec.IsHalted(state) {
+					// The node is being stopped, don't switch to consensus.
+					bcR.Logger.Info("Halted fast sync", "height", state.LastBlockHeight)
+					bcR.pool.Stop()
+					break FOR_LOOP
+				}
+
 				if blocksSynced%100 == 0 {
 					lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
 					bcR.Logger.Info("Fast Sync Rate", "height", bcR.pool.height,
@@ -295,6 +295,10 @@ ForLoop:
 			}
 		case <-doProcessBlockCh:
 			for {
+				// Once halted, the node is being stopped.
+				if bcR.blockExec.IsHalted(bcR.state) {
+					break
+				}
 				err := bcR.processBlock()
 				if err == errMissingBlock {
 					break
@@ -133,6 +133,10 @@ func (state *pcState) handle(event Event) (Event, error) {
 		return noOp, nil
 
 	case rProcessBlock:
+		// Once halted, the node is being stopped.
+		if state.context.halted() {
+			return noOp, nil
+		}
 		tmState := state.context.tmState()
 		firstItem, secondItem, err := state.nextTwo()
 		if err != nil {
@@ -12,6 +12,7 @@ type processorContext interface {
 	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
 	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 	tmState() state.State
+	halted() bool
 }
 
 type pContext struct {
@@ -38,6 +39,10 @@ func (pc pContext) tmState() state.State {
 	return pc.state
 }
 
+func (pc pContext) halted() bool {
+	return pc.applier.IsHalted(pc.state)
+}
+
 func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error {
 	return pc.state.Validators.VerifyCommit(chainID, blockID, height, commit)
 }
@@ -89,3 +94,7 @@ func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet
 func (mpc *mockPContext) tmState() state.State {
 	return mpc.state
 }
+
+func (mpc *mockPContext) halted() bool {
+	return false
+}
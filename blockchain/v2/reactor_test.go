@@ -81,6 +81,10 @@ func (mba *mockBlockApplier) ApplyBlock(state sm.State, blockID types.BlockID, b
 	return state, nil
 }
 
+func (mba *mockBlockApplier) IsHalted(state sm.State) bool {
+	return false
+}
+
 type mockSwitchIo struct {
 	mtx                 sync.Mutex
 	switchedToConsensus bool
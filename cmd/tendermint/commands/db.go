@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	nm "github.com/tendermint/tendermint/node"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	migrateFrom string
+	migrateTo   string
+)
+
+// DBCmd manages the databases of the node.
+var DBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the databases of the node",
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy the databases to another backend, and switch db_backend to it",
+	Long: `Copy the databases to another backend, and switch db_backend to it.
+
+The blockstore, state, tx_index and evidence databases are copied to the new
+backend in a staging directory in the DB dir, and each copy is verified against
+the original by its number of entries and a checksum. The copies then replace
+the originals, which are kept in a backup directory in the DB dir, and
+db_backend is set to the new backend in config.toml. If the command is
+interrupted before the databases are all swapped and config.toml rewritten,
+the node refuses to start until it's run again to finish the migration.
+
+The backends supported are goleveldb, cleveldb, boltdb and rocksdb: badgerdb
+isn't supported by this version of tm-db. The backends which need a build tag
+(cleveldb, boltdb, rocksdb) must be built in.
+
+The node must be stopped.`,
+	Example: "tendermint db migrate --from goleveldb --to rocksdb",
+	Args:    cobra.NoArgs,
+	RunE:    dbMigrate,
+}
+
+func init() {
+	dbMigrateCmd.Flags().StringVar(&migrateFrom, "from", "", "Backend of the databases (default: db_backend)")
+	dbMigrateCmd.Flags().StringVar(&migrateTo, "to", "", "Backend to migrate the databases to")
+
+	DBCmd.AddCommand(dbMigrateCmd)
+}
+
+func dbMigrate(cmd *cobra.Command, args []string) error {
+	if migrateTo == "" {
+		return errors.New("--to is required")
+	}
+	if migrateFrom == "" {
+		migrateFrom = config.DBBackend
+	}
+
+	_, err := nm.MigrateDBs(config, dbm.BackendType(migrateFrom), dbm.BackendType(migrateTo), logger)
+	return err
+}
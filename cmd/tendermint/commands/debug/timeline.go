@@ -0,0 +1,88 @@
+package debug
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	cs "github.com/tendermint/tendermint/consensus"
+)
+
+var (
+	timelineFormat    string
+	timelineOut       string
+	timelineMinHeight int64
+	timelineMaxHeight int64
+
+	flagFormat    = "format"
+	flagOut       = "out"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+)
+
+var timelineCmd = &cobra.Command{
+	Use:   "timeline [trace-file...]",
+	Short: "Merge the consensus trace files of several nodes into a per-height timeline",
+	Long: `Merge the consensus trace files of several nodes, written with
+consensus.trace_file, into a timeline of each height: when each node entered
+each step, timed out, received the proposal, and the table of all the events,
+including the block parts and votes with the peers they were received from or
+sent to. The clocks of the nodes are assumed to be synchronized.
+
+Example:
+$ tendermint debug timeline node0/data/cs.trace node1/data/cs.trace --out timeline.html`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: timelineCmdHandler,
+}
+
+func init() {
+	timelineCmd.Flags().StringVar(&timelineFormat, flagFormat, "html", "the format of the timeline: html or json")
+	timelineCmd.Flags().StringVar(&timelineOut, flagOut, "", "the file to write the timeline to (default stdout)")
+	timelineCmd.Flags().Int64Var(&timelineMinHeight, flagMinHeight, 0, "the first height of the timeline (0 for the first traced)")
+	timelineCmd.Flags().Int64Var(&timelineMaxHeight, flagMaxHeight, 0, "the last height of the timeline (0 for the last traced)")
+}
+
+func timelineCmdHandler(cmd *cobra.Command, args []string) error {
+	var write func(*cs.Timeline, io.Writer) error
+	switch timelineFormat {
+	case "html":
+		write = (*cs.Timeline).WriteHTML
+	case "json":
+		write = (*cs.Timeline).WriteJSON
+	default:
+		return fmt.Errorf("unknown format %q, must be html or json", timelineFormat)
+	}
+
+	var events []cs.TraceEvent
+	for _, traceFile := range args {
+		fileEvents, err := cs.ReadTraceFile(traceFile)
+		if err != nil {
+			if len(fileEvents) == 0 {
+				return errors.Wrapf(err, "failed to read %s", traceFile)
+			}
+			logger.Error("Failed to read the end of the trace file", "file", traceFile, "err", err)
+		}
+		events = append(events, fileEvents...)
+	}
+	timeline := cs.NewTimeline(events, timelineMinHeight, timelineMaxHeight)
+
+	if timelineOut == "" {
+		return write(timeline, os.Stdout)
+	}
+	f, err := os.Create(timelineOut)
+	if err != nil {
+		return err
+	}
+	if err := write(timeline, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	logger.Info("Wrote the timeline", "heights", len(timeline.Heights), "nodes", len(timeline.Nodes), "file", timelineOut)
+	return nil
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	nm "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+)
+
+var (
+	exportChainID string
+	exportOutput  string
+)
+
+// ExportGenesisCmd exports the genesis of a new chain continuing from the
+// state of a halted node.
+var ExportGenesisCmd = &cobra.Command{
+	Use:   "export-genesis",
+	Short: "Export the genesis of a new chain continuing from the current state",
+	Long: `Export the genesis of a new chain continuing from the current state,
+for a hard-fork upgrade once the node halted at consensus.halt_height or
+consensus.halt_time.
+
+The genesis has the validators and consensus params of the next height, and
+the app state returned by the app for the ExportState ABCI request. The app
+must be at the same height as the state; it's started like for "tendermint
+node" if it's built in (see --proxy_app).
+
+The node must be stopped.`,
+	Args: cobra.NoArgs,
+	RunE: exportGenesis,
+}
+
+func init() {
+	ExportGenesisCmd.Flags().StringVar(&exportChainID, "chain-id", "",
+		"Chain ID of the new chain (default: the current one)")
+	ExportGenesisCmd.Flags().StringVar(&exportOutput, "output", "",
+		"File to write the genesis to (default: stdout)")
+	ExportGenesisCmd.Flags().String("proxy_app", config.ProxyApp,
+		"Proxy app address, or one of: 'kvstore', 'persistent_kvstore', 'counter', 'counter_serial' or 'noop'")
+	ExportGenesisCmd.Flags().String("abci", config.ABCI, "Specify abci transport (socket | grpc | grpc-stream)")
+}
+
+func exportGenesis(cmd *cobra.Command, args []string) error {
+	if config.Consensus.PipelinedExecution {
+		return errors.New("export-genesis is not supported with consensus.pipelined_execution")
+	}
+
+	// Fails if the node is running, as it holds the database lock.
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	state := sm.LoadState(stateDB)
+	if state.IsEmpty() {
+		return errors.New("no state found")
+	}
+
+	clientCreator := proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir(), config.ABCIConcurrency)
+	client, err := clientCreator.NewABCIClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the app client: %v", err)
+	}
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("failed to connect to the app: %v", err)
+	}
+	defer client.Stop()
+
+	info, err := client.InfoSync(abci.RequestInfo{})
+	if err != nil {
+		return fmt.Errorf("info request failed: %v", err)
+	}
+	if info.LastBlockHeight != state.LastBlockHeight {
+		return fmt.Errorf("the app is at height %d, but the state is at height %d",
+			info.LastBlockHeight, state.LastBlockHeight)
+	}
+	res, err := client.ExportStateSync(abci.RequestExportState{Height: state.LastBlockHeight})
+	if err != nil {
+		return fmt.Errorf("export state request failed: %v", err)
+	}
+
+	genDoc := state.ExportGenesis(res.AppState)
+	if exportChainID != "" {
+		genDoc.ChainID = exportChainID
+	}
+
+	// The proofs of possession of BLS12-381 keys aren't kept in the state.
+	missingProofs := false
+	for _, val := range genDoc.Validators {
+		if _, ok := val.PubKey.(bls12381.PubKeyBls12381); ok {
+			fmt.Fprintf(os.Stderr, "Add the proof_of_possession of validator %X to the genesis\n", val.Address)
+			missingProofs = true
+		}
+	}
+	if !missingProofs {
+		if err := genDoc.ValidateAndComplete(); err != nil {
+			return fmt.Errorf("invalid genesis: %v", err)
+		}
+	}
+
+	if exportOutput != "" {
+		if err := genDoc.SaveAs(exportOutput); err != nil {
+			return err
+		}
+		logger.Info("Exported the genesis", "height", state.LastBlockHeight, "path", exportOutput)
+		return nil
+	}
+	bz, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
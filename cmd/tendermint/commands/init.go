@@ -30,7 +30,11 @@ func initFilesWithConfig(config *cfg.Config) error {
 	privValStateFile := config.PrivValidatorStateFile()
 	var pv *privval.FilePV
 	if tmos.FileExists(privValKeyFile) {
-		pv = privval.LoadFilePV(privValKeyFile, privValStateFile)
+		passphrase, err := currentPassphrase()
+		if err != nil {
+			return err
+		}
+		pv = privval.LoadFilePVWithPassphrase(privValKeyFile, privValStateFile, passphrase)
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	tmos "github.com/tendermint/tendermint/libs/os"
+	nm "github.com/tendermint/tendermint/node"
+)
+
+// InspectCmd serves the read-only RPC routes from the databases of a stopped
+// node.
+var InspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Serve the block, state and tx index RPC routes of a stopped node",
+	Long: `Serve the block, state and tx index RPC routes of a stopped or crashed
+node, from its databases opened read-only, without starting the app,
+consensus or the p2p layer.
+
+The routes served are: ` + strings.Join(nm.InspectRoutes, ", ") + `.
+
+Only goleveldb databases can be opened read-only, so the other backends are
+refused. The node must be stopped.`,
+	Args: cobra.NoArgs,
+	RunE: inspect,
+}
+
+func init() {
+	InspectCmd.Flags().String("rpc.laddr", config.RPC.ListenAddress, "RPC listen address. Port required")
+}
+
+func inspect(cmd *cobra.Command, args []string) error {
+	// Fails if the node is running, as it holds the database locks.
+	ins, err := nm.NewInspector(config, nm.ReadOnlyDBProvider, logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the databases: %v", err)
+	}
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	tmos.TrapSignal(logger, func() {
+		if ins.IsRunning() {
+			ins.Stop()
+		}
+	})
+
+	if err := ins.Start(); err != nil {
+		return fmt.Errorf("failed to start the inspector: %v", err)
+	}
+	logger.Info("Serving the databases read-only", "laddr", config.RPC.ListenAddress)
+
+	// Run forever.
+	select {}
+}
@@ -0,0 +1,499 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/keystore"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	nm "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	sm "github.com/tendermint/tendermint/state"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+var (
+	newPassphraseSource    string
+	importPassphraseSource string
+	keysNodeKey            bool
+	keysForce              bool
+)
+
+// KeysCmd manages the encryption of the validator and node keys.
+var KeysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Encrypt, decrypt, export, import and rotate the validator and node keys",
+	Long: `Encrypt, decrypt, export, import and rotate the validator and node keys.
+
+Encrypted keys are decrypted at startup with the passphrase read from
+key_passphrase in config.toml: "prompt" asks on the terminal, "env:<VAR>" reads
+the environment variable <VAR> and "fd:<N>" reads the open file descriptor <N>.
+Both keys are encrypted with the same passphrase.
+
+New passphrases are read from --new-passphrase, which takes the same values.
+"prompt" asks for them twice.`,
+}
+
+var keysEncryptCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt the validator and node keys with a passphrase",
+	RunE:  keysEncrypt,
+}
+
+var keysDecryptCmd = &cobra.Command{
+	Use:   "decrypt",
+	Short: "Decrypt the validator and node keys, and save them in plaintext",
+	RunE:  keysDecrypt,
+}
+
+var keysChangePassphraseCmd = &cobra.Command{
+	Use:   "change-passphrase",
+	Short: "Change the passphrase the validator and node keys are encrypted with",
+	RunE:  keysChangePassphrase,
+}
+
+var keysExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the validator key (or node key, with --node) armored and encrypted with a new passphrase",
+	Args:  cobra.NoArgs,
+	RunE:  keysExport,
+}
+
+var keysImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import an armored key printed by export as the validator key (or node key, with --node)",
+	Long: `Import an armored key printed by export as the validator key (or node key, with --node).
+
+The key is saved encrypted with the passphrase of the other key, if encrypted,
+or else with a new passphrase.
+
+WARNING: a validator key must never be used by two nodes at the same time,
+as they would double sign.`,
+	Args: cobra.ExactArgs(1),
+	RunE: keysImport,
+}
+
+var keysRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate the validator key to a new key, keeping the validator's address and voting power",
+	Long: `Rotate the validator key to a new key, keeping the validator's address and voting power.
+
+The node must be stopped. A new key of the same type is generated, and the
+rotation, signed by both keys, is saved in the validator key file. The node
+submits it when it starts, and switches to the new key two blocks after the
+rotation is committed.`,
+	Args: cobra.NoArgs,
+	RunE: keysRotate,
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{keysEncryptCmd, keysChangePassphraseCmd, keysExportCmd, keysImportCmd} {
+		cmd.Flags().StringVar(&newPassphraseSource, "new-passphrase", keystore.SourcePrompt,
+			"Where to read the new passphrase from: prompt | env:<VAR> | fd:<N>")
+	}
+	for _, cmd := range []*cobra.Command{keysExportCmd, keysImportCmd} {
+		cmd.Flags().BoolVar(&keysNodeKey, "node", false, "Use the node key instead of the validator key")
+	}
+	keysImportCmd.Flags().StringVar(&importPassphraseSource, "passphrase", keystore.SourcePrompt,
+		"Where to read the passphrase of the imported key from: prompt | env:<VAR> | fd:<N>")
+	keysImportCmd.Flags().BoolVar(&keysForce, "force", false, "Overwrite an existing key")
+	keysRotateCmd.Flags().BoolVar(&keysForce, "force", false, "Replace a pending key rotation")
+
+	KeysCmd.AddCommand(keysEncryptCmd)
+	KeysCmd.AddCommand(keysDecryptCmd)
+	KeysCmd.AddCommand(keysChangePassphraseCmd)
+	KeysCmd.AddCommand(keysExportCmd)
+	KeysCmd.AddCommand(keysImportCmd)
+	KeysCmd.AddCommand(keysRotateCmd)
+}
+
+// keyFile is the validator or node key file.
+type keyFile struct {
+	name string
+	path string
+	load func(passphrase keystore.PassphraseFunc) (crypto.PrivKey, error)
+	// save saves the key in plaintext if the passphrase is empty.
+	save func(privKey crypto.PrivKey, passphrase string) error
+}
+
+func validatorKeyFile() keyFile {
+	path := config.PrivValidatorKeyFile()
+	// The loaded key, saved again as is to keep its key rotation.
+	var loaded *privval.FilePVKey
+	return keyFile{
+		name: "validator key",
+		path: path,
+		load: func(passphrase keystore.PassphraseFunc) (crypto.PrivKey, error) {
+			pvKey, err := privval.LoadFilePVKey(path, passphrase)
+			if err != nil {
+				return nil, err
+			}
+			loaded = &pvKey
+			return pvKey.PrivKey, nil
+		},
+		save: func(privKey crypto.PrivKey, passphrase string) error {
+			if loaded != nil && loaded.PrivKey.Equals(privKey) {
+				loaded.SetPassphrase(passphrase)
+				loaded.Save()
+				return nil
+			}
+			pv := privval.NewFilePV(privKey, path, config.PrivValidatorStateFile())
+			pv.Key.SetPassphrase(passphrase)
+			if !tmos.FileExists(config.PrivValidatorStateFile()) {
+				pv.Save()
+			} else {
+				pv.Key.Save()
+			}
+			return nil
+		},
+	}
+}
+
+func nodeKeyFile() keyFile {
+	path := config.NodeKeyFile()
+	return keyFile{
+		name: "node key",
+		path: path,
+		load: func(passphrase keystore.PassphraseFunc) (crypto.PrivKey, error) {
+			nodeKey, err := p2p.LoadNodeKeyWithPassphrase(path, passphrase)
+			if err != nil {
+				return nil, err
+			}
+			return nodeKey.PrivKey, nil
+		},
+		save: func(privKey crypto.PrivKey, passphrase string) error {
+			nodeKey := &p2p.NodeKey{PrivKey: privKey}
+			if passphrase == "" {
+				return nodeKey.SaveAs(path)
+			}
+			return nodeKey.SaveEncryptedAs(path, passphrase)
+		},
+	}
+}
+
+func (kf keyFile) exists() bool {
+	return tmos.FileExists(kf.path)
+}
+
+func (kf keyFile) encrypted() (bool, error) {
+	bz, err := ioutil.ReadFile(kf.path)
+	if err != nil {
+		return false, err
+	}
+	return keystore.IsArmored(bz), nil
+}
+
+// keyFiles returns the key files that exist, and whether they are encrypted.
+func keyFiles() ([]keyFile, []bool, error) {
+	var (
+		files     []keyFile
+		encrypted []bool
+	)
+	for _, kf := range []keyFile{validatorKeyFile(), nodeKeyFile()} {
+		if !kf.exists() {
+			continue
+		}
+		enc, err := kf.encrypted()
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, kf)
+		encrypted = append(encrypted, enc)
+	}
+	if len(files) == 0 {
+		return nil, nil, errors.New("no validator or node key found, run init first")
+	}
+	return files, encrypted, nil
+}
+
+// currentPassphrase returns the PassphraseFunc the keys are decrypted with at
+// startup.
+func currentPassphrase() (keystore.PassphraseFunc, error) {
+	return keystore.PassphraseFromSource(config.KeyPassphrase, "Enter the passphrase of the keys: ")
+}
+
+// newPassphrase reads a new passphrase from --new-passphrase.
+func newPassphrase() (string, error) {
+	if newPassphraseSource == keystore.SourcePrompt {
+		return keystore.PromptNewPassphrase("Enter a new passphrase: ")
+	}
+	read, err := keystore.PassphraseFromSource(newPassphraseSource, "")
+	if err != nil {
+		return "", err
+	}
+	passphrase, err := read()
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", errors.New("passphrase must not be empty")
+	}
+	return passphrase, nil
+}
+
+func keysEncrypt(cmd *cobra.Command, args []string) error {
+	files, encrypted, err := keyFiles()
+	if err != nil {
+		return err
+	}
+	for i, kf := range files {
+		if encrypted[i] {
+			return fmt.Errorf("%s at %s is already encrypted, use change-passphrase instead", kf.name, kf.path)
+		}
+	}
+
+	passphrase, err := newPassphrase()
+	if err != nil {
+		return err
+	}
+	for _, kf := range files {
+		privKey, err := kf.load(nil)
+		if err != nil {
+			return err
+		}
+		if err := kf.save(privKey, passphrase); err != nil {
+			return err
+		}
+		logger.Info("Encrypted "+kf.name, "path", kf.path)
+	}
+	return nil
+}
+
+func keysDecrypt(cmd *cobra.Command, args []string) error {
+	return reencryptKeys(false)
+}
+
+func keysChangePassphrase(cmd *cobra.Command, args []string) error {
+	return reencryptKeys(true)
+}
+
+// reencryptKeys decrypts the encrypted keys, and saves them encrypted with a
+// new passphrase if encrypt is true, or else in plaintext.
+func reencryptKeys(encrypt bool) error {
+	files, encrypted, err := keyFiles()
+	if err != nil {
+		return err
+	}
+	passphrase, err := currentPassphrase()
+	if err != nil {
+		return err
+	}
+
+	var toSave []keyFile
+	for i, kf := range files {
+		if !encrypted[i] {
+			logger.Info("Skipping unencrypted "+kf.name, "path", kf.path)
+			continue
+		}
+		toSave = append(toSave, kf)
+	}
+	if len(toSave) == 0 {
+		return errors.New("no encrypted key found")
+	}
+
+	// Decrypt all the keys first, so a wrong passphrase doesn't leave them
+	// encrypted with different passphrases.
+	privKeys := make([]crypto.PrivKey, len(toSave))
+	for i, kf := range toSave {
+		privKeys[i], err = kf.load(passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s at %s: %v", kf.name, kf.path, err)
+		}
+	}
+
+	var newPass string
+	if encrypt {
+		newPass, err = newPassphrase()
+		if err != nil {
+			return err
+		}
+	}
+	for i, kf := range toSave {
+		if err := kf.save(privKeys[i], newPass); err != nil {
+			return err
+		}
+		if encrypt {
+			logger.Info("Changed the passphrase of "+kf.name, "path", kf.path)
+		} else {
+			logger.Info("Decrypted "+kf.name, "path", kf.path)
+		}
+	}
+	return nil
+}
+
+func selectedKeyFile() keyFile {
+	if keysNodeKey {
+		return nodeKeyFile()
+	}
+	return validatorKeyFile()
+}
+
+func keysExport(cmd *cobra.Command, args []string) error {
+	kf := selectedKeyFile()
+	if !kf.exists() {
+		return fmt.Errorf("%s at %s does not exist", kf.name, kf.path)
+	}
+	passphrase, err := currentPassphrase()
+	if err != nil {
+		return err
+	}
+	privKey, err := kf.load(passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to load %s at %s: %v", kf.name, kf.path, err)
+	}
+	if !keysNodeKey {
+		pvKey, err := privval.LoadFilePVKey(kf.path, passphrase)
+		if err != nil {
+			return err
+		}
+		if pvKey.Rotated() {
+			return errors.New("the validator key was rotated, and can't be exported as a bare private key")
+		}
+	}
+
+	exportPass, err := newPassphrase()
+	if err != nil {
+		return err
+	}
+	fmt.Println(keystore.EncryptArmorPrivKey(privKey, exportPass))
+	return nil
+}
+
+func keysImport(cmd *cobra.Command, args []string) error {
+	kf := selectedKeyFile()
+	if kf.exists() && !keysForce {
+		return fmt.Errorf("%s at %s already exists, use --force to overwrite it", kf.name, kf.path)
+	}
+
+	armored, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	read, err := keystore.PassphraseFromSource(importPassphraseSource, "Enter the passphrase of the imported key: ")
+	if err != nil {
+		return err
+	}
+	importPass, err := read()
+	if err != nil {
+		return err
+	}
+	privKey, err := keystore.UnarmorDecryptPrivKey(string(armored), importPass)
+	if err != nil {
+		return fmt.Errorf("failed to import %s: %v", args[0], err)
+	}
+
+	// Use the passphrase of the other key, so both can be decrypted at
+	// startup.
+	other := nodeKeyFile()
+	if keysNodeKey {
+		other = validatorKeyFile()
+	}
+	otherEncrypted := false
+	if other.exists() {
+		otherEncrypted, err = other.encrypted()
+		if err != nil {
+			return err
+		}
+	}
+	var passphrase string
+	if otherEncrypted {
+		current, err := currentPassphrase()
+		if err != nil {
+			return err
+		}
+		// Check the passphrase before saving with it.
+		if _, err := other.load(current); err != nil {
+			return fmt.Errorf("failed to decrypt %s at %s: %v", other.name, other.path, err)
+		}
+		passphrase, _ = current()
+	} else {
+		passphrase, err = newPassphrase()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := kf.save(privKey, passphrase); err != nil {
+		return err
+	}
+	logger.Info("Imported "+kf.name, "path", kf.path, "address", privKey.PubKey().Address())
+	if !keysNodeKey {
+		fmt.Fprintln(os.Stderr, "WARNING: make sure no other node signs with this validator key")
+	}
+	return nil
+}
+
+func keysRotate(cmd *cobra.Command, args []string) error {
+	kf := validatorKeyFile()
+	if !kf.exists() {
+		return fmt.Errorf("%s at %s does not exist", kf.name, kf.path)
+	}
+	passphrase, err := currentPassphrase()
+	if err != nil {
+		return err
+	}
+	pv := privval.LoadFilePVWithPassphrase(kf.path, config.PrivValidatorStateFile(), passphrase)
+	if rot := pv.PendingKeyRotation(); rot != nil && !keysForce {
+		return fmt.Errorf("a rotation to key %v is pending, use --force to replace it", rot.NewPubKey)
+	}
+
+	// Fails if the node is running, as it holds the database lock.
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	state := sm.LoadState(stateDB)
+	if state.IsEmpty() {
+		return errors.New("no state found, the key can only be rotated once the chain started")
+	}
+	height := state.LastBlockHeight + 1
+	_, val := state.Validators.GetByAddress(pv.GetAddress())
+	if val == nil {
+		return fmt.Errorf("%v is not a validator at height %d", pv.GetAddress(), height)
+	}
+	if !val.PubKey.Equals(pv.GetPubKey()) {
+		return fmt.Errorf("the validator key is not the key of the validator at height %d", height)
+	}
+
+	newPrivKey, err := genPrivKeyLike(pv.Key.PrivKey)
+	if err != nil {
+		return err
+	}
+	if !state.ConsensusParams.Validator.IsValidPubKey(newPrivKey.PubKey()) {
+		return fmt.Errorf("key type of %v is unsupported for consensus", newPrivKey.PubKey())
+	}
+	rot, err := pv.RotateKey(state.ChainID, newPrivKey, height, tmtime.Now())
+	if err != nil {
+		return err
+	}
+	logger.Info("Signed the validator key rotation, it is submitted when the node starts",
+		"address", rot.ValidatorAddress, "newPubKey", rot.NewPubKey)
+	return nil
+}
+
+// genPrivKeyLike generates a private key of the same type as privKey.
+func genPrivKeyLike(privKey crypto.PrivKey) (crypto.PrivKey, error) {
+	switch privKey.(type) {
+	case ed25519.PrivKeyEd25519:
+		return ed25519.GenPrivKey(), nil
+	case secp256k1.PrivKeySecp256k1:
+		return secp256k1.GenPrivKey(), nil
+	case sr25519.PrivKeySr25519:
+		return sr25519.GenPrivKey(), nil
+	case bls12381.PrivKeyBls12381:
+		return bls12381.GenPrivKey(), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", privKey)
+	}
+}
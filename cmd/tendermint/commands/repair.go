@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/tendermint/tendermint/consensus"
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+)
+
+// RepairCmd fixes the block store, the state and the WAL after a crash.
+var RepairCmd = &cobra.Command{
+	Use:   "repair",
+	Short: "Fix inconsistencies between the block store, the state and the consensus WAL",
+	Long: `Fix inconsistencies between the block store, the state and the consensus
+WAL, left by a crash or a failing disk:
+
+- The height of the block store is made the height of its last complete block.
+- The WAL is cut at its first corrupted message, and at the end of a height
+  above the block store. The original files are kept with a .bak suffix.
+- If the block store is more than one block ahead of the state, the blocks
+  above the state are executed again on the next start, like after a
+  "tendermint rollback".
+
+A block store behind the state can't be repaired: the missing blocks must be
+restored, or the node synced again.
+
+The node must be stopped.`,
+	Args: cobra.NoArgs,
+	RunE: repair,
+}
+
+func repair(cmd *cobra.Command, args []string) error {
+	// Fails if the node is running, as it holds the database locks.
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	oldHeight, storeHeight := store.NewBlockStore(blockStoreDB).Repair()
+	changed := oldHeight != storeHeight
+	if changed {
+		fmt.Printf("Repaired the block store height from %d to %d\n", oldHeight, storeHeight)
+	}
+
+	walFile := config.Consensus.WalFile()
+	repaired, err := cs.RepairWAL(walFile, storeHeight)
+	if err != nil {
+		return fmt.Errorf("failed to repair the WAL: %v", err)
+	}
+	if repaired {
+		changed = true
+		fmt.Printf("Repaired the WAL %s, the original files are kept with a .bak suffix\n", walFile)
+	}
+
+	state := sm.LoadState(stateDB)
+	switch {
+	case state.IsEmpty():
+	case storeHeight < state.LastBlockHeight:
+		return fmt.Errorf("the block store at height %d is behind the state at height %d: "+
+			"restore the missing blocks, or sync the node again", storeHeight, state.LastBlockHeight)
+	case storeHeight > state.LastBlockHeight+1 && sm.LoadRollbackHeight(stateDB) == 0:
+		if config.Consensus.PipelinedExecution {
+			return errors.New("the block store is more than one block ahead of the state, " +
+				"which can't be repaired with consensus.pipelined_execution")
+		}
+		sm.SaveRollbackHeight(stateDB, state.LastBlockHeight)
+		changed = true
+		fmt.Printf("The blocks from height %d to %d will be executed again on the next start\n",
+			state.LastBlockHeight+1, storeHeight)
+	}
+	if !changed {
+		fmt.Println("Found nothing to repair")
+	}
+	return nil
+}
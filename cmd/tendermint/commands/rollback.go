@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+)
+
+var rollbackHeights int64
+
+// RollbackCmd rewinds the state to recover from a bad app state.
+var RollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the state by one or more heights, keeping the blocks",
+	Long: `Roll back the state by one or more heights, keeping the blocks.
+
+A rollback recovers from an app which computed a wrong app hash, e.g. after an
+upgrade, without a full resync. The state is rewound to the state after the
+block at the current height minus --heights, and the blocks above it are
+executed again on the next start.
+
+The app must roll back to the same height: on the next start it gets the height
+in RequestInfo.rollback_height, and the node refuses to start if the app
+remains ahead.
+
+The node must be stopped.`,
+	Args: cobra.NoArgs,
+	RunE: rollback,
+}
+
+func init() {
+	RollbackCmd.Flags().Int64Var(&rollbackHeights, "heights", 1, "Number of heights to roll back")
+}
+
+func rollback(cmd *cobra.Command, args []string) error {
+	if config.Consensus.PipelinedExecution {
+		return errors.New("rollback is not supported with consensus.pipelined_execution")
+	}
+
+	// Fails if the node is running, as it holds the database locks.
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	height, appHash, err := sm.Rollback(store.NewBlockStore(blockStoreDB), stateDB, rollbackHeights)
+	if err != nil {
+		return fmt.Errorf("failed to roll back the state: %v", err)
+	}
+	fmt.Printf("Rolled back the state to height %d and app hash %X\n", height, appHash)
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -13,10 +14,12 @@ import (
 	cfg "github.com/tendermint/tendermint/config"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	nm "github.com/tendermint/tendermint/node"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 var (
 	genesisHash []byte
+	clockSkew   time.Duration
 )
 
 // AddNodeFlags exposes some common configuration options on the command-line
@@ -30,6 +33,8 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"priv_validator_laddr",
 		config.PrivValidatorListenAddr,
 		"Socket address to listen on for connections from external priv_validator process")
+	cmd.Flags().String("key_passphrase", config.KeyPassphrase,
+		"Where to read the passphrase of encrypted keys from (prompt | env:<VAR> | fd:<N>)")
 
 	// node flags
 	cmd.Flags().Bool("fast_sync", config.FastSyncMode, "Fast blockchain syncing")
@@ -47,7 +52,9 @@ func AddNodeFlags(cmd *cobra.Command) {
 			" 'persistent_kvstore',"+
 			" 'counter',"+
 			" 'counter_serial' or 'noop' for local testing.")
-	cmd.Flags().String("abci", config.ABCI, "Specify abci transport (socket | grpc)")
+	cmd.Flags().String("abci", config.ABCI, "Specify abci transport (socket | grpc | grpc-stream)")
+	cmd.Flags().Int("abci_concurrency", config.ABCIConcurrency,
+		"Number of streams opened per connection to the app with grpc-stream")
 
 	// rpc flags
 	cmd.Flags().String("rpc.laddr", config.RPC.ListenAddress, "RPC listen address. Port required")
@@ -99,6 +106,10 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 		Use:   "node",
 		Short: "Run the tendermint node",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if clockSkew != 0 {
+				tmtime.SetSkew(clockSkew)
+			}
+
 			n, err := nodeProvider(config, logger)
 			if err != nil {
 				return fmt.Errorf("failed to create node: %v", err)
@@ -120,12 +131,31 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 			}
 			logger.Info("Started node", "nodeInfo", n.Switch().NodeInfo())
 
-			// Run forever.
-			select {}
+			// Run until the node halts (see consensus.halt_height) or finds
+			// its validator running elsewhere (see
+			// consensus.double_sign_check_height), or forever.
+			select {
+			case <-n.Halted():
+				logger.Info("Node halted, stopping", "height", n.BlockStore().Height())
+				if err := n.Stop(); err != nil {
+					return fmt.Errorf("failed to stop node: %v", err)
+				}
+				return nil
+			case <-n.DoubleSignRisk():
+				err := n.ConsensusState().DoubleSignRiskErr()
+				logger.Error("Validator may be running on another node, stopping", "err", err)
+				if err := n.Stop(); err != nil {
+					logger.Error("Failed to stop node", "err", err)
+				}
+				return err
+			}
 		},
 	}
 
 	AddNodeFlags(cmd)
+	// Used by "tendermint testnet run" to inject clock skew.
+	cmd.Flags().DurationVar(&clockSkew, "clock-skew", 0, "Shift the clock of the node")
+	cmd.Flags().MarkHidden("clock-skew") // nolint: errcheck
 	return cmd
 }
 
@@ -16,7 +16,11 @@ var ShowNodeIDCmd = &cobra.Command{
 }
 
 func showNodeID(cmd *cobra.Command, args []string) error {
-	nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+	passphrase, err := currentPassphrase()
+	if err != nil {
+		return err
+	}
+	nodeKey, err := p2p.LoadNodeKeyWithPassphrase(config.NodeKeyFile(), passphrase)
 	if err != nil {
 		return err
 	}
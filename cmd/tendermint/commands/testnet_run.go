@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/test/testnet"
+)
+
+var (
+	runValidators     int
+	runNonValidators  int
+	runOutputDir      string
+	runProxyApp       string
+	runDuration       time.Duration
+	runTxRate         int
+	runFaults         []string
+	runLivenessWindow time.Duration
+	runReportFile     string
+	runInProcess      bool
+)
+
+func init() {
+	testnetRunCmd.Flags().IntVar(&runValidators, "v", 4, "Number of validators")
+	testnetRunCmd.Flags().IntVar(&runNonValidators, "n", 0, "Number of non-validators")
+	testnetRunCmd.Flags().StringVar(&runOutputDir, "o", "./testnet-run",
+		"Directory to store the homes of the nodes in, which must not exist")
+	testnetRunCmd.Flags().StringVar(&runProxyApp, "proxy_app", "kvstore",
+		"Proxy app of the nodes: an address, or one of 'kvstore', 'persistent_kvstore', 'counter', 'counter_serial' or 'noop'")
+	testnetRunCmd.Flags().DurationVar(&runDuration, "duration", time.Minute,
+		"Time to run for, once the first block is committed")
+	testnetRunCmd.Flags().IntVar(&runTxRate, "tx-rate", 10, "Number of txs per second to send")
+	testnetRunCmd.Flags().StringArrayVar(&runFaults, "fault", nil,
+		"Fault to inject, as <at>:<kind>[:<nodes>[:<value>]] (can be repeated)")
+	testnetRunCmd.Flags().DurationVar(&runLivenessWindow, "liveness-window", 10*time.Second,
+		"Time at the end of the run, after all the faults, in which every running node must commit a block")
+	testnetRunCmd.Flags().StringVar(&runReportFile, "report", "", "File to write the report to, as JSON")
+	testnetRunCmd.Flags().BoolVar(&runInProcess, "in-process", false,
+		"Run the nodes in this process rather than as processes, without their RPC servers (no skew fault)")
+
+	TestnetFilesCmd.AddCommand(testnetRunCmd)
+}
+
+var testnetRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a local testnet, inject faults and check the safety and liveness of the chain",
+	Long: `Run a local testnet of "v" + "n" node processes on 127.0.0.1, send txs to
+them and inject faults, then report whether all the running nodes committed
+the same blocks (safety), and whether each committed a block at the end of the
+run, after the faults (liveness). It exits with an error if either failed.
+
+The nodes connect to each other through proxies, so the connections can be cut
+or delayed. The faults are given with --fault <at>:<kind>[:<nodes>[:<value>]],
+where <at> is the time after the first block and <nodes> a comma-separated
+list of node indexes:
+
+  kill:<nodes>            kill the nodes, without a graceful stop
+  restart:<nodes>         start the killed nodes again
+  partition:<nodes>       cut the connections between the nodes and the others
+  fuzz:<nodes>[:<delay>]  delay the reads and writes of the nodes' connections
+                          by up to <delay> (default 100ms)
+  heal                    remove the partitions and fuzzing
+  skew:<nodes>:<skew>     restart the nodes with their clock shifted by <skew>
+  double-sign:<nodes>     start a twin of each validator, with the same key,
+                          so it signs twice at each height
+
+The homes and logs of the nodes are kept in --o. With --in-process, the nodes
+run in this process, without their RPC servers; killed nodes are stopped
+gracefully and clock skew can't be injected.
+
+Example:
+
+	tendermint testnet run --v 4 --duration 2m --fault 20s:partition:0 --fault 40s:heal \
+		--fault 50s:kill:1 --fault 70s:restart:1 --fault 80s:double-sign:2
+	`,
+	Args: cobra.NoArgs,
+	RunE: testnetRun,
+}
+
+func testnetRun(cmd *cobra.Command, args []string) error {
+	binary, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	config := testnet.Config{
+		Dir:            runOutputDir,
+		Binary:         binary,
+		InProcess:      runInProcess,
+		Validators:     runValidators,
+		NonValidators:  runNonValidators,
+		ProxyApp:       runProxyApp,
+		Duration:       runDuration,
+		TxRate:         runTxRate,
+		LivenessWindow: runLivenessWindow,
+	}
+	for _, s := range runFaults {
+		f, err := testnet.ParseFault(s)
+		if err != nil {
+			return err
+		}
+		config.Faults = append(config.Faults, f)
+	}
+
+	tn, err := testnet.Setup(config, logger)
+	if err != nil {
+		return fmt.Errorf("failed to set up the testnet: %v", err)
+	}
+	report, err := tn.Run(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to run the testnet: %v", err)
+	}
+
+	fmt.Print(report)
+	if runReportFile != "" {
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(runReportFile, bz, 0644); err != nil {
+			return err
+		}
+	}
+	if !report.OK() {
+		return errors.New("the safety or liveness invariants were violated")
+	}
+	return nil
+}
@@ -15,18 +15,24 @@ import (
 func main() {
 	rootCmd := cmd.RootCmd
 	rootCmd.AddCommand(
+		cmd.DBCmd,
 		cmd.GenValidatorCmd,
+		cmd.ExportGenesisCmd,
 		cmd.InitFilesCmd,
+		cmd.InspectCmd,
 		cmd.ProbeUpnpCmd,
 		cmd.LiteCmd,
 		cmd.ReplayCmd,
 		cmd.ReplayConsoleCmd,
+		cmd.RepairCmd,
 		cmd.ResetAllCmd,
 		cmd.ResetPrivValidatorCmd,
+		cmd.RollbackCmd,
 		cmd.ShowValidatorCmd,
 		cmd.TestnetFilesCmd,
 		cmd.ShowNodeIDCmd,
 		cmd.GenNodeKeyCmd,
+		cmd.KeysCmd,
 		cmd.VersionCmd,
 		debug.DebugCmd,
 	)
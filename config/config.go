@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -203,9 +204,25 @@ type BaseConfig struct { //nolint: maligned
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
-	// Mechanism to connect to the ABCI application: socket | grpc
+	// Where to read the passphrase of the validator and node keys from, if
+	// they are encrypted with `tendermint keys encrypt`:
+	// * "prompt" - ask on the terminal at startup
+	// * "env:<VAR>" - the environment variable <VAR>
+	// * "fd:<N>" - the open file descriptor <N>
+	KeyPassphrase string `mapstructure:"key_passphrase"`
+
+	// Mechanism to connect to the ABCI application: socket | grpc | grpc-stream
 	ABCI string `mapstructure:"abci"`
 
+	// Number of streams opened per connection to the ABCI application, when
+	// using grpc-stream. CheckTx and Query requests are spread over them, so up
+	// to this many can run concurrently in the app
+	ABCIConcurrency int `mapstructure:"abci_concurrency"`
+
+	// If set, every request made to the ABCI application and its response are
+	// appended to this file, which can be replayed with `abci-cli replay`
+	ABCIRecord string `mapstructure:"abci_record_file"`
+
 	// TCP or UNIX socket address for the profiling server to listen on
 	ProfListenAddress string `mapstructure:"prof_laddr"`
 
@@ -221,9 +238,11 @@ func DefaultBaseConfig() BaseConfig {
 		PrivValidatorKey:   defaultPrivValKeyPath,
 		PrivValidatorState: defaultPrivValStatePath,
 		NodeKey:            defaultNodeKeyPath,
+		KeyPassphrase:      "prompt",
 		Moniker:            defaultMoniker,
 		ProxyApp:           "tcp://127.0.0.1:26658",
 		ABCI:               "socket",
+		ABCIConcurrency:    1,
 		LogLevel:           DefaultPackageLogLevels(),
 		LogFormat:          LogFormatPlain,
 		ProfListenAddress:  "",
@@ -274,6 +293,15 @@ func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
 }
 
+// ABCIRecordFile returns the full path to the file recording the requests
+// made to the ABCI application, or "" if they're not recorded.
+func (cfg BaseConfig) ABCIRecordFile() string {
+	if cfg.ABCIRecord == "" {
+		return ""
+	}
+	return rootify(cfg.ABCIRecord, cfg.RootDir)
+}
+
 // DBDir returns the full path to the database directory
 func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
@@ -287,6 +315,13 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if cfg.ABCIConcurrency < 1 {
+		return errors.New("abci_concurrency must be at least 1")
+	}
+	if cfg.KeyPassphrase != "prompt" &&
+		!strings.HasPrefix(cfg.KeyPassphrase, "env:") && !strings.HasPrefix(cfg.KeyPassphrase, "fd:") {
+		return errors.New("unknown key_passphrase (must be 'prompt', 'env:<VAR>' or 'fd:<N>')")
+	}
 	return nil
 }
 
@@ -655,6 +690,10 @@ type MempoolConfig struct {
 	MaxTxsBytes int64  `mapstructure:"max_txs_bytes"`
 	CacheSize   int    `mapstructure:"cache_size"`
 	MaxTxBytes  int    `mapstructure:"max_tx_bytes"`
+
+	// Announce tx hashes to peers which support it, letting them request
+	// only the txs they haven't seen, instead of sending every tx in full.
+	AnnounceTxHashes bool `mapstructure:"announce_tx_hashes"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
@@ -669,6 +708,8 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxsBytes: 1024 * 1024 * 1024, // 1GB
 		CacheSize:   10000,
 		MaxTxBytes:  1024 * 1024, // 1MB
+
+		AnnounceTxHashes: false,
 	}
 }
 
@@ -749,6 +790,10 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// Write the trace events of the consensus to this file, for
+	// "tendermint debug timeline". Empty disables tracing.
+	TracePath string `mapstructure:"trace_file"`
+
 	TimeoutPropose        time.Duration `mapstructure:"timeout_propose"`
 	TimeoutProposeDelta   time.Duration `mapstructure:"timeout_propose_delta"`
 	TimeoutPrevote        time.Duration `mapstructure:"timeout_prevote"`
@@ -767,12 +812,43 @@ type ConsensusConfig struct {
 	// Reactor sleep duration parameters
 	PeerGossipSleepDuration     time.Duration `mapstructure:"peer_gossip_sleep_duration"`
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
+
+	// Execute blocks in the background while consensus proceeds on the next
+	// height. The app hash and results of block H are then included in block
+	// H+2 instead of H+1. All validators must use the same setting, and it
+	// can't be changed once the node applied blocks.
+	PipelinedExecution bool `mapstructure:"pipelined_execution"`
+
+	// The time of a block whose LastCommit is aggregated is set by its
+	// proposer. Prevote such a block only if its time is at most
+	// ProposalTimePrecision after the time we received the proposal, and at
+	// most ProposalMessageDelay plus ProposalTimePrecision before it.
+	ProposalTimePrecision time.Duration `mapstructure:"proposal_time_precision"`
+	ProposalMessageDelay  time.Duration `mapstructure:"proposal_message_delay"`
+
+	// Stop the node after committing the block at HaltHeight, or the first
+	// block with a time at or after HaltTime (in seconds since the Unix
+	// epoch). 0 disables either.
+	HaltHeight int64 `mapstructure:"halt_height"`
+	HaltTime   int64 `mapstructure:"halt_time"`
+
+	// Before signing, look for precommits of the node's validator it didn't
+	// sign in the last DoubleSignCheckHeight blocks, then watch the network
+	// for them over the next DoubleSignCheckHeight heights. If one is found,
+	// the validator is likely running on another node: node.NewNode returns
+	// an error, or the node stops signing and is stopped. The network isn't
+	// watched if the validator has a third or more of the voting power, as
+	// the chain would halt. The blocks are only checked with private
+	// validators which keep the height they last signed at. 0 disables the
+	// check.
+	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
 func DefaultConsensusConfig() *ConsensusConfig {
 	return &ConsensusConfig{
 		WalPath:                     filepath.Join(defaultDataDir, "cs.wal", "wal"),
+		TracePath:                   "",
 		TimeoutPropose:              3000 * time.Millisecond,
 		TimeoutProposeDelta:         500 * time.Millisecond,
 		TimeoutPrevote:              1000 * time.Millisecond,
@@ -785,6 +861,12 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
+		PipelinedExecution:          false,
+		ProposalTimePrecision:       500 * time.Millisecond,
+		ProposalMessageDelay:        2000 * time.Millisecond,
+		HaltHeight:                  0,
+		HaltTime:                    0,
+		DoubleSignCheckHeight:       0,
 	}
 }
 
@@ -844,6 +926,15 @@ func (cfg *ConsensusConfig) WalFile() string {
 	return rootify(cfg.WalPath, cfg.RootDir)
 }
 
+// TraceFile returns the full path to the trace file, or "" if tracing is
+// disabled
+func (cfg *ConsensusConfig) TraceFile() string {
+	if cfg.TracePath == "" {
+		return ""
+	}
+	return rootify(cfg.TracePath, cfg.RootDir)
+}
+
 // SetWalFile sets the path to the write-ahead log file
 func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
@@ -882,6 +973,21 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.PeerQueryMaj23SleepDuration < 0 {
 		return errors.New("peer_query_maj23_sleep_duration can't be negative")
 	}
+	if cfg.ProposalTimePrecision < 0 {
+		return errors.New("proposal_time_precision can't be negative")
+	}
+	if cfg.ProposalMessageDelay < 0 {
+		return errors.New("proposal_message_delay can't be negative")
+	}
+	if cfg.HaltHeight < 0 {
+		return errors.New("halt_height can't be negative")
+	}
+	if cfg.HaltTime < 0 {
+		return errors.New("halt_time can't be negative")
+	}
+	if cfg.DoubleSignCheckHeight < 0 {
+		return errors.New("double_sign_check_height can't be negative")
+	}
 	return nil
 }
 
@@ -958,6 +1064,12 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// Number of the last heights over which the signed and missed blocks of
+	// each validator are counted, for the validator_uptime RPC endpoint and
+	// the validator_uptime metric.
+	// 0 - disabled.
+	UptimeWindow int64 `mapstructure:"uptime_window"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
@@ -968,6 +1080,7 @@ func DefaultInstrumentationConfig() *InstrumentationConfig {
 		PrometheusListenAddr: ":26660",
 		MaxOpenConnections:   3,
 		Namespace:            "tendermint",
+		UptimeWindow:         10000,
 	}
 }
 
@@ -983,6 +1096,9 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return errors.New("max_open_connections can't be negative")
 	}
+	if cfg.UptimeWindow < 0 {
+		return errors.New("uptime_window can't be negative")
+	}
 	return nil
 }
 
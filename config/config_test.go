@@ -61,6 +61,16 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestBaseConfig()
+	cfg.ABCIConcurrency = 0
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestBaseConfig()
+	cfg.KeyPassphrase = "env:TM_KEY_PASSPHRASE"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.KeyPassphrase = "file:passphrase.txt"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -149,6 +159,10 @@ func TestConsensusConfigValidateBasic(t *testing.T) {
 		"CreateEmptyBlocksInterval",
 		"PeerGossipSleepDuration",
 		"PeerQueryMaj23SleepDuration",
+		"ProposalTimePrecision",
+		"ProposalMessageDelay",
+		"HaltHeight",
+		"HaltTime",
 	}
 
 	for _, fieldName := range fieldsToTest {
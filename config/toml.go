@@ -131,9 +131,25 @@ priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
-# Mechanism to connect to the ABCI application: socket | grpc
+# Where to read the passphrase of the validator and node keys from, if they
+# are encrypted with "tendermint keys encrypt":
+#   - "prompt" - ask on the terminal at startup
+#   - "env:<VAR>" - the environment variable <VAR>
+#   - "fd:<N>" - the open file descriptor <N>
+key_passphrase = "{{ .BaseConfig.KeyPassphrase }}"
+
+# Mechanism to connect to the ABCI application: socket | grpc | grpc-stream
 abci = "{{ .BaseConfig.ABCI }}"
 
+# Number of streams opened per connection to the ABCI application, when
+# using grpc-stream. CheckTx and Query requests are spread over them, so up
+# to this many can run concurrently in the app
+abci_concurrency = {{ .BaseConfig.ABCIConcurrency }}
+
+# If set, every request made to the ABCI application and its response are
+# appended to this file, which can be replayed with "abci-cli replay"
+abci_record_file = "{{ js .BaseConfig.ABCIRecord }}"
+
 # TCP or UNIX socket address for the profiling server to listen on
 prof_laddr = "{{ .BaseConfig.ProfListenAddress }}"
 
@@ -315,6 +331,10 @@ cache_size = {{ .Mempool.CacheSize }}
 # NOTE: the max size of a tx transmitted over the network is {max_tx_bytes} + {amino overhead}.
 max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 
+# Announce tx hashes to peers which also enable it, so they only request the
+# txs they haven't seen yet, instead of sending every tx in full.
+announce_tx_hashes = {{ .Mempool.AnnounceTxHashes }}
+
 ##### fast sync configuration options #####
 [fastsync]
 
@@ -328,6 +348,12 @@ version = "{{ .FastSync.Version }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# Write the trace events of the consensus (step transitions, proposals, block
+# parts, votes with the peers they were received from or sent to, and timeouts)
+# to this file, rotated like the WAL, for "tendermint debug timeline".
+# Empty disables tracing.
+trace_file = "{{ js .Consensus.TracePath }}"
+
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 timeout_propose_delta = "{{ .Consensus.TimeoutProposeDelta }}"
 timeout_prevote = "{{ .Consensus.TimeoutPrevote }}"
@@ -347,6 +373,37 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Execute blocks in the background while consensus proceeds on the next height.
+# The app hash and results of block H are then included in block H+2 instead of H+1.
+# NOTE: all validators must use the same setting, and it can't be changed once
+# the node applied blocks
+pipelined_execution = {{ .Consensus.PipelinedExecution }}
+
+# The time of a block whose last commit is aggregated (BLS12-381 validators)
+# is set by its proposer. Prevote such a block only if its time is at most
+# proposal_time_precision after the time the proposal was received, and at
+# most proposal_message_delay plus proposal_time_precision before it.
+proposal_time_precision = "{{ .Consensus.ProposalTimePrecision }}"
+proposal_message_delay = "{{ .Consensus.ProposalMessageDelay }}"
+
+# Stop the node after committing the block at halt_height, or the first block
+# with a time at or after halt_time (in seconds since the Unix epoch), e.g. to
+# export the genesis of a new chain with "tendermint export-genesis".
+# 0 disables either.
+halt_height = {{ .Consensus.HaltHeight }}
+halt_time = {{ .Consensus.HaltTime }}
+
+# Before signing, look for precommits of the node's validator it didn't sign
+# in the last double_sign_check_height blocks, then watch the network for them
+# over the next double_sign_check_height heights. If one is found in the
+# blocks, the node refuses to start; if one is seen on the network, it stops
+# signing and exits. The network isn't watched if the validator has a third or
+# more of the voting power, as the chain would halt meanwhile.
+# The blocks are only checked with the file private validator, which
+# keeps the height it last signed at: a remote signer doesn't, so the node
+# can't tell its own precommits apart. 0 disables the check.
+double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
+
 ##### transactions indexer configuration options #####
 [tx_index]
 
@@ -398,6 +455,12 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
+
+# Number of the last heights over which the signed and missed blocks of
+# each validator are counted, for the validator_uptime RPC endpoint and
+# the validator_uptime metric.
+# 0 - disabled.
+uptime_window = {{ .Instrumentation.UptimeWindow }}
 `
 
 /****** these are for test settings ***********/
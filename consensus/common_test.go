@@ -504,8 +504,14 @@ func ensureNewValidBlock(validBlockCh <-chan tmpubsub.Message, height int64, rou
 }
 
 func ensureNewBlock(blockCh <-chan tmpubsub.Message, height int64) {
+	ensureNewBlockWithin(blockCh, height, ensureTimeout)
+}
+
+// ensureNewBlockWithin is ensureNewBlock with another timeout, e.g. for the
+// slower BLS12-381 signatures.
+func ensureNewBlockWithin(blockCh <-chan tmpubsub.Message, height int64, timeout time.Duration) {
 	select {
-	case <-time.After(ensureTimeout):
+	case <-time.After(timeout):
 		panic("Timeout expired while waiting for NewBlock event")
 	case msg := <-blockCh:
 		blockEvent, ok := msg.Data().(types.EventDataNewBlock)
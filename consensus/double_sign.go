@@ -0,0 +1,198 @@
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// DoubleSignRiskError is returned by CheckDoubleSignRisk, or logged by the
+// State, when a precommit of the node's validator the node didn't sign was
+// found: the validator is likely running on another node, and signing would
+// double sign. See consensus.double_sign_check_height.
+type DoubleSignRiskError struct {
+	Address crypto.Address
+	Height  int64
+}
+
+func (e DoubleSignRiskError) Error() string {
+	return fmt.Sprintf("found a precommit of validator %v at height %d the node didn't sign, "+
+		"the validator may be running on another node", e.Address, e.Height)
+}
+
+// lastSignedHeighter is implemented by the private validators which keep the
+// height they last signed at, like privval.FilePV.
+type lastSignedHeighter interface {
+	LastSignedHeight() int64
+}
+
+// CheckDoubleSignRisk looks for a precommit of the validator in the commits of
+// the last checkHeight blocks of the store, above the height the private
+// validator last signed at, and returns a DoubleSignRiskError if it finds one.
+//
+// The check is skipped for private validators which don't keep the height
+// they last signed at, like remote signers, as the node can't tell the
+// precommits it signed from the ones of another node. The State still watches
+// the network for them before signing.
+func CheckDoubleSignRisk(
+	stateDB dbm.DB,
+	blockStore sm.BlockStore,
+	privValidator types.PrivValidator,
+	checkHeight int64,
+	logger log.Logger,
+) error {
+	if checkHeight == 0 || privValidator == nil {
+		return nil
+	}
+	pv, ok := privValidator.(lastSignedHeighter)
+	if !ok {
+		logger.Info("Not looking for precommits of the validator in the last blocks, " +
+			"as the private validator doesn't keep the height it last signed at")
+		return nil
+	}
+	address := types.PrivValidatorAddress(privValidator)
+
+	storeHeight := blockStore.Height()
+	from := storeHeight - checkHeight + 1
+	if from <= pv.LastSignedHeight() {
+		from = pv.LastSignedHeight() + 1
+	}
+	if from < 1 {
+		from = 1
+	}
+	for height := from; height <= storeHeight; height++ {
+		commit := blockStore.LoadBlockCommit(height)
+		if height == storeHeight {
+			commit = blockStore.LoadSeenCommit(height)
+		}
+		// The signers of an aggregated commit are only known by their index.
+		var vals *types.ValidatorSet
+		if commit.Aggregated() {
+			var err error
+			if vals, err = sm.LoadValidators(stateDB, height); err != nil {
+				return err
+			}
+		}
+		if commitSignedBy(commit, vals, address) {
+			return DoubleSignRiskError{Address: address, Height: height}
+		}
+	}
+	return nil
+}
+
+// startDoubleSignWatch pauses signing for consensus.double_sign_check_height
+// heights, while watching for the precommits of the validator.
+//
+// The watch is skipped if the validator has a third or more of the voting
+// power, as the other validators can't commit blocks without it: the chain
+// would halt for good, with no precommit of the validator to find.
+func (cs *State) startDoubleSignWatch() {
+	checkHeight := cs.config.DoubleSignCheckHeight
+	if checkHeight == 0 || cs.privValidator == nil {
+		return
+	}
+	address := types.PrivValidatorAddress(cs.privValidator)
+	if _, val := cs.Validators.GetByAddress(address); val != nil &&
+		val.VotingPower*3 >= cs.Validators.TotalVotingPower() {
+		cs.Logger.Error("Not watching for precommits of the validator before signing, "+
+			"as the chain can't make progress without it", "address", address,
+			"power", val.VotingPower, "totalPower", cs.Validators.TotalVotingPower())
+		return
+	}
+	cs.signFromHeight = cs.Height + checkHeight
+	cs.Logger.Info("Watching for precommits of the validator before signing",
+		"address", types.PrivValidatorAddress(cs.privValidator), "signFrom", cs.signFromHeight)
+}
+
+// signingPaused returns true if the node must not sign at the current height,
+// because it's still watching for the precommits of its validator, or found
+// one.
+func (cs *State) signingPaused() bool {
+	return cs.doubleSignErr != nil || cs.Height < cs.signFromHeight
+}
+
+// checkDoubleSignVote reports a precommit of the validator, at a height the
+// node doesn't sign at.
+func (cs *State) checkDoubleSignVote(vote *types.Vote) {
+	if vote.Type != types.PrecommitType || !cs.watchingForDoubleSign(vote.Height) {
+		return
+	}
+	if address := types.PrivValidatorAddress(cs.privValidator); bytes.Equal(vote.ValidatorAddress, address) {
+		cs.reportDoubleSignRisk(DoubleSignRiskError{Address: address, Height: vote.Height})
+	}
+}
+
+// checkDoubleSignCommit reports a precommit of the validator in the commit of
+// a block received, at a height the node doesn't sign at. vals is the
+// validator set which signed it.
+func (cs *State) checkDoubleSignCommit(commit *types.Commit, vals *types.ValidatorSet) {
+	if commit == nil || !cs.watchingForDoubleSign(commit.Height) {
+		return
+	}
+	if address := types.PrivValidatorAddress(cs.privValidator); commitSignedBy(commit, vals, address) {
+		cs.reportDoubleSignRisk(DoubleSignRiskError{Address: address, Height: commit.Height})
+	}
+}
+
+// watchingForDoubleSign returns true if a precommit of the validator at the
+// height wasn't signed by the node. watchFromHeight is set once the votes of
+// the WAL are replayed.
+func (cs *State) watchingForDoubleSign(height int64) bool {
+	return cs.privValidator != nil && cs.watchFromHeight > 0 &&
+		height >= cs.watchFromHeight && height < cs.signFromHeight
+}
+
+// reportDoubleSignRisk stops signing for good, and closes the channel
+// returned by DoubleSignRisk so the node is stopped.
+func (cs *State) reportDoubleSignRisk(err error) {
+	if cs.doubleSignErr != nil {
+		return
+	}
+	cs.doubleSignErr = err
+	cs.Logger.Error("Stopped signing, check where else the validator is running", "err", err)
+	close(cs.doubleSignCh)
+}
+
+// DoubleSignRisk returns a channel which is closed once a precommit of the
+// node's validator the node didn't sign is seen on the network (see
+// consensus.double_sign_check_height). DoubleSignRiskErr then returns the
+// DoubleSignRiskError.
+func (cs *State) DoubleSignRisk() <-chan struct{} {
+	return cs.doubleSignCh
+}
+
+// DoubleSignRiskErr returns the error reported once DoubleSignRisk is
+// closed, or nil.
+func (cs *State) DoubleSignRiskErr() error {
+	select {
+	case <-cs.doubleSignCh:
+		return cs.doubleSignErr
+	default:
+		return nil
+	}
+}
+
+// commitSignedBy returns true if the commit has a signature of the validator.
+// vals is the validator set which signed the commit, only needed if it is
+// aggregated.
+func commitSignedBy(commit *types.Commit, vals *types.ValidatorSet, address crypto.Address) bool {
+	if commit == nil {
+		return false
+	}
+	if commit.Aggregated() {
+		idx, _ := vals.GetByAddress(address)
+		return idx >= 0 && commit.Signers.GetIndex(idx)
+	}
+	for _, commitSig := range commit.Signatures {
+		if !commitSig.Absent() && bytes.Equal(commitSig.ValidatorAddress, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -58,6 +58,25 @@ type Metrics struct {
 
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
+
+	// Duration of each step, in seconds.
+	StepDurationSeconds metrics.Histogram
+	// Time from the start of a round to the receipt of its proposal, in
+	// seconds.
+	ProposalReceiveSeconds metrics.Histogram
+	// Time from the receipt of a proposal to the receipt of each part of its
+	// block, in seconds.
+	BlockPartReceiveSeconds metrics.Histogram
+
+	// Number of committed blocks without the prevote of a validator in the
+	// commit round.
+	ValidatorMissedPrevotes metrics.Counter
+	// Number of committed blocks without the precommit of a validator in the
+	// commit round.
+	ValidatorMissedPrecommits metrics.Counter
+	// Number of votes of a validator received after the node moved past their
+	// step.
+	ValidatorLateVotes metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -180,6 +199,47 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+
+		StepDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "step_duration_seconds",
+			Help:      "Duration of each step, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.01, 2, 14),
+		}, append(labels, "step")).With(labelsAndValues...),
+		ProposalReceiveSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_receive_seconds",
+			Help:      "Time from the start of a round to the receipt of its proposal, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.01, 2, 12),
+		}, labels).With(labelsAndValues...),
+		BlockPartReceiveSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_part_receive_seconds",
+			Help:      "Time from the receipt of a proposal to the receipt of each part of its block, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.01, 2, 12),
+		}, labels).With(labelsAndValues...),
+
+		ValidatorMissedPrevotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_missed_prevotes",
+			Help:      "Number of committed blocks without the prevote of a validator in the commit round.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorMissedPrecommits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_missed_precommits",
+			Help:      "Number of committed blocks without the precommit of a validator in the commit round.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorLateVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_late_votes",
+			Help:      "Number of votes of a validator received after the node moved past their step.",
+		}, append(labels, "validator_address", "vote_type")).With(labelsAndValues...),
 	}
 }
 
@@ -209,5 +269,13 @@ func NopMetrics() *Metrics {
 		CommittedHeight: discard.NewGauge(),
 		FastSyncing:     discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		StepDurationSeconds:     discard.NewHistogram(),
+		ProposalReceiveSeconds:  discard.NewHistogram(),
+		BlockPartReceiveSeconds: discard.NewHistogram(),
+
+		ValidatorMissedPrevotes:   discard.NewCounter(),
+		ValidatorMissedPrecommits: discard.NewCounter(),
+		ValidatorLateVotes:        discard.NewCounter(),
 	}
 }
@@ -163,7 +163,7 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // InitPeer implements Reactor by creating a state for the peer.
 func (conR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
-	peerState := NewPeerState(peer).SetLogger(conR.Logger)
+	peerState := NewPeerState(peer).SetLogger(conR.Logger).SetTracer(conR.conS.tracer)
 	peer.Set(types.PeerStateKey, peerState)
 	return peer
 }
@@ -242,6 +242,13 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		switch msg := msg.(type) {
 		case *NewRoundStepMessage:
 			ps.ApplyNewRoundStepMessage(msg)
+			conR.conS.tracer.Trace(TraceEvent{
+				Type:   TracePeerStep,
+				Height: msg.Height,
+				Round:  msg.Round,
+				Step:   msg.Step.String(),
+				Peer:   src.ID(),
+			})
 		case *NewValidBlockMessage:
 			ps.ApplyNewValidBlockMessage(msg)
 		case *HasVoteMessage:
@@ -488,6 +495,7 @@ OUTER_LOOP:
 				logger.Debug("Sending block part", "height", prs.Height, "round", prs.Round)
 				if peer.Send(DataChannel, cdc.MustMarshalBinaryBare(msg)) {
 					ps.SetHasProposalBlockPart(prs.Height, prs.Round, index)
+					conR.conS.tracer.traceBlockPart(msg.Height, msg.Round, index, peer.ID(), true, false, nil)
 				}
 				continue OUTER_LOOP
 			}
@@ -535,6 +543,7 @@ OUTER_LOOP:
 				if peer.Send(DataChannel, cdc.MustMarshalBinaryBare(msg)) {
 					// NOTE[ZM]: A peer might have received different proposal msg so this Proposal msg will be rejected!
 					ps.SetHasProposal(rs.Proposal)
+					conR.conS.tracer.traceProposal(rs.Proposal, peer.ID(), true, nil)
 				}
 			}
 			// ProposalPOL: lets peer know which POL votes we have so far.
@@ -593,6 +602,7 @@ func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundSt
 		logger.Debug("Sending block part for catchup", "round", prs.Round, "index", index)
 		if peer.Send(DataChannel, cdc.MustMarshalBinaryBare(msg)) {
 			ps.SetHasProposalBlockPart(prs.Height, prs.Round, index)
+			conR.conS.tracer.traceBlockPart(msg.Height, msg.Round, index, peer.ID(), true, false, nil)
 		} else {
 			logger.Debug("Sending block part for catchup failed")
 		}
@@ -648,12 +658,20 @@ OUTER_LOOP:
 		// Catchup logic
 		// If peer is lagging by more than 1, send Commit.
 		if prs.Height != 0 && rs.Height >= prs.Height+2 {
-			// Load the block commit for prs.Height,
-			// which contains precommit signatures for prs.Height.
-			commit := conR.conS.blockStore.LoadBlockCommit(prs.Height)
-			if ps.PickSendVote(commit) {
-				logger.Debug("Picked Catchup commit to send", "height", prs.Height)
-				continue OUTER_LOOP
+			// Load the extended commit for prs.Height, so the precommits
+			// keep their extensions, or else the block commit,
+			// which contains precommit signatures for prs.Height, unless
+			// it is aggregated.
+			if extCommit := conR.conS.blockStore.LoadExtendedCommit(prs.Height); extCommit != nil {
+				if ps.PickSendVote(extCommit) {
+					logger.Debug("Picked Catchup extended commit to send", "height", prs.Height)
+					continue OUTER_LOOP
+				}
+			} else if commit := conR.conS.blockStore.LoadBlockCommit(prs.Height); !commit.Aggregated() {
+				if ps.PickSendVote(commit) {
+					logger.Debug("Picked Catchup commit to send", "height", prs.Height)
+					continue OUTER_LOOP
+				}
 			}
 		}
 
@@ -912,6 +930,7 @@ var (
 type PeerState struct {
 	peer   p2p.Peer
 	logger log.Logger
+	tracer *Tracer
 
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
@@ -951,6 +970,13 @@ func (ps *PeerState) SetLogger(logger log.Logger) *PeerState {
 	return ps
 }
 
+// SetTracer sets the tracer of the votes sent to the peer. Returns the peer
+// state itself.
+func (ps *PeerState) SetTracer(tracer *Tracer) *PeerState {
+	ps.tracer = tracer
+	return ps
+}
+
 // GetRoundState returns an shallow copy of the PeerRoundState.
 // There's no point in mutating it since it won't change PeerState.
 func (ps *PeerState) GetRoundState() *cstypes.PeerRoundState {
@@ -1036,6 +1062,7 @@ func (ps *PeerState) PickSendVote(votes types.VoteSetReader) bool {
 		ps.logger.Debug("Sending vote message", "ps", ps, "vote", vote)
 		if ps.peer.Send(VoteChannel, cdc.MustMarshalBinaryBare(msg)) {
 			ps.SetHasVote(vote)
+			ps.tracer.traceVote(vote, ps.peer.ID(), true, false, nil)
 			return true
 		}
 		return false
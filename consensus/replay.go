@@ -86,6 +86,10 @@ func (cs *State) readReplayMessage(msg *TimedWALMessage, newStepSub types.Subscr
 		}
 
 		cs.handleMsg(m)
+		// The proposal was received when it was written to the WAL.
+		if pm, ok := m.Msg.(*ProposalMessage); ok && cs.Proposal == pm.Proposal {
+			cs.ProposalReceiveTime = msg.Time
+		}
 	case timeoutInfo:
 		cs.Logger.Info("Replay: Timeout", "height", m.Height, "round", m.Round, "step", m.Step, "dur", m.Duration)
 		cs.handleTimeout(m, cs.RoundState)
@@ -205,6 +209,10 @@ type Handshaker struct {
 	genDoc       *types.GenesisDoc
 	logger       log.Logger
 
+	// blocks are executed in the background (see
+	// sm.BlockExecutorWithPipelinedExecution)
+	pipelined bool
+
 	nBlocks int // number of blocks applied to the state
 }
 
@@ -232,6 +240,13 @@ func (h *Handshaker) SetEventBus(eventBus types.BlockEventPublisher) {
 	h.eventBus = eventBus
 }
 
+// SetPipelinedExecution sets whether blocks are executed in the background,
+// which changes how the app and the state are synced. It must match the
+// setting of the node's block executor.
+func (h *Handshaker) SetPipelinedExecution(pipelined bool) {
+	h.pipelined = pipelined
+}
+
 // NBlocks returns the number of blocks applied to the state.
 func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
@@ -240,8 +255,11 @@ func (h *Handshaker) NBlocks() int {
 // TODO: retry the handshake/replay if it fails ?
 func (h *Handshaker) Handshake(proxyApp proxy.AppConns) error {
 
-	// Handshake is done via ABCI Info on the query conn.
-	res, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+	// Handshake is done via ABCI Info on the query conn. After a rollback,
+	// the app is asked to roll back to the same height.
+	reqInfo := proxy.RequestInfo
+	reqInfo.RollbackHeight = sm.LoadRollbackHeight(h.stateDB)
+	res, err := proxyApp.Query().InfoSync(reqInfo)
 	if err != nil {
 		return fmt.Errorf("error calling Info: %v", err)
 	}
@@ -250,6 +268,10 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) error {
 	if blockHeight < 0 {
 		return fmt.Errorf("got a negative last block height (%d) from the app", blockHeight)
 	}
+	if reqInfo.RollbackHeight > 0 && blockHeight > h.initialState.LastBlockHeight {
+		return fmt.Errorf("the app is at height %d, above the height %d the state was rolled back to: "+
+			"roll the app back too", blockHeight, h.initialState.LastBlockHeight)
+	}
 	appHash := res.LastBlockAppHash
 
 	h.logger.Info("ABCI Handshake App Info",
@@ -270,6 +292,9 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) error {
 	if err != nil {
 		return fmt.Errorf("error on replay: %v", err)
 	}
+	if reqInfo.RollbackHeight > 0 {
+		sm.ClearRollbackHeight(h.stateDB)
+	}
 
 	h.logger.Info("Completed ABCI Handshake - Tendermint and App are synced",
 		"appHeight", blockHeight, "appHash", fmt.Sprintf("%X", appHash))
@@ -303,10 +328,19 @@ func (h *Handshaker) ReplayBlocks(
 	if appBlockHeight == 0 {
 		validators := make([]*types.Validator, len(h.genDoc.Validators))
 		for i, val := range h.genDoc.Validators {
-			validators[i] = types.NewValidator(val.PubKey, val.Power)
+			validators[i] = val.Validator()
 		}
 		validatorSet := types.NewValidatorSet(validators)
 		nextVals := types.TM2PB.ValidatorUpdates(validatorSet)
+		// Pass the proofs of possession along, so the app can return the
+		// validators it got.
+		for i := range nextVals {
+			for _, val := range h.genDoc.Validators {
+				if bytes.Equal(val.Address, validatorSet.Validators[i].Address) {
+					nextVals[i].ProofOfPossession = val.ProofOfPossession
+				}
+			}
+		}
 		csParams := types.TM2PB.ConsensusParams(h.genDoc.ConsensusParams)
 		req := abci.RequestInitChain{
 			Time:            h.genDoc.GenesisTime,
@@ -327,6 +361,15 @@ func (h *Handshaker) ReplayBlocks(
 				if err != nil {
 					return nil, err
 				}
+				// The updates carry the keys only, so keep the addresses of the
+				// genesis validators which rotated their keys.
+				for _, v := range vals {
+					for _, val := range h.genDoc.Validators {
+						if val.PubKey.Equals(v.PubKey) {
+							v.Address = val.Address
+						}
+					}
+				}
 				state.Validators = types.NewValidatorSet(vals)
 				state.NextValidators = types.NewValidatorSet(vals)
 			} else if len(h.genDoc.Validators) == 0 {
@@ -341,6 +384,31 @@ func (h *Handshaker) ReplayBlocks(
 		}
 	}
 
+	// After a rollback, the store can be more than one ahead of the state.
+	// Execute the blocks again up to the last one, which is handled below.
+	if storeBlockHeight > stateBlockHeight+1 && !h.pipelined && sm.LoadRollbackHeight(h.stateDB) > 0 {
+		if appBlockHeight > stateBlockHeight {
+			return nil, fmt.Errorf("the app is at height %d, above the height %d the state was rolled back to",
+				appBlockHeight, stateBlockHeight)
+		}
+		if appBlockHeight < stateBlockHeight {
+			if _, err := h.replayBlocks(state, proxyApp, appBlockHeight, stateBlockHeight, false); err != nil {
+				return nil, err
+			}
+		}
+		for height := stateBlockHeight + 1; height < storeBlockHeight; height++ {
+			h.logger.Info("Applying rolled back block", "height", height)
+			var err error
+			state, err = h.replayBlock(state, height, proxyApp.Consensus())
+			if err != nil {
+				return nil, err
+			}
+		}
+		stateBlockHeight = state.LastBlockHeight
+		appBlockHeight = stateBlockHeight
+		appHash = state.AppHash
+	}
+
 	// First handle edge cases and constraints on the storeBlockHeight.
 	switch {
 	case storeBlockHeight == 0:
@@ -360,6 +428,10 @@ func (h *Handshaker) ReplayBlocks(
 		panic(fmt.Sprintf("StoreBlockHeight (%d) > StateBlockHeight + 1 (%d)", storeBlockHeight, stateBlockHeight+1))
 	}
 
+	if h.pipelined {
+		return h.replayBlocksPipelined(state, proxyApp, appHash, appBlockHeight, storeBlockHeight)
+	}
+
 	var err error
 	// Now either store is equal to state, or one ahead.
 	// For each, consider all cases of where the app could be, given app <= store
@@ -463,6 +535,74 @@ func (h *Handshaker) replayBlocks(
 	return appHash, nil
 }
 
+// replayBlocksPipelined syncs the app with the store when blocks are executed
+// in the background. The state at height H then only includes the app hash of
+// H-1, and the app is either at H-1 or H, so it's first caught up with the
+// state. If the store is one block ahead of the state, that block is applied
+// and executed as well.
+func (h *Handshaker) replayBlocksPipelined(
+	state sm.State,
+	proxyApp proxy.AppConns,
+	appHash []byte,
+	appBlockHeight,
+	storeBlockHeight int64,
+) ([]byte, error) {
+	stateBlockHeight := state.LastBlockHeight
+	if appBlockHeight > stateBlockHeight {
+		panic(fmt.Sprintf("uncovered case! appHeight: %d, storeHeight: %d, stateHeight: %d",
+			appBlockHeight, storeBlockHeight, stateBlockHeight))
+	}
+
+	var err error
+	for i := appBlockHeight + 1; i <= stateBlockHeight; i++ {
+		h.logger.Info("Applying block", "height", i)
+		block := h.store.LoadBlock(i)
+		// The app hash of i-1 is in the header of i+1, or in the state.
+		if i == stateBlockHeight {
+			assertAppHashEqualsOneFromState(appHash, state)
+		} else {
+			assertAppHashEqualsOneFromBlock(appHash, h.store.LoadBlock(i+1))
+		}
+
+		appHash, err = sm.ExecCommitBlockAndSaveResponses(proxyApp.Consensus(), block, h.logger, h.stateDB)
+		if err != nil {
+			return nil, err
+		}
+
+		h.nBlocks++
+	}
+
+	// If the app was already at the state height, we may have crashed before
+	// saving its app hash.
+	if appBlockHeight == stateBlockHeight && stateBlockHeight > 0 {
+		sm.SaveAppHash(h.stateDB, stateBlockHeight, appHash)
+	}
+
+	if storeBlockHeight == stateBlockHeight+1 {
+		// We saved the block in the store but haven't updated the state.
+		h.logger.Info("Replay last block using real app")
+		block := h.store.LoadBlock(storeBlockHeight)
+		meta := h.store.LoadBlockMeta(storeBlockHeight)
+
+		blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp.Consensus(), mock.Mempool{},
+			sm.MockEvidencePool{}, sm.BlockExecutorWithPipelinedExecution())
+		blockExec.SetEventBus(h.eventBus)
+
+		if _, err := blockExec.ApplyBlock(state, meta.BlockID, block); err != nil {
+			return nil, err
+		}
+		// Wait for the block to be executed and committed by the app.
+		if err := blockExec.WaitPendingBlock(); err != nil {
+			return nil, err
+		}
+		h.nBlocks++
+
+		appHash = sm.LoadAppHash(h.stateDB, storeBlockHeight)
+	}
+
+	return appHash, nil
+}
+
 // ApplyBlock on the proxyApp with the last block.
 func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.AppConnConsensus) (sm.State, error) {
 	block := h.store.LoadBlock(height)
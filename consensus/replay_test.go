@@ -855,6 +855,126 @@ func TestHandshakePanicsIfAppReturnsWrongAppHash(t *testing.T) {
 	}
 }
 
+func TestHandshakeReplayPipelined(t *testing.T) {
+	const nBlocks = 5
+
+	for _, storeAhead := range []bool{false, true} {
+		storeAhead := storeAhead
+		t.Run(fmt.Sprintf("storeAhead=%v", storeAhead), func(t *testing.T) {
+			config := ResetConfig("handshake_test_")
+			defer os.RemoveAll(config.RootDir)
+			privVal := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+			stateDB, state, store := stateAndStore(config, privVal.GetPubKey(), kvstore.ProtocolVersion)
+			genDoc, _ := sm.MakeGenesisDocFromFile(config.GenesisFile())
+
+			// 1. Run the chain with pipelined execution. If storeAhead, the
+			// last block is saved to the store but not applied.
+			proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop()
+			blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+				mempool, evpool, sm.BlockExecutorWithPipelinedExecution())
+
+			var (
+				prevBlock     *types.Block
+				prevBlockMeta *types.BlockMeta
+			)
+			for height := int64(1); height <= nBlocks; height++ {
+				txs := []types.Tx{[]byte(fmt.Sprintf("key%d=%d", height, height))}
+				block, parts := makeBlock(state, prevBlock, prevBlockMeta, privVal, height, txs)
+				store.chain = append(store.chain, block)
+				prevBlock = block
+				prevBlockMeta = types.NewBlockMeta(block, parts)
+
+				if storeAhead && height == nBlocks {
+					break
+				}
+				var err error
+				state, err = blockExec.ApplyBlock(state, prevBlockMeta.BlockID, block)
+				require.NoError(t, err)
+			}
+			require.NoError(t, blockExec.WaitPendingBlock())
+
+			// 2. Sync a fresh app using the handshake.
+			newProxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+			require.NoError(t, newProxyApp.Start())
+			defer newProxyApp.Stop()
+
+			handshaker := NewHandshaker(stateDB, state, store, genDoc)
+			handshaker.SetPipelinedExecution(true)
+			require.NoError(t, handshaker.Handshake(newProxyApp))
+			assert.Equal(t, nBlocks, handshaker.NBlocks())
+
+			// The app is at the store height, and the state holds the app
+			// hash of the previous height.
+			res, err := newProxyApp.Query().InfoSync(proxy.RequestInfo)
+			require.NoError(t, err)
+			assert.EqualValues(t, nBlocks, res.LastBlockHeight)
+
+			state = sm.LoadState(stateDB)
+			assert.EqualValues(t, nBlocks, state.LastBlockHeight)
+			assert.Equal(t, sm.LoadAppHash(stateDB, nBlocks-1), state.AppHash)
+			assert.Equal(t, res.LastBlockAppHash, sm.LoadAppHash(stateDB, nBlocks))
+		})
+	}
+}
+
+func TestHandshakeAfterRollback(t *testing.T) {
+	const nBlocks = 5
+
+	config := ResetConfig("handshake_test_")
+	defer os.RemoveAll(config.RootDir)
+	privVal := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	stateDB, state, store := stateAndStore(config, privVal.GetPubKey(), kvstore.ProtocolVersion)
+	genDoc, _ := sm.MakeGenesisDocFromFile(config.GenesisFile())
+
+	// 1. Run the chain, and roll the state back by 2 heights.
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop()
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(), mempool, evpool)
+
+	var (
+		prevBlock     *types.Block
+		prevBlockMeta *types.BlockMeta
+	)
+	for height := int64(1); height <= nBlocks; height++ {
+		txs := []types.Tx{[]byte(fmt.Sprintf("key%d=%d", height, height))}
+		block, parts := makeBlock(state, prevBlock, prevBlockMeta, privVal, height, txs)
+		store.chain = append(store.chain, block)
+		prevBlock = block
+		prevBlockMeta = types.NewBlockMeta(block, parts)
+
+		var err error
+		state, err = blockExec.ApplyBlock(state, prevBlockMeta.BlockID, block)
+		require.NoError(t, err)
+	}
+	appHash := state.AppHash
+
+	height, _, err := sm.Rollback(store, stateDB, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, nBlocks-2, height)
+	state = sm.LoadState(stateDB)
+
+	// 2. The app must roll back too.
+	handshaker := NewHandshaker(stateDB, state, store, genDoc)
+	assert.Error(t, handshaker.Handshake(proxyApp))
+
+	// 3. A fresh app executes all the blocks again.
+	newProxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+	require.NoError(t, newProxyApp.Start())
+	defer newProxyApp.Stop()
+
+	handshaker = NewHandshaker(stateDB, state, store, genDoc)
+	require.NoError(t, handshaker.Handshake(newProxyApp))
+	assert.Equal(t, nBlocks, handshaker.NBlocks())
+
+	state = sm.LoadState(stateDB)
+	assert.EqualValues(t, nBlocks, state.LastBlockHeight)
+	assert.Equal(t, appHash, state.AppHash)
+	assert.EqualValues(t, 0, sm.LoadRollbackHeight(stateDB))
+}
+
 func makeBlocks(n int, state *sm.State, privVal types.PrivValidator) []*types.Block {
 	blocks := make([]*types.Block, 0)
 
@@ -867,7 +987,7 @@ func makeBlocks(n int, state *sm.State, privVal types.PrivValidator) []*types.Bl
 	for i := 0; i < n; i++ {
 		height := int64(i + 1)
 
-		block, parts := makeBlock(*state, prevBlock, prevBlockMeta, privVal, height)
+		block, parts := makeBlock(*state, prevBlock, prevBlockMeta, privVal, height, []types.Tx{})
 		blocks = append(blocks, block)
 
 		prevBlock = block
@@ -883,7 +1003,7 @@ func makeBlocks(n int, state *sm.State, privVal types.PrivValidator) []*types.Bl
 }
 
 func makeBlock(state sm.State, lastBlock *types.Block, lastBlockMeta *types.BlockMeta,
-	privVal types.PrivValidator, height int64) (*types.Block, *types.PartSet) {
+	privVal types.PrivValidator, height int64, txs []types.Tx) (*types.Block, *types.PartSet) {
 
 	lastCommit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
 	if height > 1 {
@@ -897,7 +1017,7 @@ func makeBlock(state sm.State, lastBlock *types.Block, lastBlockMeta *types.Bloc
 			lastBlockMeta.BlockID, []types.CommitSig{vote.CommitSig()})
 	}
 
-	return state.MakeBlock(height, []types.Tx{}, lastCommit, nil, state.Validators.GetProposer().Address)
+	return state.MakeBlock(height, txs, lastCommit, nil, state.Validators.GetProposer().Address)
 }
 
 type badApp struct {
@@ -1081,6 +1201,8 @@ func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
+func (bs *mockBlockStore) LoadExtendedCommit(height int64) *types.ExtendedCommit { return nil }
+func (bs *mockBlockStore) SaveExtendedCommit(extCommit *types.ExtendedCommit)    {}
 
 //---------------------------------------
 // Test handshake/init chain
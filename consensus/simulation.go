@@ -0,0 +1,549 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	mempl "github.com/tendermint/tendermint/mempool"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// simGenesisTime is the genesis time of the simulated chains, at which their
+// virtual clock starts, so a run doesn't depend on the system time.
+var simGenesisTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SimNetworkConfig configures the faults of the network of a simulation.
+type SimNetworkConfig struct {
+	// MinDelay and MaxDelay bound the random delay of each message.
+	MinDelay time.Duration
+	MaxDelay time.Duration
+	// DropRate is the probability that a message is dropped.
+	DropRate float64
+	// ReorderRate is the probability that a message is delayed by another
+	// MaxDelay, so it is delivered after the messages sent after it.
+	ReorderRate float64
+}
+
+// SimFilter returns whether the message sent at the given virtual time from
+// a node to another should be delivered, e.g. to partition the network.
+type SimFilter func(at time.Duration, from, to int, msg Message) bool
+
+// SimConfig configures a simulation.
+type SimConfig struct {
+	// Seed seeds the network and the gossip: a simulation run again with the
+	// same config takes the same steps.
+	Seed int64
+	// Validators is the number of validators, with the same voting power.
+	Validators int
+	// NewApp returns the app of a node.
+	NewApp func() abci.Application
+	// Consensus is the consensus config of the nodes, whose timeouts are
+	// on the virtual clock. Defaults to cfg.DefaultConsensusConfig().
+	Consensus *cfg.ConsensusConfig
+	Network   SimNetworkConfig
+	// Filter, if set, drops the messages for which it returns false.
+	Filter SimFilter
+	// Heights is the height all the nodes must commit.
+	Heights int64
+	// MaxTime is the virtual time in which they must commit it.
+	MaxTime time.Duration
+	// Logger logs the events of the simulation, and the nodes' consensus
+	// with a "node" key. Defaults to a nop logger.
+	Logger log.Logger
+}
+
+// ValidateBasic performs basic validation.
+func (config SimConfig) ValidateBasic() error {
+	if config.Validators < 1 {
+		return errors.New("at least one validator is required")
+	}
+	if config.NewApp == nil {
+		return errors.New("NewApp is required")
+	}
+	if config.Heights < 1 {
+		return errors.New("Heights must be positive")
+	}
+	if config.MaxTime <= 0 {
+		return errors.New("MaxTime must be positive")
+	}
+	net := config.Network
+	if net.MinDelay < 0 || net.MaxDelay < net.MinDelay {
+		return errors.New("the message delays must be positive, with MinDelay <= MaxDelay")
+	}
+	if net.DropRate < 0 || net.DropRate >= 1 || net.ReorderRate < 0 || net.ReorderRate > 1 {
+		return errors.New("DropRate must be in [0, 1), ReorderRate in [0, 1]")
+	}
+	return nil
+}
+
+// SimResult is the outcome of a simulation.
+type SimResult struct {
+	Seed int64
+	// Time is the virtual time at which all the nodes committed the target
+	// height.
+	Time time.Duration
+	// Events is the number of messages, timeouts and gossip rounds handled.
+	Events   int
+	Messages int
+	Dropped  int
+	// BlockHashes are the hashes of the committed blocks, from height 1.
+	BlockHashes [][]byte
+}
+
+// RunSimulation runs the validators of a chain in the calling goroutine,
+// driving their consensus.State by the messages and timeouts of a
+// simulated network, on a virtual clock: a run only depends on the config,
+// so a failure can be replayed from its seed.
+//
+// Each node sends its proposals, block parts and votes to all the others,
+// and gossips what the others are missing, like the Reactor, seeing their
+// round state as it is. The messages go through the network, which delays,
+// reorders and drops them, and through the Filter.
+//
+// It checks after each event that the nodes committed the same blocks
+// (safety), and returns an error with the seed if they didn't, or if they
+// didn't all commit Heights blocks within MaxTime (liveness).
+//
+// As it sets the clock of types/time (see tmtime.SetClock), simulations
+// can't run concurrently, nor with running nodes.
+func RunSimulation(config SimConfig) (*SimResult, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if config.Consensus == nil {
+		config.Consensus = cfg.DefaultConsensusConfig()
+	}
+	if config.Logger == nil {
+		config.Logger = log.NewNopLogger()
+	}
+
+	sim := &simulation{
+		config: config,
+		rng:    rand.New(rand.NewSource(config.Seed)),
+		result: &SimResult{Seed: config.Seed},
+	}
+	tmtime.SetClock(func() time.Time { return simGenesisTime.Add(sim.now) })
+	defer tmtime.SetClock(nil)
+	defer sim.stop()
+
+	if err := sim.start(); err != nil {
+		return nil, fmt.Errorf("seed %d: %v", config.Seed, err)
+	}
+	if err := sim.run(); err != nil {
+		return sim.result, fmt.Errorf("seed %d: %v", config.Seed, err)
+	}
+	return sim.result, nil
+}
+
+type simulation struct {
+	config SimConfig
+	rng    *rand.Rand
+	result *SimResult
+
+	nodes  []*simNode
+	now    time.Duration
+	events simEventQueue
+	seq    int
+}
+
+type simNode struct {
+	index      int
+	peerID     p2p.ID
+	cs         *State
+	ticker     *simTicker
+	blockStore *store.BlockStore
+	proxyApp   proxy.AppConns
+	eventBus   *types.EventBus
+	// checked is the height up to which the blocks were checked for safety.
+	checked int64
+}
+
+func (sim *simulation) start() error {
+	genDoc := &types.GenesisDoc{
+		ChainID:         "simulation",
+		GenesisTime:     simGenesisTime,
+		ConsensusParams: types.DefaultConsensusParams(),
+	}
+	privVals := make([]types.PrivValidator, sim.config.Validators)
+	for i := range privVals {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("simulation validator %d", i)))
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{
+			Address: privKey.PubKey().Address(),
+			PubKey:  privKey.PubKey(),
+			Power:   10,
+			Name:    fmt.Sprintf("node%d", i),
+		})
+	}
+
+	for i, privVal := range privVals {
+		node, err := sim.newNode(i, genDoc, privVal)
+		if err != nil {
+			return fmt.Errorf("failed to create node %d: %v", i, err)
+		}
+		sim.nodes = append(sim.nodes, node)
+	}
+	for _, node := range sim.nodes {
+		node.cs.scheduleRound0(&node.cs.RoundState)
+		sim.push(&simEvent{at: sim.config.Consensus.PeerGossipSleepDuration, node: node.index, gossip: true})
+	}
+	return nil
+}
+
+func (sim *simulation) newNode(index int, genDoc *types.GenesisDoc, privVal types.PrivValidator) (*simNode, error) {
+	logger := sim.config.Logger.With("node", index)
+	stateDB := dbm.NewMemDB()
+	state, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+	sm.SaveState(stateDB, state)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(sim.config.NewApp()))
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	if err := proxyApp.Start(); err != nil {
+		return nil, err
+	}
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(logger.With("module", "events"))
+	if err := eventBus.Start(); err != nil {
+		proxyApp.Stop()
+		return nil, err
+	}
+	node := &simNode{
+		index:      index,
+		peerID:     p2p.ID(fmt.Sprintf("node%d", index)),
+		blockStore: blockStore,
+		proxyApp:   proxyApp,
+		eventBus:   eventBus,
+	}
+
+	handshaker := NewHandshaker(stateDB, state, blockStore, genDoc)
+	handshaker.SetLogger(logger.With("module", "consensus"))
+	handshaker.SetEventBus(eventBus)
+	if err := handshaker.Handshake(proxyApp); err != nil {
+		node.stop()
+		return nil, err
+	}
+	state = sm.LoadState(stateDB)
+
+	mempool := mempl.NewCListMempool(cfg.DefaultMempoolConfig(), proxyApp.Mempool(), state.LastBlockHeight)
+	mempool.SetLogger(logger.With("module", "mempool"))
+	blockExec := sm.NewBlockExecutor(stateDB, logger.With("module", "state"), proxyApp.Consensus(),
+		mempool, sm.MockEvidencePool{})
+
+	cs := NewState(sim.config.Consensus, state, blockExec, blockStore, mempool, sm.MockEvidencePool{})
+	node.ticker = &simTicker{sim: sim, node: index}
+	cs.SetLogger(logger.With("module", "consensus"))
+	cs.SetTimeoutTicker(node.ticker)
+	cs.SetEventBus(eventBus)
+	cs.SetPrivValidator(privVal)
+	node.cs = cs
+	return node, nil
+}
+
+func (node *simNode) stop() {
+	node.eventBus.Stop()
+	node.proxyApp.Stop()
+}
+
+func (sim *simulation) stop() {
+	for _, node := range sim.nodes {
+		node.stop()
+	}
+}
+
+// run handles the events in order until all the nodes committed the target
+// height, or the virtual time is up.
+func (sim *simulation) run() error {
+	for sim.events.Len() > 0 {
+		ev := heap.Pop(&sim.events).(*simEvent)
+		if ev.at > sim.config.MaxTime {
+			break
+		}
+		sim.now = ev.at
+		sim.result.Events++
+		if err := sim.handle(ev); err != nil {
+			return err
+		}
+		if err := sim.checkSafety(sim.nodes[ev.node]); err != nil {
+			return err
+		}
+		if sim.done() {
+			sim.result.Time = sim.now
+			return nil
+		}
+	}
+	for _, node := range sim.nodes {
+		if height := node.blockStore.Height(); height < sim.config.Heights {
+			return fmt.Errorf("node %d committed %d blocks in %v, expected %d", node.index, height,
+				sim.config.MaxTime, sim.config.Heights)
+		}
+	}
+	return nil
+}
+
+// handle handles the event on its node, as the receiveRoutine of the State
+// would, then the messages the node sent itself, which it also sends to the
+// others. A panic of the State is returned as an error.
+func (sim *simulation) handle(ev *simEvent) (err error) {
+	node := sim.nodes[ev.node]
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("node %d: CONSENSUS FAILURE at %v: %v\n%s", node.index, sim.now, r, debug.Stack())
+		}
+	}()
+
+	switch {
+	case ev.msg != nil:
+		sim.config.Logger.Debug("Delivering message", "at", sim.now, "from", ev.from, "to", ev.node, "msg", ev.msg)
+		node.cs.handleMsg(msgInfo{Msg: ev.msg, PeerID: sim.nodes[ev.from].peerID})
+	case ev.gossip:
+		sim.gossip(node)
+		sim.push(&simEvent{at: sim.now + sim.config.Consensus.PeerGossipSleepDuration, node: node.index, gossip: true})
+	default:
+		if ev.timeoutGen != node.ticker.gen {
+			return nil // rescheduled
+		}
+		sim.config.Logger.Debug("Timing out", "at", sim.now, "node", ev.node, "timeout", ev.timeout)
+		node.cs.handleTimeout(ev.timeout, node.cs.RoundState)
+	}
+
+	for {
+		select {
+		case mi := <-node.cs.internalMsgQueue:
+			node.cs.handleMsg(mi)
+			sim.broadcast(node, mi.Msg)
+		case <-node.cs.statsMsgQueue:
+		default:
+			return nil
+		}
+	}
+}
+
+// checkSafety checks the blocks committed by the node against those of the
+// others.
+func (sim *simulation) checkSafety(node *simNode) error {
+	hashes := &sim.result.BlockHashes
+	for height := node.checked + 1; height <= node.blockStore.Height(); height++ {
+		hash := node.blockStore.LoadBlockMeta(height).BlockID.Hash
+		if height > int64(len(*hashes)) {
+			*hashes = append(*hashes, hash)
+		} else if want := (*hashes)[height-1]; !bytes.Equal(hash, want) {
+			return fmt.Errorf("node %d committed block %X at height %d, another node committed block %X",
+				node.index, hash, height, want)
+		}
+		node.checked = height
+	}
+	return nil
+}
+
+func (sim *simulation) done() bool {
+	for _, node := range sim.nodes {
+		if node.blockStore.Height() < sim.config.Heights {
+			return false
+		}
+	}
+	return true
+}
+
+func (sim *simulation) broadcast(from *simNode, msg Message) {
+	for _, to := range sim.nodes {
+		if to != from {
+			sim.send(from, to, msg)
+		}
+	}
+}
+
+// send sends a copy of the message through the network.
+func (sim *simulation) send(from, to *simNode, msg Message) {
+	sim.result.Messages++
+	if sim.config.Filter != nil && !sim.config.Filter(sim.now, from.index, to.index, msg) {
+		sim.result.Dropped++
+		return
+	}
+	net := sim.config.Network
+	if net.DropRate > 0 && sim.rng.Float64() < net.DropRate {
+		sim.result.Dropped++
+		return
+	}
+	delay := net.MinDelay
+	if net.MaxDelay > net.MinDelay {
+		delay += time.Duration(sim.rng.Int63n(int64(net.MaxDelay - net.MinDelay)))
+	}
+	if net.ReorderRate > 0 && sim.rng.Float64() < net.ReorderRate {
+		delay += net.MaxDelay
+	}
+
+	// The node gets its own copy, validated like by the Reactor.
+	msg, err := decodeMsg(cdc.MustMarshalBinaryBare(msg))
+	if err != nil {
+		panic(err)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("invalid message %v: %v", msg, err))
+	}
+	sim.push(&simEvent{at: sim.now + delay, node: to.index, from: from.index, msg: msg})
+}
+
+// gossip sends the others what they are missing: the proposal, block parts
+// and votes of their height and round, or the commit and block parts of
+// their height if they are behind.
+func (sim *simulation) gossip(from *simNode) {
+	rs := &from.cs.RoundState
+	for _, to := range sim.nodes {
+		if to == from {
+			continue
+		}
+		prs := &to.cs.RoundState
+		switch {
+		case prs.Height == rs.Height:
+			if rs.Proposal != nil && prs.Proposal == nil && prs.Round == rs.Round {
+				sim.send(from, to, &ProposalMessage{Proposal: rs.Proposal})
+			}
+			if prs.ProposalBlockParts != nil && rs.ProposalBlockParts.HasHeader(prs.ProposalBlockParts.Header()) {
+				missing := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.BitArray())
+				for index := 0; index < missing.Size(); index++ {
+					if !missing.GetIndex(index) {
+						continue
+					}
+					sim.send(from, to, &BlockPartMessage{Height: rs.Height, Round: rs.Round,
+						Part: rs.ProposalBlockParts.GetPart(index)})
+				}
+			}
+			sim.gossipVotes(from, to, rs.Votes.Prevotes(prs.Round), prs.Votes.Prevotes(prs.Round))
+			sim.gossipVotes(from, to, rs.Votes.Precommits(prs.Round), prs.Votes.Precommits(prs.Round))
+			if prs.Proposal != nil && prs.Proposal.POLRound >= 0 {
+				round := prs.Proposal.POLRound
+				sim.gossipVotes(from, to, rs.Votes.Prevotes(round), prs.Votes.Prevotes(round))
+			}
+
+		case prs.Height < rs.Height:
+			extCommit := from.blockStore.LoadExtendedCommit(prs.Height)
+			if extCommit == nil {
+				continue
+			}
+			sim.gossipVotes(from, to, extCommit, prs.Votes.Precommits(extCommit.GetRound()))
+			blockMeta := from.blockStore.LoadBlockMeta(prs.Height)
+			if prs.ProposalBlockParts != nil && prs.ProposalBlockParts.HasHeader(blockMeta.BlockID.PartsHeader) {
+				missing := prs.ProposalBlockParts.BitArray().Not()
+				for index := 0; index < missing.Size(); index++ {
+					if !missing.GetIndex(index) {
+						continue
+					}
+					sim.send(from, to, &BlockPartMessage{Height: prs.Height, Round: prs.Round,
+						Part: from.blockStore.LoadBlockPart(prs.Height, index)})
+				}
+			}
+		}
+	}
+}
+
+// gossipVotes sends the votes the peer doesn't have.
+func (sim *simulation) gossipVotes(from, to *simNode, votes types.VoteSetReader, peerVotes *types.VoteSet) {
+	if votes.Size() == 0 {
+		return
+	}
+	missing := votes.BitArray().Sub(peerVotes.BitArray())
+	for index := 0; index < missing.Size(); index++ {
+		if missing.GetIndex(index) {
+			sim.send(from, to, &VoteMessage{Vote: votes.GetByIndex(index)})
+		}
+	}
+}
+
+func (sim *simulation) push(ev *simEvent) {
+	ev.seq = sim.seq
+	sim.seq++
+	heap.Push(&sim.events, ev)
+}
+
+//-----------------------------------------------------------------------------
+
+// simEvent is a message delivered to a node, a timeout of a node, or a gossip
+// round of a node.
+type simEvent struct {
+	at   time.Duration
+	seq  int
+	node int
+
+	from int
+	msg  Message
+
+	timeout    timeoutInfo
+	timeoutGen int
+
+	gossip bool
+}
+
+// simEventQueue is a heap of events, by time then order of scheduling.
+type simEventQueue []*simEvent
+
+func (q simEventQueue) Len() int { return len(q) }
+func (q simEventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+func (q simEventQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *simEventQueue) Push(x interface{}) { *q = append(*q, x.(*simEvent)) }
+func (q *simEventQueue) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return ev
+}
+
+// simTicker is the TimeoutTicker of a node in a simulation. Like the
+// timeoutTicker, it keeps a single timeout, which is only replaced by a
+// timeout for a later height, round or step.
+type simTicker struct {
+	sim  *simulation
+	node int
+	ti   timeoutInfo
+	// gen is incremented on each new timeout, to tell the events of the
+	// replaced timeouts apart.
+	gen int
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (t *simTicker) Start() error                { return nil }
+func (t *simTicker) Stop() error                 { return nil }
+func (t *simTicker) Chan() <-chan timeoutInfo    { return nil }
+func (t *simTicker) SetLogger(logger log.Logger) {}
+
+func (t *simTicker) ScheduleTimeout(newti timeoutInfo) {
+	ti := t.ti
+	if newti.Height < ti.Height {
+		return
+	} else if newti.Height == ti.Height {
+		if newti.Round < ti.Round {
+			return
+		} else if newti.Round == ti.Round {
+			if ti.Step > 0 && newti.Step <= ti.Step {
+				return
+			}
+		}
+	}
+	t.ti = newti
+	t.gen++
+	t.sim.push(&simEvent{at: t.sim.now + newti.Duration, node: t.node, timeout: newti, timeoutGen: t.gen})
+}
@@ -0,0 +1,97 @@
+package consensus
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	simSeed  = flag.Int64("sim.seed", 0, "seed of the simulation to replay, instead of the default seeds")
+	simSeeds = flag.Int("sim.seeds", 10, "number of seeds to simulate")
+)
+
+func simConfig(seed int64) SimConfig {
+	return SimConfig{
+		Seed:       seed,
+		Validators: 4,
+		NewApp:     func() abci.Application { return kvstore.NewApplication() },
+		Network: SimNetworkConfig{
+			MinDelay:    10 * time.Millisecond,
+			MaxDelay:    500 * time.Millisecond,
+			DropRate:    0.1,
+			ReorderRate: 0.1,
+		},
+		Heights: 5,
+		MaxTime: 5 * time.Minute,
+	}
+}
+
+// simSeedsToRun returns the seeds to simulate, or the seed given with
+// -sim.seed to replay a failure.
+func simSeedsToRun() []int64 {
+	if *simSeed != 0 {
+		return []int64{*simSeed}
+	}
+	seeds := make([]int64, *simSeeds)
+	for i := range seeds {
+		seeds[i] = int64(i + 1)
+	}
+	return seeds
+}
+
+func TestSimulationSafetyAndLiveness(t *testing.T) {
+	for _, seed := range simSeedsToRun() {
+		res, err := RunSimulation(simConfig(seed))
+		require.NoError(t, err, "replay with -sim.seed=%d", seed)
+		assert.Len(t, res.BlockHashes, 5)
+		assert.True(t, res.Dropped > 0)
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	res1, err := RunSimulation(simConfig(42))
+	require.NoError(t, err)
+	res2, err := RunSimulation(simConfig(42))
+	require.NoError(t, err)
+	assert.Equal(t, res1, res2)
+
+	res3, err := RunSimulation(simConfig(43))
+	require.NoError(t, err)
+	assert.NotEqual(t, res1.Events, res3.Events)
+}
+
+func TestSimulationPartition(t *testing.T) {
+	// Cut two of the four validators off for a minute: there is no quorum,
+	// then the chain resumes.
+	config := simConfig(1)
+	config.Filter = func(at time.Duration, from, to int, msg Message) bool {
+		return at > time.Minute || (from < 2) == (to < 2)
+	}
+	res, err := RunSimulation(config)
+	require.NoError(t, err)
+	assert.True(t, res.Time > time.Minute)
+
+	// A validator cut off for a minute catches up with the others.
+	config.Heights = 20
+	config.Filter = func(at time.Duration, from, to int, msg Message) bool {
+		return at > time.Minute || (from != 0 && to != 0)
+	}
+	res, err = RunSimulation(config)
+	require.NoError(t, err)
+	assert.True(t, res.Time > time.Minute)
+
+	// A partition which isn't healed stops the chain.
+	config.Heights = 5
+	config.Filter = func(at time.Duration, from, to int, msg Message) bool {
+		return (from < 2) == (to < 2)
+	}
+	_, err = RunSimulation(config)
+	assert.Error(t, err)
+}
@@ -134,6 +134,24 @@ type State struct {
 
 	// for reporting metrics
 	metrics *Metrics
+	// the last step, and when it started, for the step durations
+	lastStep      cstypes.RoundStepType
+	lastStepStart time.Time
+	// when the round started, for the proposal latency, or zero before round
+	// 0 of the height started
+	roundStart time.Time
+
+	// for tracing the consensus events, see "tendermint debug timeline"
+	tracer *Tracer
+
+	// see consensus.double_sign_check_height: the node doesn't sign before
+	// signFromHeight, and looks for the precommits of its validator from
+	// watchFromHeight until then. doubleSignErr is set, and doubleSignCh
+	// closed, once one is found.
+	signFromHeight  int64
+	watchFromHeight int64
+	doubleSignErr   error
+	doubleSignCh    chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -164,6 +182,7 @@ func NewState(
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		doubleSignCh:     make(chan struct{}),
 	}
 	// set function defaults (may be overwritten before calling Start)
 	cs.decideProposal = cs.defaultDecideProposal
@@ -202,6 +221,11 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateTracer sets the tracer, which the State starts and stops.
+func StateTracer(tracer *Tracer) StateOption {
+	return func(cs *State) { cs.tracer = tracer }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -256,6 +280,7 @@ func (cs *State) GetValidators() (int64, []*types.Validator) {
 func (cs *State) SetPrivValidator(priv types.PrivValidator) {
 	cs.mtx.Lock()
 	cs.privValidator = priv
+	cs.handOverKey()
 	cs.mtx.Unlock()
 }
 
@@ -295,6 +320,12 @@ func (cs *State) OnStart() error {
 		cs.wal = wal
 	}
 
+	if cs.tracer != nil && !cs.tracer.IsRunning() {
+		if err := cs.tracer.Start(); err != nil {
+			return err
+		}
+	}
+
 	// we need the timeoutRoutine for replay so
 	// we don't block on the tick chan.
 	// NOTE: we will get a build up of garbage go routines
@@ -312,7 +343,7 @@ func (cs *State) OnStart() error {
 			if IsDataCorruptionError(err) {
 				cs.Logger.Error("Encountered corrupt WAL file", "err", err.Error())
 				cs.Logger.Error("Please repair the WAL file before restarting")
-				fmt.Println(`You can attempt to repair the WAL as follows:
+				fmt.Println(`You can attempt to repair the WAL with "tendermint repair", or manually as follows:
 
 ----
 WALFILE=~/.tendermint/data/cs.wal/wal
@@ -330,6 +361,9 @@ go run scripts/json2wal/main.go wal.json $WALFILE # rebuild the file without cor
 			// make sure to stop the timeoutTicker
 		}
 	}
+	// The votes replayed from the WAL were signed by the node.
+	cs.startDoubleSignWatch()
+	cs.watchFromHeight = cs.Height
 
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
@@ -490,7 +524,23 @@ func (cs *State) reconstructLastCommit(state sm.State) {
 		return
 	}
 	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
-	lastPrecommits := types.CommitToVoteSet(state.ChainID, seenCommit, state.LastValidators)
+	if seenCommit.Aggregated() {
+		// The seen commit comes from the next block (ie. after fast sync),
+		// and doesn't keep the precommits. LastCommit starts empty, to collect
+		// the late ones, and createProposalBlock includes the seen commit
+		// until it has +2/3.
+		cs.Logger.Info("Seen commit is aggregated, LastCommit starts empty", "height", state.LastBlockHeight)
+		cs.LastCommit = types.NewVoteSet(state.ChainID, seenCommit.Height, seenCommit.Round,
+			types.PrecommitType, state.LastValidators)
+		return
+	}
+	// The votes keep their extensions if we committed the block ourselves.
+	var lastPrecommits *types.VoteSet
+	if extCommit := cs.blockStore.LoadExtendedCommit(state.LastBlockHeight); extCommit != nil {
+		lastPrecommits = types.ExtendedCommitToVoteSet(state.ChainID, extCommit, state.LastValidators)
+	} else {
+		lastPrecommits = types.CommitToVoteSet(state.ChainID, seenCommit, state.LastValidators)
+	}
 	if !lastPrecommits.HasTwoThirdsMajority() {
 		panic("Failed to reconstruct LastCommit: Does not have +2/3 maj")
 	}
@@ -535,6 +585,10 @@ func (cs *State) updateToState(state sm.State) {
 			panic("updateToState(state) called but last Precommit round didn't have +2/3")
 		}
 		lastPrecommits = cs.Votes.Precommits(cs.CommitRound)
+	} else if cs.LastCommit != nil && cs.LastCommit.GetHeight() == state.LastBlockHeight {
+		// Keep LastCommit if it was rebuilt from the seen commit, see
+		// SwitchToConsensus.
+		lastPrecommits = cs.LastCommit
 	}
 
 	// Next desired block height
@@ -553,6 +607,7 @@ func (cs *State) updateToState(state sm.State) {
 	} else {
 		cs.StartTime = cs.config.Commit(cs.CommitTime)
 	}
+	cs.roundStart = time.Time{}
 
 	cs.Validators = validators
 	cs.Proposal = nil
@@ -572,13 +627,40 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.state = state
 
+	cs.handOverKey()
+
 	// Finally, broadcast RoundState
 	cs.newStep()
 }
 
+// handOverKey replaces the key of the private validator with its next key,
+// once the validators of the height map the validator's address to it. See
+// types.KeyRotation.
+func (cs *State) handOverKey() {
+	rpv, ok := cs.privValidator.(types.RotatingPrivValidator)
+	if !ok || cs.Validators == nil {
+		return
+	}
+	nextPubKey := rpv.GetNextPubKey()
+	if nextPubKey == nil {
+		return
+	}
+	_, val := cs.Validators.GetByAddress(rpv.GetAddress())
+	if val == nil || !val.PubKey.Equals(nextPubKey) {
+		return
+	}
+	if err := rpv.HandOver(cs.Height); err != nil {
+		cs.Logger.Error("Failed to hand over to the next validator key", "height", cs.Height, "err", err)
+		return
+	}
+	cs.Logger.Info("Handed over to the next validator key", "height", cs.Height, "pubKey", nextPubKey)
+}
+
 func (cs *State) newStep() {
 	rs := cs.RoundStateEvent()
 	cs.wal.Write(rs)
+	cs.tracer.Trace(TraceEvent{Type: TraceStep, Height: rs.Height, Round: rs.Round, Step: rs.Step})
+	cs.recordStepDuration()
 	cs.nSteps++
 	// newStep is called by updateToState in NewState before the eventBus is set!
 	if cs.eventBus != nil {
@@ -605,6 +687,11 @@ func (cs *State) receiveRoutine(maxSteps int) {
 		cs.wal.Stop()
 		cs.wal.Wait()
 
+		if cs.tracer != nil {
+			cs.tracer.Stop()
+			cs.tracer.Wait()
+		}
+
 		close(cs.done)
 	}
 
@@ -675,6 +762,11 @@ func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	// Once halted, the node is being stopped: ignore the next height.
+	if cs.blockExec.IsHalted(cs.state) {
+		return
+	}
+
 	var (
 		added bool
 		err   error
@@ -685,12 +777,26 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal)
+		cs.tracer.traceProposal(msg.Proposal, peerID, false, err)
+		// The proposal is only set if it's the first one of the round.
+		if err == nil && peerID != "" && cs.Proposal == msg.Proposal {
+			cs.metrics.ProposalReceiveSeconds.Observe(sinceOrZero(cs.proposalRoundStart(), cs.ProposalReceiveTime).Seconds())
+		}
 	case *BlockPartMessage:
+		// the proposal of the block of the part, if any, for the part latency
+		proposal := cs.Proposal
+		if proposal != nil && !cs.ProposalBlockParts.HasHeader(proposal.BlockID.PartsHeader) {
+			proposal = nil
+		}
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
 		added, err = cs.addProposalBlockPart(msg, peerID)
 		if added {
 			cs.statsMsgQueue <- mi
+			if peerID != "" && proposal != nil {
+				cs.metrics.BlockPartReceiveSeconds.Observe(sinceOrZero(cs.ProposalReceiveTime, tmtime.Now()).Seconds())
+			}
 		}
+		cs.tracer.traceBlockPart(msg.Height, msg.Round, msg.Part.Index, peerID, false, added, err)
 
 		if err != nil && msg.Round != cs.Round {
 			cs.Logger.Debug(
@@ -710,6 +816,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 		if added {
 			cs.statsMsgQueue <- mi
 		}
+		cs.tracer.traceVote(msg.Vote, peerID, false, added, err)
 
 		// if err == ErrAddingVote {
 		// TODO: punish peer
@@ -751,6 +858,18 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	if cs.blockExec.IsHalted(cs.state) {
+		return
+	}
+
+	cs.tracer.Trace(TraceEvent{
+		Type:     TraceTimeout,
+		Height:   ti.Height,
+		Round:    ti.Round,
+		Step:     ti.Step.String(),
+		Duration: ti.Duration,
+	})
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -839,6 +958,7 @@ func (cs *State) enterNewRound(height int64, round int) {
 	// we don't fire newStep for this step,
 	// but we fire an event, so update the round step first
 	cs.updateRoundStep(round, cstypes.RoundStepNewRound)
+	cs.roundStart = tmtime.Now()
 	cs.Validators = validators
 	if round == 0 {
 		// We've already reset these upon new height,
@@ -923,13 +1043,18 @@ func (cs *State) enterPropose(height int64, round int) {
 	}
 
 	// if not a validator, we're done
-	address := cs.privValidator.GetPubKey().Address()
+	address := types.PrivValidatorAddress(cs.privValidator)
 	if !cs.Validators.HasAddress(address) {
 		logger.Debug("This node is not a validator", "addr", address, "vals", cs.Validators)
 		return
 	}
 	logger.Debug("This node is a validator")
 
+	if cs.signingPaused() {
+		logger.Info("enterPropose: Not signing", "signFrom", cs.signFromHeight, "err", cs.doubleSignErr)
+		return
+	}
+
 	if cs.isProposer(address) {
 		logger.Info("enterPropose: Our turn to propose",
 			"proposer",
@@ -1010,7 +1135,10 @@ func (cs *State) isProposalComplete() bool {
 // Returns nil block upon error.
 // NOTE: keep it side-effect free for clarity.
 func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.PartSet) {
-	var commit *types.Commit
+	var (
+		commit         *types.Commit
+		voteExtensions [][]byte
+	)
 	switch {
 	case cs.Height == 1:
 		// We're creating a proposal for the first block.
@@ -1019,14 +1147,37 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 	case cs.LastCommit.HasTwoThirdsMajority():
 		// Make the commit from LastCommit
 		commit = cs.LastCommit.MakeCommit()
+		// NOTE: if LastCommit was rebuilt from the block store (ie. after a
+		// restart), the extensions are gone and the app gets none.
+		voteExtensions = cs.LastCommit.VoteExtensions()
+		if cs.LastValidators.SupportsAggregation() {
+			// Some precommits may lack an aggregation signature, e.g. if
+			// LastCommit was rebuilt from a seen commit.
+			if aggCommit, err := cs.LastCommit.MakeAggregatedCommit(); err == nil {
+				commit = aggCommit
+			} else {
+				cs.Logger.Info("enterPropose: Not aggregating the commit", "err", err)
+			}
+		}
 	default:
+		// LastCommit can't be rebuilt from an aggregated seen commit (ie.
+		// after fast sync), which is included as is.
+		if seenCommit := cs.blockStore.LoadSeenCommit(cs.Height - 1); seenCommit.Aggregated() {
+			commit = seenCommit
+			break
+		}
 		// This shouldn't happen.
 		cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block.")
 		return
 	}
 
-	proposerAddr := cs.privValidator.GetPubKey().Address()
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	proposerAddr := types.PrivValidatorAddress(cs.privValidator)
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, voteExtensions)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Cannot create proposal block", "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1086,6 +1237,31 @@ func (cs *State) defaultDoPrevote(height int64, round int) {
 		return
 	}
 
+	// The time of a block with an aggregated LastCommit is set by its
+	// proposer, so it must be close to the time we received the proposal,
+	// unless the proposal has a POL: +2/3 found it timely then.
+	if cs.ProposalBlock.LastCommit.Aggregated() &&
+		(cs.Proposal == nil || cs.Proposal.POLRound == -1) && !cs.proposalBlockIsTimely() {
+		logger.Error("enterPrevote: ProposalBlock time is not timely",
+			"time", cs.ProposalBlock.Time, "received", cs.ProposalReceiveTime)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// Let the app accept or reject the proposal block
+	accepted, err := cs.blockExec.ProcessProposal(cs.ProposalBlock)
+	if err != nil {
+		logger.Error("enterPrevote: Error processing ProposalBlock", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	if !accepted {
+		// The app rejected ProposalBlock, prevote nil.
+		logger.Error("enterPrevote: ProposalBlock was rejected by the app")
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1093,6 +1269,22 @@ func (cs *State) defaultDoPrevote(height int64, round int) {
 	cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
 }
 
+// proposalBlockIsTimely returns true if the time of the proposal block is at
+// most ProposalTimePrecision after the time we received the proposal, or the
+// earliest time allowed after the last block, and at most ProposalMessageDelay
+// plus ProposalTimePrecision before it.
+func (cs *State) proposalBlockIsTimely() bool {
+	blockTime := cs.ProposalBlock.Time
+	minTime := cs.ProposalReceiveTime.Add(-cs.config.ProposalMessageDelay - cs.config.ProposalTimePrecision)
+	maxTime := cs.ProposalReceiveTime.Add(cs.config.ProposalTimePrecision)
+	// Like the timestamps of the votes, see voteTime.
+	timeIota := time.Duration(cs.state.ConsensusParams.Block.TimeIotaMs) * time.Millisecond
+	if minBlockTime := cs.state.LastBlockTime.Add(timeIota); maxTime.Before(minBlockTime) {
+		maxTime = minBlockTime
+	}
+	return !blockTime.Before(minTime) && !blockTime.After(maxTime)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1377,6 +1569,7 @@ func (cs *State) finalizeCommit(height int64) {
 	if err := cs.blockExec.ValidateBlock(cs.state, block); err != nil {
 		panic(fmt.Sprintf("+2/3 committed an invalid block: %v", err))
 	}
+	cs.checkDoubleSignCommit(block.LastCommit, cs.state.LastValidators)
 
 	cs.Logger.Info("Finalizing commit of block with N txs",
 		"height", block.Height,
@@ -1392,8 +1585,9 @@ func (cs *State) finalizeCommit(height int64) {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit := precommits.MakeCommit()
-		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		extCommit := precommits.MakeExtendedCommit()
+		cs.blockStore.SaveBlock(block, blockParts, extCommit.Commit)
+		cs.blockStore.SaveExtendedCommit(extCommit)
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		cs.Logger.Info("Calling finalizeCommit on already stored block", "height", block.Height)
@@ -1451,6 +1645,13 @@ func (cs *State) finalizeCommit(height int64) {
 
 	fail.Fail() // XXX
 
+	// Don't start the next height if the block executor halted, see
+	// sm.BlockExecutorWithHalt.
+	if cs.blockExec.IsHalted(stateCopy) {
+		cs.Logger.Info("Halted after committing the block", "height", height)
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1481,22 +1682,22 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 		)
 		if commitSize != valSetLen {
 			panic(fmt.Sprintf("commit size (%d) doesn't match valset length (%d) at height %d\n\n%v\n\n%v",
-				commitSize, valSetLen, block.Height, block.LastCommit.Signatures, cs.LastValidators.Validators))
+				commitSize, valSetLen, block.Height, block.LastCommit, cs.LastValidators.Validators))
 		}
 
 		for i, val := range cs.LastValidators.Validators {
-			commitSig := block.LastCommit.Signatures[i]
-			if commitSig.Absent() {
+			blockIDFlag := block.LastCommit.BlockIDFlag(i)
+			if blockIDFlag == types.BlockIDFlagAbsent {
 				missingValidators++
 				missingValidatorsPower += val.VotingPower
 			}
 
-			if cs.privValidator != nil && bytes.Equal(val.Address, cs.privValidator.GetPubKey().Address()) {
+			if cs.privValidator != nil && bytes.Equal(val.Address, types.PrivValidatorAddress(cs.privValidator)) {
 				label := []string{
 					"validator_address", val.Address.String(),
 				}
 				cs.metrics.ValidatorPower.With(label...).Set(float64(val.VotingPower))
-				if commitSig.ForBlock() {
+				if blockIDFlag == types.BlockIDFlagCommit {
 					cs.metrics.ValidatorLastSignedHeight.With(label...).Set(float64(height))
 				} else {
 					cs.metrics.ValidatorMissedBlocks.With(label...).Add(float64(1))
@@ -1507,13 +1708,36 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.MissingValidators.Set(float64(missingValidators))
 	cs.metrics.MissingValidatorsPower.Set(float64(missingValidatorsPower))
 
-	cs.metrics.ByzantineValidators.Set(float64(len(block.Evidence.Evidence)))
+	// The validators whose votes of the commit round we didn't receive before
+	// committing. Without +2/3 prevotes, e.g. if we jumped to the commit round
+	// on +2/3 precommits, the missing prevotes tell nothing.
+	prevotes := cs.Votes.Prevotes(cs.CommitRound)
+	precommits := cs.Votes.Precommits(cs.CommitRound)
+	for i, val := range cs.Validators.Validators {
+		label := []string{
+			"validator_address", val.Address.String(),
+		}
+		if prevotes.HasTwoThirdsAny() && prevotes.GetByIndex(i) == nil {
+			cs.metrics.ValidatorMissedPrevotes.With(label...).Add(1)
+		}
+		if precommits.GetByIndex(i) == nil {
+			cs.metrics.ValidatorMissedPrecommits.With(label...).Add(1)
+		}
+	}
+
+	byzantineValidators := 0
 	byzantineValidatorsPower := int64(0)
 	for _, ev := range block.Evidence.Evidence {
+		// Key rotations are not misbehaviour.
+		if _, ok := ev.(*types.KeyRotation); ok {
+			continue
+		}
+		byzantineValidators++
 		if _, val := cs.Validators.GetByAddress(ev.Address()); val != nil {
 			byzantineValidatorsPower += val.VotingPower
 		}
 	}
+	cs.metrics.ByzantineValidators.Set(float64(byzantineValidators))
 	cs.metrics.ByzantineValidatorsPower.Set(float64(byzantineValidatorsPower))
 
 	if height > 1 {
@@ -1529,6 +1753,83 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 	cs.metrics.CommittedHeight.Set(float64(block.Height))
 }
 
+// recordStepDuration records the duration of the last step, which the new
+// step ends.
+func (cs *State) recordStepDuration() {
+	now := tmtime.Now()
+	if !cs.lastStepStart.IsZero() {
+		cs.metrics.StepDurationSeconds.With("step", stepLabel(cs.lastStep)).Observe(now.Sub(cs.lastStepStart).Seconds())
+	}
+	cs.lastStep, cs.lastStepStart = cs.Step, now
+}
+
+// proposalRoundStart returns when the round of the proposal started, or, for
+// a proposal of round 0 received before the round started, when it is due to
+// start. The proposer's clock may differ from ours, so the proposal latency is
+// measured from local times only.
+func (cs *State) proposalRoundStart() time.Time {
+	if cs.roundStart.IsZero() {
+		return cs.StartTime
+	}
+	return cs.roundStart
+}
+
+// sinceOrZero returns the time from start to end, or 0 if end is before start,
+// e.g. for a proposal received before its round started.
+func sinceOrZero(start, end time.Time) time.Duration {
+	if end.Before(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// stepLabel returns the label of the step in the metrics.
+func stepLabel(step cstypes.RoundStepType) string {
+	switch step {
+	case cstypes.RoundStepNewHeight:
+		return "new_height"
+	case cstypes.RoundStepNewRound:
+		return "new_round"
+	case cstypes.RoundStepPropose:
+		return "propose"
+	case cstypes.RoundStepPrevote:
+		return "prevote"
+	case cstypes.RoundStepPrevoteWait:
+		return "prevote_wait"
+	case cstypes.RoundStepPrecommit:
+		return "precommit"
+	case cstypes.RoundStepPrecommitWait:
+		return "precommit_wait"
+	case cstypes.RoundStepCommit:
+		return "commit"
+	default:
+		return "unknown"
+	}
+}
+
+// isVoteLate returns whether a vote of the current height comes after we
+// moved past its round or step, so it didn't count in our decision.
+func (cs *State) isVoteLate(vote *types.Vote) bool {
+	if vote.Round != cs.Round {
+		return vote.Round < cs.Round
+	}
+	switch vote.Type {
+	case types.PrevoteType:
+		return cs.Step > cstypes.RoundStepPrevoteWait
+	case types.PrecommitType:
+		return cs.Step > cstypes.RoundStepPrecommitWait
+	default:
+		return false
+	}
+}
+
+func (cs *State) recordLateVote(vote *types.Vote) {
+	cs.metrics.ValidatorLateVotes.With(
+		"validator_address", vote.ValidatorAddress.String(),
+		"vote_type", voteTypeString(vote.Type),
+	).Add(1)
+}
+
 //-----------------------------------------------------------------------------
 
 func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
@@ -1555,6 +1856,7 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	}
 
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1648,7 +1950,7 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 		if err == ErrVoteHeightMismatch {
 			return added, err
 		} else if voteErr, ok := err.(*types.ErrVoteConflictingVotes); ok {
-			addr := cs.privValidator.GetPubKey().Address()
+			addr := types.PrivValidatorAddress(cs.privValidator)
 			if bytes.Equal(vote.ValidatorAddress, addr) {
 				cs.Logger.Error(
 					"Found conflicting vote from ourselves. Did you unsafe_reset a validator?",
@@ -1702,10 +2004,16 @@ func (cs *State) addVote(
 			// fmt.Errorf("tryAddVote: Wrong height, not a LastCommit straggler commit.")
 			return added, ErrVoteHeightMismatch
 		}
+		if err = cs.verifyVoteExtension(vote, peerID, cs.LastValidators); err != nil {
+			return added, err
+		}
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return added, err
 		}
+		// The block was committed without it.
+		cs.recordLateVote(vote)
+		cs.checkDoubleSignVote(vote)
 
 		cs.Logger.Info(fmt.Sprintf("Added to lastPrecommits: %v", cs.LastCommit.StringShort()))
 		cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote})
@@ -1729,12 +2037,20 @@ func (cs *State) addVote(
 		return
 	}
 
+	if err = cs.verifyVoteExtension(vote, peerID, cs.Validators); err != nil {
+		return added, err
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
 	}
+	if cs.isVoteLate(vote) {
+		cs.recordLateVote(vote)
+	}
+	cs.checkDoubleSignVote(vote)
 
 	cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote})
 	cs.evsw.FireEvent(types.EventVote, vote)
@@ -1838,6 +2154,28 @@ func (cs *State) addVote(
 	return added, err
 }
 
+// verifyVoteExtension checks the extension of a precommit for a block received
+// from a peer: its signature must be valid and the app must accept it. Our
+// own votes and votes without a block are not checked.
+func (cs *State) verifyVoteExtension(vote *types.Vote, peerID p2p.ID, valSet *types.ValidatorSet) error {
+	if peerID == "" || !vote.IsExtendable() {
+		return nil
+	}
+	// the extension signature is required, so that a peer can't strip the
+	// extension from a vote it relays.
+	if len(vote.ExtensionSignature) == 0 {
+		return errors.New("precommit is missing the extension signature")
+	}
+	_, val := valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return types.ErrVoteInvalidValidatorIndex
+	}
+	if err := vote.VerifyValidatorExtension(cs.state.ChainID, val); err != nil {
+		return err
+	}
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
 func (cs *State) signVote(
 	msgType types.SignedMsgType,
 	hash []byte,
@@ -1847,7 +2185,7 @@ func (cs *State) signVote(
 	// and the privValidator will refuse to sign anything.
 	cs.wal.FlushAndSync()
 
-	addr := cs.privValidator.GetPubKey().Address()
+	addr := types.PrivValidatorAddress(cs.privValidator)
 	valIndex, _ := cs.Validators.GetByAddress(addr)
 
 	vote := &types.Vote{
@@ -1859,6 +2197,19 @@ func (cs *State) signVote(
 		Type:             msgType,
 		BlockID:          types.BlockID{Hash: hash, PartsHeader: header},
 	}
+
+	// Let the app attach data to our precommit for a block
+	if vote.IsExtendable() {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		if len(ext) > types.MaxVoteExtensionSize {
+			return nil, fmt.Errorf("vote extension is too big (%d > %d)", len(ext), types.MaxVoteExtensionSize)
+		}
+		vote.Extension = ext
+	}
+
 	err := cs.privValidator.SignVote(cs.state.ChainID, vote)
 	return vote, err
 }
@@ -1885,7 +2236,11 @@ func (cs *State) voteTime() time.Time {
 // sign the vote and publish on internalMsgQueue
 func (cs *State) signAddVote(msgType types.SignedMsgType, hash []byte, header types.PartSetHeader) *types.Vote {
 	// if we don't have a key or we're not in the validator set, do nothing
-	if cs.privValidator == nil || !cs.Validators.HasAddress(cs.privValidator.GetPubKey().Address()) {
+	if cs.privValidator == nil || !cs.Validators.HasAddress(types.PrivValidatorAddress(cs.privValidator)) {
+		return nil
+	}
+	// or if we're watching for the precommits of another node with our key
+	if cs.signingPaused() {
 		return nil
 	}
 	vote, err := cs.signVote(msgType, hash, header)
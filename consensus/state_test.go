@@ -4,18 +4,28 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/tendermint/tendermint/abci/example/counter"
+	abci "github.com/tendermint/tendermint/abci/types"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/libs/bits"
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	p2pmock "github.com/tendermint/tendermint/p2p/mock"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 /*
@@ -26,6 +36,7 @@ x * TestProposerSelection2 - round robin ordering, round 2++
 x * TestEnterProposeNoValidator - timeout into prevote round
 x * TestEnterPropose - finish propose without timing out (we have the proposal)
 x * TestBadProposal - 2 vals, bad proposal (bad block state hash), should prevote and precommit nil
+x * TestRejectedProposal - 2 vals, valid proposal rejected by the app, should prevote nil
 FullRoundSuite
 x * TestFullRound1 - 1 val, full successful round
 x * TestFullRoundNil - 1 val, full round of nil
@@ -226,6 +237,53 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, types.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+// rejectProposalApp rejects every proposal in ProcessProposal.
+type rejectProposalApp struct {
+	*counter.Application
+}
+
+func (app rejectProposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	return abci.ResponseProcessProposal{Status: abci.ProposalStatus_Reject}
+}
+
+func TestStateRejectedProposal(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newState(state, privVals[0], rejectProposalApp{counter.NewApplication(true)})
+	vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	propBlock, propBlockParts := cs1.createProposalBlock()
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vs2)
+
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartsHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+	if err := vs2.SignProposal(config.ChainID(), proposal); err != nil {
+		t.Fatal("failed to sign proposal", err)
+	}
+
+	// set the proposal block
+	if err := cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"); err != nil {
+		t.Fatal(err)
+	}
+
+	// start the machine
+	startTestRound(cs1, height, round)
+
+	// wait for proposal
+	ensureProposal(proposalCh, height, round, blockID)
+
+	// the block is valid, but the app rejected it, so we prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vs1, nil)
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -319,6 +377,182 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// voteExtensionApp extends precommits with the height and reports the vote
+// extensions it receives in PrepareProposal.
+type voteExtensionApp struct {
+	*counter.Application
+	prepared chan []abci.ExtendedVoteInfo
+}
+
+func (app voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: []byte(fmt.Sprintf("height-%d", req.Height))}
+}
+
+func (app voteExtensionApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.prepared <- req.VoteExtensions
+	return app.Application.PrepareProposal(req)
+}
+
+// our precommit carries the app's extension, which is handed to the app when
+// proposing the next block
+func TestStateVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10)
+	app := voteExtensionApp{counter.NewApplication(true), make(chan []abci.ExtendedVoteInfo, 2)}
+	cs := newState(state, privVals[0], app)
+	height, round := cs.Height, cs.Round
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	lastPrecommit := cs.GetRoundState().LastCommit.GetByIndex(0)
+	require.NotNil(t, lastPrecommit)
+	assert.Equal(t, []byte("height-1"), lastPrecommit.Extension)
+	assert.NotEmpty(t, lastPrecommit.ExtensionSignature)
+
+	assert.Empty(t, <-app.prepared) // height 1
+	select {
+	case exts := <-app.prepared: // height 2
+		require.Len(t, exts, 1)
+		assert.Equal(t, []byte("height-1"), exts[0].VoteExtension)
+		assert.Equal(t, privVals[0].GetPubKey().Address().Bytes(), exts[0].Validator.Address)
+	case <-time.After(ensureTimeout):
+		t.Fatal("Timed out waiting for PrepareProposal")
+	}
+
+	// the precommits rebuilt from the block store, as after a restart, keep
+	// their extensions
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	cs.reconstructLastCommit(cs.state)
+	lastPrecommit = cs.LastCommit.GetByIndex(0)
+	require.NotNil(t, lastPrecommit)
+	assert.Equal(t, []byte(fmt.Sprintf("height-%d", cs.state.LastBlockHeight)), lastPrecommit.Extension)
+	assert.NotEmpty(t, lastPrecommit.ExtensionSignature)
+}
+
+// the commits of BLS12-381 validators are aggregated in the next block
+// blsEnsureTimeout leaves time for the BLS12-381 signatures, which are slower.
+var blsEnsureTimeout = 10 * ensureTimeout
+
+func TestStateAggregatedCommit(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	genDoc := &types.GenesisDoc{
+		GenesisTime: tmtime.Now(),
+		ChainID:     config.ChainID(),
+		Validators: []types.GenesisValidator{{
+			PubKey:            privKey.PubKey(),
+			Power:             10,
+			ProofOfPossession: privKey.ProofOfPossession(),
+		}},
+	}
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	cs := newState(state, types.NewMockPVWithParams(privKey, false, false), counter.NewApplication(true))
+	height, round := cs.Height, cs.Round
+
+	newBlockCh := subscribe(cs.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs, height, round)
+	ensureNewBlockWithin(newBlockCh, height, blsEnsureTimeout)
+	ensureNewBlockWithin(newBlockCh, height+1, blsEnsureTimeout)
+
+	lastCommit := cs.blockStore.LoadBlock(height + 1).LastCommit
+	assert.True(t, lastCommit.Aggregated())
+	assert.Empty(t, lastCommit.Signatures)
+	assert.NoError(t, state.Validators.VerifyCommit(state.ChainID, lastCommit.BlockID, height, lastCommit))
+
+	// The seen commit keeps the signatures, to rebuild LastCommit.
+	seenCommit := cs.blockStore.LoadSeenCommit(height)
+	assert.False(t, seenCommit.Aggregated())
+	assert.NotEmpty(t, seenCommit.Signatures[0].Signature)
+}
+
+// After fast sync, the seen commit of the last block is the aggregated
+// LastCommit of the next one, from which LastCommit can't be rebuilt. The
+// proposer includes it as is.
+func TestStateProposeFromAggregatedSeenCommit(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	privVal := types.NewMockPVWithParams(privKey, false, false)
+	genDoc := &types.GenesisDoc{
+		GenesisTime: tmtime.Now(),
+		ChainID:     config.ChainID(),
+		Validators: []types.GenesisValidator{{
+			PubKey:            privKey.PubKey(),
+			Power:             10,
+			ProofOfPossession: privKey.ProofOfPossession(),
+		}},
+	}
+	genState, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	cs1 := newState(genState, privVal, counter.NewApplication(true))
+	height, round := cs1.Height, cs1.Round
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensureNewBlockWithin(newBlockCh, height, blsEnsureTimeout)
+	// Keep cs1 from moving on.
+	cs1.mtx.Lock()
+	defer cs1.mtx.Unlock()
+	state := cs1.state.Copy()
+	height = state.LastBlockHeight
+
+	// Aggregate the precommit for the last block.
+	seenCommit := cs1.blockStore.LoadSeenCommit(height)
+	vote := &types.Vote{
+		Type:             types.PrecommitType,
+		Height:           height,
+		Round:            seenCommit.Round,
+		BlockID:          seenCommit.BlockID,
+		Timestamp:        tmtime.Now(),
+		ValidatorAddress: privKey.PubKey().Address(),
+		ValidatorIndex:   0,
+	}
+	require.NoError(t, privVal.SignVote(state.ChainID, vote))
+	voteSet := types.NewVoteSet(state.ChainID, height, seenCommit.Round, types.PrecommitType, state.LastValidators)
+	_, err = voteSet.AddVote(vote)
+	require.NoError(t, err)
+	aggCommit, err := voteSet.MakeAggregatedCommit()
+	require.NoError(t, err)
+
+	// Switch to consensus from the genesis state, like the reactor does after
+	// fast sync.
+	blockStore := &seenCommitBlockStore{BlockStore: cs1.blockStore, seenCommit: aggCommit}
+	cs2 := NewState(cs1.config, genState, cs1.blockExec, blockStore, cs1.txNotifier, cs1.evpool)
+	cs2.SetLogger(log.TestingLogger().With("module", "consensus"))
+	cs2.SetPrivValidator(privVal)
+	cs2.reconstructLastCommit(state)
+	cs2.updateToState(state)
+	require.NotNil(t, cs2.LastCommit)
+
+	block, _ := cs2.createProposalBlock()
+	require.NotNil(t, block)
+	assert.Equal(t, aggCommit, block.LastCommit)
+	assert.True(t, block.Time.After(state.LastBlockTime))
+	assert.NoError(t, cs2.blockExec.ValidateBlock(state, block))
+
+	// The block time is set by the proposer, and prevoted only if it's close
+	// to the time the proposal was received.
+	cs2.Proposal = &types.Proposal{Height: block.Height, POLRound: -1}
+	cs2.ProposalBlock = block
+	cs2.ProposalReceiveTime = tmtime.Now()
+	assert.True(t, cs2.proposalBlockIsTimely())
+	cs2.ProposalReceiveTime = block.Time.Add(cs2.config.ProposalMessageDelay + cs2.config.ProposalTimePrecision + time.Millisecond)
+	assert.False(t, cs2.proposalBlockIsTimely())
+
+	block.Time = block.Time.Add(time.Hour)
+	cs2.ProposalReceiveTime = tmtime.Now()
+	require.NoError(t, cs2.blockExec.ValidateBlock(state, block))
+	cs2.defaultDoPrevote(cs2.Height, cs2.Round)
+	mi := <-cs2.internalMsgQueue
+	prevote := mi.Msg.(*VoteMessage).Vote
+	assert.Equal(t, types.PrevoteType, prevote.Type)
+	assert.True(t, prevote.BlockID.IsZero(), "the block from the future is prevoted")
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
@@ -1648,3 +1882,277 @@ func subscribeUnBuffered(eventBus *types.EventBus, q tmpubsub.Query) <-chan tmpu
 	}
 	return sub.Out()
 }
+
+func TestStateValidatorVoteMetrics(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, cs1.Round
+	counters := newTestCounters()
+	cs1.metrics.ValidatorMissedPrevotes = counters.counter("missed_prevotes")
+	cs1.metrics.ValidatorMissedPrecommits = counters.counter("missed_precommits")
+	cs1.metrics.ValidatorLateVotes = counters.counter("late_votes")
+	cs1.metrics.StepDurationSeconds = testHistogram{counters.counter("step_duration")}
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartsHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	// +2/3 prevotes, then the prevote of vs4 after we precommitted.
+	signAddVotes(cs1, types.PrevoteType, propBlockHash, propPartsHeader, vs2, vs3)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, types.PrevoteType, propBlockHash, propPartsHeader, vs4)
+	ensurePrevote(voteCh, height, round)
+
+	// Commit without the precommit of vs4.
+	signAddVotes(cs1, types.PrecommitType, propBlockHash, propPartsHeader, vs2, vs3)
+	ensurePrecommit(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	addr := func(vs *validatorStub) string { return vs.GetPubKey().Address().String() }
+	assert.Equal(t, map[string]float64{
+		"late_votes,validator_address," + addr(vs4) + ",vote_type,prevote": 1,
+		"missed_precommits,validator_address," + addr(vs4):                 1,
+	}, counters.values("late_votes", "missed_prevotes", "missed_precommits"))
+	for _, step := range []string{"new_height", "propose", "prevote", "precommit", "commit"} {
+		assert.Contains(t, counters.values("step_duration"), "step_duration,step,"+step)
+	}
+}
+
+func TestStateIsVoteLate(t *testing.T) {
+	cs, _ := randState(1)
+	cs.Round = 1
+	testCases := []struct {
+		step     cstypes.RoundStepType
+		voteType types.SignedMsgType
+		round    int
+		late     bool
+	}{
+		{cstypes.RoundStepPropose, types.PrevoteType, 0, true},
+		{cstypes.RoundStepPropose, types.PrevoteType, 1, false},
+		{cstypes.RoundStepPropose, types.PrevoteType, 2, false},
+		{cstypes.RoundStepPrevoteWait, types.PrevoteType, 1, false},
+		{cstypes.RoundStepPrecommit, types.PrevoteType, 1, true},
+		{cstypes.RoundStepPrecommit, types.PrecommitType, 1, false},
+		{cstypes.RoundStepPrecommitWait, types.PrecommitType, 1, false},
+		{cstypes.RoundStepCommit, types.PrecommitType, 1, true},
+		{cstypes.RoundStepCommit, types.PrecommitType, 2, false},
+	}
+	for i, tc := range testCases {
+		cs.Step = tc.step
+		vote := &types.Vote{Type: tc.voteType, Height: cs.Height, Round: tc.round}
+		assert.Equal(t, tc.late, cs.isVoteLate(vote), "#%d", i)
+	}
+}
+
+func TestStateProposalRoundStart(t *testing.T) {
+	cs, _ := randState(1)
+	// Before round 0 started, it is due to start at StartTime.
+	assert.True(t, cs.roundStart.IsZero())
+	assert.Equal(t, cs.StartTime, cs.proposalRoundStart())
+
+	cs.enterNewRound(cs.Height, 0)
+	assert.False(t, cs.roundStart.IsZero())
+	assert.Equal(t, cs.roundStart, cs.proposalRoundStart())
+
+	// A proposal received before its round started took no time.
+	now := tmtime.Now()
+	assert.Zero(t, sinceOrZero(now, now.Add(-time.Second)))
+	assert.Equal(t, time.Second, sinceOrZero(now, now.Add(time.Second)))
+}
+
+func TestCheckDoubleSignRisk(t *testing.T) {
+	privVal := types.NewMockPV()
+	address := types.PrivValidatorAddress(privVal)
+	logger := log.TestingLogger()
+
+	// The validator signed the blocks at heights 1 and 2.
+	commit := func(height int64) *types.Commit {
+		return types.NewCommit(height, 0, types.BlockID{}, []types.CommitSig{
+			{BlockIDFlag: types.BlockIDFlagCommit, ValidatorAddress: address, Signature: []byte("signature")},
+			types.NewCommitSigAbsent(),
+		})
+	}
+	blockStore := &commitsBlockStore{commits: []*types.Commit{commit(1), commit(2)}}
+	stateDB := dbm.NewMemDB()
+
+	err := CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 0}, 3, logger)
+	assert.Equal(t, DoubleSignRiskError{Address: address, Height: 1}, err)
+
+	err = CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 1}, 3, logger)
+	assert.Equal(t, DoubleSignRiskError{Address: address, Height: 2}, err)
+
+	err = CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 0}, 1, logger)
+	assert.Equal(t, DoubleSignRiskError{Address: address, Height: 2}, err)
+
+	assert.NoError(t, CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 2}, 3, logger))
+	assert.NoError(t, CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 0}, 0, logger))
+
+	// Without the height the private validator last signed at, any precommit
+	// may be the node's, so the check is skipped.
+	assert.NoError(t, CheckDoubleSignRisk(stateDB, blockStore, privVal, 3, logger))
+
+	// The signers of an aggregated commit are looked up in the validator set.
+	vals := types.NewValidatorSet([]*types.Validator{types.NewValidator(privVal.GetPubKey(), 10)})
+	sm.SaveState(stateDB, sm.State{Validators: vals, NextValidators: vals, LastHeightValidatorsChanged: 1})
+	signers := bits.NewBitArray(1)
+	aggCommit := types.NewAggregatedCommit(2, 0, types.BlockID{}, signers, []byte("signature"))
+	blockStore = &commitsBlockStore{commits: []*types.Commit{commit(1), aggCommit}}
+	assert.NoError(t, CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 1}, 3, logger))
+	signers.SetIndex(0, true)
+	err = CheckDoubleSignRisk(stateDB, blockStore, lastSignedPrivValidator{privVal, 1}, 3, logger)
+	assert.Equal(t, DoubleSignRiskError{Address: address, Height: 2}, err)
+}
+
+func TestStateDoubleSignWatch(t *testing.T) {
+	cs1, vss := randState(4)
+	cs1.config.DoubleSignCheckHeight = 2
+	address := types.PrivValidatorAddress(cs1.privValidator)
+
+	cs1.startDoubleSignWatch()
+	cs1.watchFromHeight = cs1.Height
+	assert.EqualValues(t, 3, cs1.signFromHeight)
+	assert.True(t, cs1.signingPaused())
+
+	// The node doesn't sign while watching.
+	assert.Nil(t, cs1.signAddVote(types.PrevoteType, nil, types.PartSetHeader{}))
+
+	// A prevote of the validator isn't reported, a precommit is.
+	incrementHeight(vss[0])
+	vote := signVote(vss[0], types.PrevoteType, nil, types.PartSetHeader{})
+	added, err := cs1.addVote(vote, "peer")
+	require.NoError(t, err)
+	require.True(t, added)
+	assert.Nil(t, cs1.doubleSignErr)
+	vote = signVote(vss[0], types.PrecommitType, nil, types.PartSetHeader{})
+	added, err = cs1.addVote(vote, "peer")
+	require.NoError(t, err)
+	require.True(t, added)
+	assert.Equal(t, DoubleSignRiskError{Address: address, Height: 1}, cs1.doubleSignErr)
+
+	// The node doesn't sign anymore, and must be stopped.
+	cs1.signFromHeight = 1
+	assert.True(t, cs1.signingPaused())
+	select {
+	case <-cs1.DoubleSignRisk():
+	default:
+		t.Error("expected DoubleSignRisk to be closed")
+	}
+	assert.Equal(t, cs1.doubleSignErr, cs1.DoubleSignRiskErr())
+}
+
+func TestStateDoubleSignWatchSkippedForThirdOfPower(t *testing.T) {
+	// The chain can't make progress without a third of the voting power.
+	cs1, _ := randState(3)
+	cs1.config.DoubleSignCheckHeight = 2
+	cs1.startDoubleSignWatch()
+	assert.False(t, cs1.signingPaused())
+
+	cs1, _ = randState(4)
+	cs1.config.DoubleSignCheckHeight = 2
+	cs1.startDoubleSignWatch()
+	assert.True(t, cs1.signingPaused())
+	assert.Nil(t, cs1.DoubleSignRiskErr())
+}
+
+// seenCommitBlockStore is a block store whose seen commits are replaced, as
+// after fast sync, which doesn't save extended commits.
+type seenCommitBlockStore struct {
+	sm.BlockStore
+	seenCommit *types.Commit
+}
+
+func (bs *seenCommitBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	return bs.seenCommit
+}
+
+func (bs *seenCommitBlockStore) LoadExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
+
+// commitsBlockStore is a block store of commits only.
+type commitsBlockStore struct {
+	sm.BlockStore
+	commits []*types.Commit
+}
+
+func (bs *commitsBlockStore) Height() int64 { return int64(len(bs.commits)) }
+
+func (bs *commitsBlockStore) LoadBlockCommit(height int64) *types.Commit {
+	return bs.commits[height-1]
+}
+
+func (bs *commitsBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	return bs.commits[height-1]
+}
+
+type lastSignedPrivValidator struct {
+	types.PrivValidator
+	lastSignedHeight int64
+}
+
+func (pv lastSignedPrivValidator) LastSignedHeight() int64 { return pv.lastSignedHeight }
+
+// testCounters are counters and histograms, recording the sum of the values
+// added or observed by name and label values.
+type testCounters struct {
+	mtx  sync.Mutex
+	sums map[string]float64
+}
+
+func newTestCounters() *testCounters {
+	return &testCounters{sums: make(map[string]float64)}
+}
+
+func (c *testCounters) counter(name string) *testCounter {
+	return &testCounter{counters: c, lvs: []string{name}}
+}
+
+// values returns the sums of the counters with the given names.
+func (c *testCounters) values(names ...string) map[string]float64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	values := make(map[string]float64)
+	for key, sum := range c.sums {
+		for _, name := range names {
+			if strings.HasPrefix(key, name+",") {
+				values[key] = sum
+			}
+		}
+	}
+	return values
+}
+
+type testCounter struct {
+	counters *testCounters
+	lvs      []string
+}
+
+func (c *testCounter) With(labelValues ...string) metrics.Counter {
+	return &testCounter{counters: c.counters, lvs: append(append([]string{}, c.lvs...), labelValues...)}
+}
+
+func (c *testCounter) Add(delta float64) {
+	c.counters.mtx.Lock()
+	c.counters.sums[strings.Join(c.lvs, ",")] += delta
+	c.counters.mtx.Unlock()
+}
+
+type testHistogram struct {
+	*testCounter
+}
+
+func (h testHistogram) With(labelValues ...string) metrics.Histogram {
+	return testHistogram{h.testCounter.With(labelValues...).(*testCounter)}
+}
+
+func (h testHistogram) Observe(value float64) {
+	h.Add(value)
+}
@@ -0,0 +1,241 @@
+package consensus
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// Timeline is the trace events of several nodes, merged by height. The
+// clocks of the nodes are assumed to be synchronized.
+type Timeline struct {
+	Nodes   []p2p.ID          `json:"nodes"`
+	Heights []*TimelineHeight `json:"heights"`
+}
+
+// TimelineHeight is the trace events of all the nodes at a height, ordered by
+// time.
+type TimelineHeight struct {
+	Height   int64         `json:"height"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Rounds   int           `json:"rounds"`
+	// Commits is when each node entered the commit step, since Start.
+	Commits map[p2p.ID]time.Duration `json:"commits"`
+	Events  []TimelineEvent          `json:"events"`
+}
+
+// TimelineEvent is a trace event, at Offset since the start of its height.
+type TimelineEvent struct {
+	Offset time.Duration `json:"offset"`
+	TraceEvent
+}
+
+// NewTimeline returns the timeline of the events of the heights from
+// minHeight to maxHeight. 0 means no bound.
+func NewTimeline(events []TraceEvent, minHeight, maxHeight int64) *Timeline {
+	sorted := make([]TraceEvent, 0, len(events))
+	for _, ev := range events {
+		if (minHeight > 0 && ev.Height < minHeight) || (maxHeight > 0 && ev.Height > maxHeight) {
+			continue
+		}
+		sorted = append(sorted, ev)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	tl := &Timeline{}
+	nodes := make(map[p2p.ID]bool)
+	heights := make(map[int64]*TimelineHeight)
+	for _, ev := range sorted {
+		if !nodes[ev.Node] {
+			nodes[ev.Node] = true
+			tl.Nodes = append(tl.Nodes, ev.Node)
+		}
+		th, ok := heights[ev.Height]
+		if !ok {
+			th = &TimelineHeight{
+				Height:  ev.Height,
+				Start:   ev.Time,
+				Commits: make(map[p2p.ID]time.Duration),
+			}
+			heights[ev.Height] = th
+			tl.Heights = append(tl.Heights, th)
+		}
+		offset := ev.Time.Sub(th.Start)
+		th.Events = append(th.Events, TimelineEvent{Offset: offset, TraceEvent: ev})
+		th.Duration = offset
+		if ev.Round >= th.Rounds {
+			th.Rounds = ev.Round + 1
+		}
+		if _, ok := th.Commits[ev.Node]; !ok && ev.Type == TraceStep && ev.Step == "RoundStepCommit" {
+			th.Commits[ev.Node] = offset
+		}
+	}
+	sort.Slice(tl.Nodes, func(i, j int) bool { return tl.Nodes[i] < tl.Nodes[j] })
+	sort.Slice(tl.Heights, func(i, j int) bool { return tl.Heights[i].Height < tl.Heights[j].Height })
+	return tl
+}
+
+// WriteJSON writes the timeline as indented JSON.
+func (tl *Timeline) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(tl)
+}
+
+// WriteHTML writes the timeline as a standalone HTML page, with a lane of the
+// steps, timeouts and proposals of each node per height, and the table of
+// all the events.
+func (tl *Timeline) WriteHTML(w io.Writer) error {
+	return timelineTemplate.Execute(w, tl)
+}
+
+// timelineMarker is a mark on the lane of a node.
+type timelineMarker struct {
+	Left  float64 // percent of the height duration
+	Class string
+	Title string
+}
+
+// lanes returns the markers of each node.
+func (th *TimelineHeight) lanes() map[p2p.ID][]timelineMarker {
+	lanes := make(map[p2p.ID][]timelineMarker)
+	for _, ev := range th.Events {
+		var class, title string
+		switch {
+		case ev.Type == TraceStep:
+			class = strings.TrimPrefix(ev.Step, "RoundStep")
+			title = fmt.Sprintf("%s round %d", class, ev.Round)
+		case ev.Type == TraceTimeout:
+			class = "Timeout"
+			title = fmt.Sprintf("timeout %v at %s round %d", ev.Duration, strings.TrimPrefix(ev.Step, "RoundStep"), ev.Round)
+		case ev.Type == TraceProposal && !ev.Sent && ev.Error == "":
+			class = "Proposal"
+			title = fmt.Sprintf("proposal of round %d from %s", ev.Round, shortPeer(ev.Peer))
+		default:
+			continue
+		}
+		left := 0.0
+		if th.Duration > 0 {
+			left = float64(ev.Offset) / float64(th.Duration) * 100
+		}
+		lanes[ev.Node] = append(lanes[ev.Node], timelineMarker{
+			Left:  left,
+			Class: class,
+			Title: fmt.Sprintf("+%v %s", ev.Offset, title),
+		})
+	}
+	return lanes
+}
+
+// shortPeer returns the prefix of the ID of a node, or "self".
+func shortPeer(id p2p.ID) string {
+	if id == "" {
+		return "self"
+	}
+	if len(id) > 8 {
+		return string(id[:8])
+	}
+	return string(id)
+}
+
+// shortHash returns the prefix of a block hash.
+func shortHash(hash []byte) string {
+	if len(hash) > 6 {
+		hash = hash[:6]
+	}
+	return fmt.Sprintf("%X", hash)
+}
+
+var timelineTemplate = template.Must(template.New("timeline").Funcs(template.FuncMap{
+	"short": shortPeer,
+	"hash":  func(hash []byte) string { return shortHash(hash) },
+	"lanes": func(th *TimelineHeight) map[p2p.ID][]timelineMarker { return th.lanes() },
+	"commit": func(th *TimelineHeight, node p2p.ID) string {
+		if offset, ok := th.Commits[node]; ok {
+			return fmt.Sprintf("commit +%v", offset)
+		}
+		return ""
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Consensus timeline</title>
+<style>
+body { font-family: sans-serif; font-size: 13px; }
+table { border-collapse: collapse; }
+td, th { padding: 2px 6px; text-align: left; }
+.lanes td.lane { width: 1000px; }
+.lane div.track { position: relative; height: 16px; background: #f4f4f4; }
+.lane span { position: absolute; top: 0; width: 3px; height: 16px; }
+.NewHeight { background: #999; } .NewRound { background: #666; }
+.Propose { background: #1f77b4; } .Prevote { background: #ff7f0e; }
+.PrevoteWait { background: #ffbb78; } .Precommit { background: #9467bd; }
+.PrecommitWait { background: #c5b0d5; } .Commit { background: #2ca02c; }
+.Timeout { background: #d62728; } .Proposal { background: #000; }
+.events td { border-top: 1px solid #eee; font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>Consensus timeline</h1>
+<p>Nodes: {{range .Nodes}}{{short .}} {{end}}</p>
+<p>
+<span class="legend"><b class="Propose">&nbsp;&nbsp;</b> propose</span>
+<span class="legend"><b class="Prevote">&nbsp;&nbsp;</b> prevote</span>
+<span class="legend"><b class="PrevoteWait">&nbsp;&nbsp;</b> prevote wait</span>
+<span class="legend"><b class="Precommit">&nbsp;&nbsp;</b> precommit</span>
+<span class="legend"><b class="PrecommitWait">&nbsp;&nbsp;</b> precommit wait</span>
+<span class="legend"><b class="Commit">&nbsp;&nbsp;</b> commit</span>
+<span class="legend"><b class="Timeout">&nbsp;&nbsp;</b> timeout</span>
+<span class="legend"><b class="Proposal">&nbsp;&nbsp;</b> proposal received</span>
+</p>
+{{$nodes := .Nodes}}
+{{range .Heights}}
+<h2>Height {{.Height}}</h2>
+<p>Started at {{.Start.Format "15:04:05.000"}}, lasted {{.Duration}} over {{.Rounds}} round(s).</p>
+{{$th := .}}{{$lanes := lanes .}}
+<table class="lanes">
+{{range $nodes}}
+<tr>
+<td>{{short .}}</td>
+<td>{{commit $th .}}</td>
+<td class="lane"><div class="track">{{range index $lanes .}}<span class="{{.Class}}" style="left: {{printf "%.2f" .Left}}%" title="{{.Title}}"></span>{{end}}</div></td>
+</tr>
+{{end}}
+</table>
+<details>
+<summary>{{len .Events}} events</summary>
+<table class="events">
+<tr><th>offset</th><th>node</th><th>type</th><th>round</th><th>step</th><th>peer / timeout</th><th>index</th><th>vote</th><th>block</th><th>added</th><th>error</th></tr>
+{{range .Events}}
+<tr>
+<td>+{{.Offset}}</td>
+<td>{{short .Node}}</td>
+<td>{{.Type}}</td>
+<td>{{.Round}}</td>
+<td>{{.Step}}</td>
+{{if eq .Type "step" "timeout"}}
+<td>{{with .Duration}}{{.}}{{end}}</td><td></td><td></td><td></td>
+{{else}}
+<td>{{if .Sent}}to{{else}}from{{end}} {{short .Peer}}</td>
+<td>{{if eq .Type "block_part" "vote"}}{{.Index}}{{end}}</td>
+<td>{{.VoteType}}</td>
+<td>{{hash .BlockHash}}</td>
+{{end}}
+<td>{{if .Added}}yes{{end}}</td>
+<td>{{.Error}}</td>
+</tr>
+{{end}}
+</table>
+</details>
+{{end}}
+</body>
+</html>
+`))
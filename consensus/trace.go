@@ -0,0 +1,270 @@
+package consensus
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	auto "github.com/tendermint/tendermint/libs/autofile"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/libs/service"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+const traceDefaultFlushInterval = 1 * time.Second
+
+// TraceEventType is the type of a TraceEvent.
+type TraceEventType string
+
+// Types of the trace events.
+const (
+	// TraceStep is a step transition of the node.
+	TraceStep TraceEventType = "step"
+	// TraceTimeout is a timeout which fired at the step of the node.
+	TraceTimeout TraceEventType = "timeout"
+	// TraceProposal is a proposal received from Peer, or sent to it.
+	TraceProposal TraceEventType = "proposal"
+	// TraceBlockPart is the block part Index received from Peer, or sent to
+	// it.
+	TraceBlockPart TraceEventType = "block_part"
+	// TraceVote is the vote of the validator Index received from Peer, or
+	// sent to it.
+	TraceVote TraceEventType = "vote"
+	// TracePeerStep is a step transition of Peer, as it told the node.
+	TracePeerStep TraceEventType = "peer_step"
+)
+
+// TraceEvent is an event of the consensus of a node, written to its trace
+// file as a line of JSON.
+type TraceEvent struct {
+	Time   time.Time      `json:"time"`
+	Node   p2p.ID         `json:"node"`
+	Type   TraceEventType `json:"type"`
+	Height int64          `json:"height"`
+	Round  int            `json:"round"`
+	Step   string         `json:"step,omitempty"`
+
+	// Peer is the peer the message was received from, or sent to if Sent. It's
+	// empty for the messages of the node itself.
+	Peer p2p.ID `json:"peer,omitempty"`
+	Sent bool   `json:"sent,omitempty"`
+
+	// Index is the index of the block part, or of the validator of the vote.
+	Index     int              `json:"index"`
+	VoteType  string           `json:"vote_type,omitempty"`
+	BlockHash tmbytes.HexBytes `json:"block_hash,omitempty"`
+	// Added is whether the received block part or vote was new.
+	Added bool `json:"added,omitempty"`
+	// Error is why the received message was rejected.
+	Error string `json:"error,omitempty"`
+
+	// Duration is the duration of the timeout.
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// Tracer writes the trace events of the consensus of a node to a file, rotated
+// with libs/autofile. It's flushed every second and once when stopped. A nil
+// Tracer traces nothing.
+type Tracer struct {
+	service.BaseService
+
+	group *auto.Group
+	node  p2p.ID
+
+	flushTicker *time.Ticker
+}
+
+// NewTracer returns a Tracer writing the trace events of the given node to
+// traceFile.
+func NewTracer(traceFile string, node p2p.ID, groupOptions ...func(*auto.Group)) (*Tracer, error) {
+	err := tmos.EnsureDir(filepath.Dir(traceFile), 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to ensure trace directory is in place")
+	}
+
+	group, err := auto.OpenGroup(traceFile, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tracer{
+		group: group,
+		node:  node,
+	}
+	t.BaseService = *service.NewBaseService(nil, "Tracer", t)
+	return t, nil
+}
+
+// SetLogger implements service.Service.
+func (t *Tracer) SetLogger(l log.Logger) {
+	t.BaseService.Logger = l
+	t.group.SetLogger(l)
+}
+
+// OnStart implements service.Service.
+func (t *Tracer) OnStart() error {
+	if err := t.group.Start(); err != nil {
+		return err
+	}
+	t.flushTicker = time.NewTicker(traceDefaultFlushInterval)
+	go t.processFlushTicks()
+	return nil
+}
+
+func (t *Tracer) processFlushTicks() {
+	for {
+		select {
+		case <-t.flushTicker.C:
+			if err := t.group.FlushAndSync(); err != nil {
+				t.Logger.Error("Periodic trace flush failed", "err", err)
+			}
+		case <-t.Quit():
+			return
+		}
+	}
+}
+
+// OnStop implements service.Service.
+func (t *Tracer) OnStop() {
+	t.flushTicker.Stop()
+	if err := t.group.FlushAndSync(); err != nil {
+		t.Logger.Error("Trace flush failed", "err", err)
+	}
+	t.group.Stop()
+	t.group.Close()
+}
+
+// Wait waits for the underlying autofile group to finish shutting down.
+func (t *Tracer) Wait() {
+	t.group.Wait()
+}
+
+// Trace writes the event, at the current time. It does nothing if the tracer
+// is nil or not running.
+func (t *Tracer) Trace(ev TraceEvent) {
+	if t == nil || !t.IsRunning() {
+		return
+	}
+	ev.Time = tmtime.Now()
+	ev.Node = t.node
+	bz, err := json.Marshal(ev)
+	if err != nil {
+		t.Logger.Error("Failed to encode trace event", "err", err)
+		return
+	}
+	if _, err := t.group.Write(append(bz, '\n')); err != nil {
+		t.Logger.Error("Failed to write trace event", "err", err)
+	}
+}
+
+// traceProposal traces the proposal received from the peer, or sent to it.
+func (t *Tracer) traceProposal(proposal *types.Proposal, peerID p2p.ID, sent bool, err error) {
+	if t == nil {
+		return
+	}
+	ev := TraceEvent{
+		Type:      TraceProposal,
+		Height:    proposal.Height,
+		Round:     proposal.Round,
+		Peer:      peerID,
+		Sent:      sent,
+		BlockHash: proposal.BlockID.Hash,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	t.Trace(ev)
+}
+
+// traceBlockPart traces the block part received from the peer, or sent to
+// it.
+func (t *Tracer) traceBlockPart(height int64, round, index int, peerID p2p.ID, sent, added bool, err error) {
+	if t == nil {
+		return
+	}
+	ev := TraceEvent{
+		Type:   TraceBlockPart,
+		Height: height,
+		Round:  round,
+		Peer:   peerID,
+		Sent:   sent,
+		Index:  index,
+		Added:  added,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	t.Trace(ev)
+}
+
+// traceVote traces the vote received from the peer, or sent to it.
+func (t *Tracer) traceVote(vote *types.Vote, peerID p2p.ID, sent, added bool, err error) {
+	if t == nil {
+		return
+	}
+	ev := TraceEvent{
+		Type:      TraceVote,
+		Height:    vote.Height,
+		Round:     vote.Round,
+		Peer:      peerID,
+		Sent:      sent,
+		Index:     vote.ValidatorIndex,
+		VoteType:  voteTypeString(vote.Type),
+		BlockHash: vote.BlockID.Hash,
+		Added:     added,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	t.Trace(ev)
+}
+
+func voteTypeString(t types.SignedMsgType) string {
+	switch t {
+	case types.PrevoteType:
+		return "prevote"
+	case types.PrecommitType:
+		return "precommit"
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// ReadTraceFile returns the trace events of traceFile, including the rotated
+// files, in the order they were written.
+func ReadTraceFile(traceFile string) ([]TraceEvent, error) {
+	// OpenGroup creates the head file if it doesn't exist.
+	if _, err := os.Stat(traceFile); err != nil {
+		return nil, err
+	}
+	group, err := auto.OpenGroup(traceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Close()
+	reader, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var events []TraceEvent
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		var ev TraceEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			// The last line may have been cut by a crash.
+			return events, fmt.Errorf("%s: line %d: %v", traceFile, line, err)
+		}
+		events = append(events, ev)
+	}
+	return events, scanner.Err()
+}
@@ -0,0 +1,131 @@
+package consensus
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTracer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trace")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	traceFile := filepath.Join(dir, "trace", "trace")
+
+	// A nil tracer traces nothing.
+	var tracer *Tracer
+	tracer.Trace(TraceEvent{Type: TraceStep})
+	tracer.traceVote(&types.Vote{}, "", false, false, nil)
+
+	tracer, err = NewTracer(traceFile, "node0")
+	require.NoError(t, err)
+	require.NoError(t, tracer.Start())
+
+	tracer.Trace(TraceEvent{Type: TraceStep, Height: 1, Step: "RoundStepPropose"})
+	tracer.group.RotateFile()
+	vote := &types.Vote{Type: types.PrevoteType, Height: 1, ValidatorIndex: 2, BlockID: types.BlockID{Hash: []byte{1, 2}}}
+	tracer.traceVote(vote, "peer1", false, true, nil)
+	tracer.traceBlockPart(1, 0, 3, "peer2", true, false, nil)
+
+	require.NoError(t, tracer.Stop())
+	tracer.Wait()
+	// Not running anymore.
+	tracer.Trace(TraceEvent{Type: TraceStep, Height: 2})
+
+	events, err := ReadTraceFile(traceFile)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for _, ev := range events {
+		assert.Equal(t, p2p.ID("node0"), ev.Node)
+		assert.False(t, ev.Time.IsZero())
+	}
+	assert.Equal(t, TraceStep, events[0].Type)
+	assert.Equal(t, "RoundStepPropose", events[0].Step)
+	assert.Equal(t, TraceVote, events[1].Type)
+	assert.Equal(t, p2p.ID("peer1"), events[1].Peer)
+	assert.Equal(t, "prevote", events[1].VoteType)
+	assert.Equal(t, 2, events[1].Index)
+	assert.EqualValues(t, []byte{1, 2}, events[1].BlockHash)
+	assert.True(t, events[1].Added)
+	assert.Equal(t, TraceBlockPart, events[2].Type)
+	assert.True(t, events[2].Sent)
+	assert.Equal(t, 3, events[2].Index)
+
+	_, err = ReadTraceFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(dir, "missing"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	events := []TraceEvent{
+		// node1's trace, then node0's.
+		{Time: at(0), Node: "node1", Type: TraceStep, Height: 1, Step: "RoundStepNewHeight"},
+		{Time: at(120), Node: "node1", Type: TraceProposal, Height: 1, Peer: "node0"},
+		{Time: at(400), Node: "node1", Type: TraceStep, Height: 1, Step: "RoundStepCommit"},
+		{Time: at(500), Node: "node1", Type: TraceStep, Height: 2, Step: "RoundStepNewHeight"},
+		{Time: at(10), Node: "node0", Type: TraceStep, Height: 1, Step: "RoundStepNewHeight"},
+		{Time: at(100), Node: "node0", Type: TraceTimeout, Height: 1, Round: 1, Step: "RoundStepPropose",
+			Duration: time.Second},
+		{Time: at(300), Node: "node0", Type: TraceStep, Height: 1, Round: 1, Step: "RoundStepCommit"},
+		{Time: at(300), Node: "node0", Type: TraceVote, Height: 1, Round: 1, Peer: "node1", Sent: true},
+	}
+
+	tl := NewTimeline(events, 0, 0)
+	assert.Equal(t, []p2p.ID{"node0", "node1"}, tl.Nodes)
+	require.Len(t, tl.Heights, 2)
+	th := tl.Heights[0]
+	assert.EqualValues(t, 1, th.Height)
+	assert.Equal(t, start, th.Start)
+	assert.Equal(t, 400*time.Millisecond, th.Duration)
+	assert.Equal(t, 2, th.Rounds)
+	assert.Equal(t, map[p2p.ID]time.Duration{
+		"node0": 300 * time.Millisecond,
+		"node1": 400 * time.Millisecond,
+	}, th.Commits)
+	require.Len(t, th.Events, 7)
+	for i, ev := range th.Events {
+		assert.Equal(t, ev.Time.Sub(start), ev.Offset)
+		if i > 0 {
+			assert.False(t, ev.Time.Before(th.Events[i-1].Time))
+		}
+	}
+	lanes := th.lanes()
+	assert.Len(t, lanes["node0"], 3)
+	assert.Len(t, lanes["node1"], 3)
+	assert.Equal(t, "Timeout", lanes["node0"][1].Class)
+	assert.Equal(t, 25.0, lanes["node0"][1].Left)
+
+	tl = NewTimeline(events, 2, 2)
+	require.Len(t, tl.Heights, 1)
+	assert.EqualValues(t, 2, tl.Heights[0].Height)
+	assert.Equal(t, []p2p.ID{"node1"}, tl.Nodes)
+
+	tl = NewTimeline(events, 0, 0)
+	var buf bytes.Buffer
+	require.NoError(t, tl.WriteJSON(&buf))
+	var decoded Timeline
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, tl.Heights[0].Events[1].TraceEvent.Type, decoded.Heights[0].Events[1].Type)
+	assert.Equal(t, tl.Heights[0].Commits, decoded.Heights[0].Commits)
+
+	buf.Reset()
+	require.NoError(t, tl.WriteHTML(&buf))
+	html := buf.String()
+	assert.Contains(t, html, "<h2>Height 1</h2>")
+	assert.Contains(t, html, "<h2>Height 2</h2>")
+	assert.Contains(t, html, "commit &#43;300ms")
+	assert.Contains(t, html, `class="Timeout" style="left: 25.00%"`)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -281,6 +282,101 @@ func (wal *baseWAL) SearchForEndHeight(
 	return nil, false, nil
 }
 
+// RepairWAL rewrites the WAL at walFile without its corrupted tail, starting
+// at the first message that fails to decode, and without the messages from
+// the first #ENDHEIGHT above maxHeight, the height of the block store, as
+// consensus must not find the end of a height it has no block for. It returns
+// whether the WAL was rewritten, in which case the original files are kept
+// with a .bak suffix.
+//
+// The WAL must not be in use.
+func RepairWAL(walFile string, maxHeight int64) (bool, error) {
+	if _, err := os.Stat(walFile); os.IsNotExist(err) {
+		return false, nil
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return false, err
+	}
+	defer group.Close()
+	min, max := group.MinIndex(), group.MaxIndex()
+	gr, err := group.NewReader(min)
+	if err != nil {
+		return false, err
+	}
+	defer gr.Close()
+
+	repairedFile := walFile + ".repair"
+	f, err := os.Create(repairedFile)
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(repairedFile) // nolint: errcheck
+	defer f.Close()
+
+	var (
+		dec      = NewWALDecoder(gr)
+		enc      = NewWALEncoder(f)
+		repaired bool
+	)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			break
+		} else if IsDataCorruptionError(err) {
+			repaired = true
+			break
+		} else if err != nil {
+			return false, err
+		}
+		if m, ok := msg.Msg.(EndHeightMessage); ok && m.Height > maxHeight {
+			repaired = true
+			break
+		}
+		if err := enc.Encode(msg); err != nil {
+			return false, err
+		}
+	}
+	if !repaired {
+		return false, nil
+	}
+	if err := f.Sync(); err != nil {
+		return false, err
+	}
+
+	// Rotated files are named like in libs/autofile.
+	for index := min; index < max; index++ {
+		path := fmt.Sprintf("%v.%03d", walFile, index)
+		if err := os.Rename(path, path+".bak"); err != nil {
+			return false, err
+		}
+	}
+	if err := os.Rename(walFile, walFile+".bak"); err != nil {
+		return false, err
+	}
+	if err := os.Rename(repairedFile, walFile); err != nil {
+		return false, err
+	}
+	// Persist the renames.
+	if err := syncDir(filepath.Dir(walFile)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// syncDir flushes the entries of the directory at path to stable storage.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	if err := dir.Sync(); err != nil {
+		dir.Close()
+		return err
+	}
+	return dir.Close()
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 
 // A WALEncoder writes custom-encoded WAL messages to an output stream.
@@ -94,12 +94,23 @@ func WALGenerateNBlocks(t *testing.T, wr io.Writer, numBlocks int) (err error) {
 		return errors.Wrap(err, "failed to start consensus state")
 	}
 
+	// Let a block being committed finish before the app connections are
+	// closed, which would fail it. The receive routine may also be blocked
+	// on the stopped timeout ticker, hence the timeout.
+	stop := func() {
+		consensusState.Stop()
+		select {
+		case <-consensusState.done:
+		case <-time.After(time.Second):
+		}
+	}
+
 	select {
 	case <-numBlocksWritten:
-		consensusState.Stop()
+		stop()
 		return nil
 	case <-time.After(1 * time.Minute):
-		consensusState.Stop()
+		stop()
 		return fmt.Errorf("waited too long for tendermint to produce %d blocks (grep logs for `wal_generator`)", numBlocks)
 	}
 }
@@ -3,6 +3,7 @@ package consensus
 import (
 	"bytes"
 	"crypto/rand"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -167,6 +168,68 @@ func TestWALSearchForEndHeight(t *testing.T) {
 	assert.Equal(t, rs.Height, h+1, "wrong height")
 }
 
+func TestRepairWAL(t *testing.T) {
+	walDir, err := ioutil.TempDir("", "wal")
+	require.NoError(t, err)
+	defer os.RemoveAll(walDir)
+	walFile := filepath.Join(walDir, "wal")
+
+	walBody, err := WALWithNBlocks(t, 6)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(walFile, walBody, 0600)
+	require.NoError(t, err)
+
+	// A consistent WAL isn't rewritten.
+	repaired, err := RepairWAL(walFile, 6)
+	require.NoError(t, err)
+	assert.False(t, repaired)
+
+	// The heights above the block store are dropped.
+	repaired, err = RepairWAL(walFile, 3)
+	require.NoError(t, err)
+	assert.True(t, repaired)
+	backup, err := ioutil.ReadFile(walFile + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, walBody, backup)
+
+	wal, err := NewWAL(walFile)
+	require.NoError(t, err)
+	wal.SetLogger(log.TestingLogger())
+	_, found, err := wal.SearchForEndHeight(4, &WALSearchOptions{})
+	require.NoError(t, err)
+	assert.False(t, found, "expected no end height for 4")
+	gr, found, err := wal.SearchForEndHeight(3, &WALSearchOptions{})
+	require.NoError(t, err)
+	assert.True(t, found, "expected to find end height for 3")
+	gr.Close()
+	wal.Group().Close()
+
+	// A corrupted tail is dropped.
+	f, err := os.OpenFile(walFile, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(nBytes(100))
+	require.NoError(t, err)
+	f.Close()
+
+	repaired, err = RepairWAL(walFile, 3)
+	require.NoError(t, err)
+	assert.True(t, repaired)
+	wal, err = NewWAL(walFile)
+	require.NoError(t, err)
+	defer wal.Group().Close()
+	gr, err = wal.Group().NewReader(0)
+	require.NoError(t, err)
+	defer gr.Close()
+	dec := NewWALDecoder(gr)
+	for {
+		_, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+}
+
 func TestWALPeriodicSync(t *testing.T) {
 	walDir, err := ioutil.TempDir("", "wal")
 	require.NoError(t, err)
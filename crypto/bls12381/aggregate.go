@@ -0,0 +1,53 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+
+	bls "github.com/kilic/bls12-381"
+)
+
+// AggregateSignatures adds the signatures up into a single one. It fails if
+// any of them is not a valid encoding of a point of the G2 subgroup.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		s, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature #%d: %v", i, err)
+		}
+		g2.Add(agg, agg, s)
+	}
+	return g2.ToCompressed(agg), nil
+}
+
+// FastAggregateVerify verifies an aggregate of the signatures of the same msg
+// by pubKeys.
+//
+// The public keys are added up, so it takes two pairings whatever the number
+// of signers. This relies on the public keys having a valid proof of
+// possession, see VerifyProofOfPossession.
+func FastAggregateVerify(pubKeys []PubKeyBls12381, msg []byte, sig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+
+	g1 := bls.NewG1()
+	sum := g1.Zero()
+	for _, pubKey := range pubKeys {
+		p, err := pubKey.point()
+		if err != nil {
+			return false
+		}
+		g1.Add(sum, sum, p)
+	}
+	if g1.IsZero(sum) {
+		return false
+	}
+
+	return verify([]*bls.PointG1{sum}, [][]byte{msg}, sig, dstSignature)
+}
@@ -0,0 +1,101 @@
+package bls12381_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+)
+
+func TestSignAndValidateBls12381(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifyBytes(msg, sig))
+	assert.False(t, pubKey.VerifyBytes(crypto.CRandBytes(128), sig))
+	assert.False(t, bls12381.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifyBytes(msg, sig))
+}
+
+func TestPubKeyOfKnownPrivKey(t *testing.T) {
+	// The public key of the scalar 1 is the generator of G1.
+	var privKey bls12381.PrivKeyBls12381
+	privKey[bls12381.PrivKeyBls12381Size-1] = 1
+	pubKey := privKey.PubKey().(bls12381.PubKeyBls12381)
+	assert.Equal(t,
+		"97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		hex.EncodeToString(pubKey[:]))
+
+	// The identity can't be used as a public key.
+	var infinity bls12381.PubKeyBls12381
+	infinity[0] = 0xc0
+	var zero bls12381.PrivKeyBls12381
+	sig, err := zero.Sign([]byte("msg"))
+	assert.Error(t, err)
+	assert.Nil(t, sig)
+	assert.False(t, infinity.VerifyBytes([]byte("msg"), make([]byte, bls12381.SignatureSize)))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(bls12381.PubKeyBls12381)
+
+	pop := privKey.ProofOfPossession()
+	assert.True(t, pubKey.VerifyProofOfPossession(pop))
+	assert.False(t, bls12381.GenPrivKey().PubKey().(bls12381.PubKeyBls12381).VerifyProofOfPossession(pop))
+
+	// A proof of possession is not a signature of the public key, and vice
+	// versa.
+	sig, err := privKey.Sign(pubKey[:])
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifyProofOfPossession(sig))
+	assert.False(t, pubKey.VerifyBytes(pubKey[:], pop))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	var (
+		msg     = []byte("message")
+		pubKeys = make([]bls12381.PubKeyBls12381, 5)
+		sigs    = make([][]byte, 5)
+	)
+	for i := range pubKeys {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey().(bls12381.PubKeyBls12381)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	agg, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.True(t, bls12381.FastAggregateVerify(pubKeys, msg, agg))
+
+	// A missing signer, or a wrong message, is caught.
+	assert.False(t, bls12381.FastAggregateVerify(pubKeys[1:], msg, agg))
+	assert.False(t, bls12381.FastAggregateVerify(pubKeys, []byte("another message"), agg))
+	assert.False(t, bls12381.FastAggregateVerify(nil, msg, agg))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	assert.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{make([]byte, bls12381.SignatureSize)})
+	assert.Error(t, err)
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := bls12381.GenPrivKeyFromSecret([]byte("secret"))
+	assert.Equal(t, privKey, bls12381.GenPrivKeyFromSecret([]byte("secret")))
+	assert.NotEqual(t, privKey, bls12381.GenPrivKeyFromSecret([]byte("another secret")))
+}
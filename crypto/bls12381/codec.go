@@ -0,0 +1,30 @@
+package bls12381
+
+import (
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ crypto.PrivKey = PrivKeyBls12381{}
+
+const (
+	PrivKeyAminoName = "tendermint/PrivKeyBls12381"
+	PubKeyAminoName  = "tendermint/PubKeyBls12381"
+
+	// SignatureSize is the size of a BLS12-381 signature, namely a compressed
+	// G2 point.
+	SignatureSize = 96
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeyBls12381{},
+		PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeyBls12381{},
+		PrivKeyAminoName, nil)
+}
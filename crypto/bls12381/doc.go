@@ -0,0 +1,12 @@
+// Package bls12381 implements BLS signatures over the BLS12-381 curve, with
+// public keys in G1 and signatures in G2.
+//
+// It follows the proof-of-possession scheme of the IETF BLS signature draft
+// (ciphersuite BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_). Signatures can be
+// aggregated into a single one, and an aggregate signature over the same
+// message is verified against the sum of the signers' public keys. This is
+// only safe if every public key comes with a valid proof of possession of its
+// private key, or an attacker could register a "rogue" key derived from the
+// keys of others and forge an aggregate signature on their behalf. So a key
+// must not be trusted before VerifyProofOfPossession succeeded.
+package bls12381
@@ -0,0 +1,119 @@
+package bls12381
+
+import (
+	"crypto/subtle"
+	"io"
+
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var (
+	// dstSignature is the domain separation tag of signatures.
+	dstSignature = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// dstProofOfPossession is the domain separation tag of proofs of
+	// possession, so they can't be mistaken for a signature of a message.
+	dstProofOfPossession = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+// PrivKeyBls12381Size is the number of bytes in a BLS12-381 private key.
+const PrivKeyBls12381Size = 32
+
+// PrivKeyBls12381 implements crypto.PrivKey. It is the big-endian encoding of
+// a non-zero scalar.
+type PrivKeyBls12381 [PrivKeyBls12381Size]byte
+
+// Bytes marshals the privkey using amino encoding.
+func (privKey PrivKeyBls12381) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign produces a signature on the provided message.
+func (privKey PrivKeyBls12381) Sign(msg []byte) ([]byte, error) {
+	return sign(privKey, msg, dstSignature)
+}
+
+// ProofOfPossession returns a proof that the holder of the public key knows
+// the private key, namely a signature of the public key itself.
+func (privKey PrivKeyBls12381) ProofOfPossession() []byte {
+	pubKey := privKey.PubKey().(PubKeyBls12381)
+	pop, err := sign(privKey, pubKey[:], dstProofOfPossession)
+	if err != nil {
+		panic(err)
+	}
+	return pop
+}
+
+// PubKey gets the corresponding public key from the private key.
+func (privKey PrivKeyBls12381) PubKey() crypto.PubKey {
+	g1 := bls.NewG1()
+	p := g1.New()
+	g1.MulScalar(p, g1.One(), bls.NewFr().FromBytes(privKey[:]))
+
+	var pubKey PubKeyBls12381
+	copy(pubKey[:], g1.ToCompressed(p))
+	return pubKey
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeyBls12381) Equals(other crypto.PrivKey) bool {
+	if otherBls, ok := other.(PrivKeyBls12381); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBls[:]) == 1
+	}
+	return false
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness in conjunction with the current global random seed
+// in tendermint/libs/common to generate the private key.
+func GenPrivKey() PrivKeyBls12381 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeyBls12381 {
+	// 64 bytes are reduced to a scalar, so the bias is negligible.
+	seed := make([]byte, 64)
+	_, err := io.ReadFull(rand, seed)
+	if err != nil {
+		panic(err)
+	}
+	return privKeyFromSeed(seed)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKeyBls12381 {
+	return privKeyFromSeed(crypto.Sha256(secret))
+}
+
+// privKeyFromSeed reduces the seed to a scalar. It rehashes the seed in the
+// unlikely case the scalar is zero.
+func privKeyFromSeed(seed []byte) PrivKeyBls12381 {
+	s := bls.NewFr().FromBytes(seed)
+	for s.IsZero() {
+		seed = crypto.Sha256(seed)
+		s = bls.NewFr().FromBytes(seed)
+	}
+	var privKey PrivKeyBls12381
+	copy(privKey[:], s.ToBytes())
+	return privKey
+}
+
+func sign(privKey PrivKeyBls12381, msg, dst []byte) ([]byte, error) {
+	s := bls.NewFr().FromBytes(privKey[:])
+	if s.IsZero() {
+		return nil, errInvalidPrivKey
+	}
+	g2 := bls.NewG2()
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	g2.MulScalar(h, h, s)
+	return g2.ToCompressed(h), nil
+}
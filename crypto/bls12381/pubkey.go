@@ -0,0 +1,110 @@
+package bls12381
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	bls "github.com/kilic/bls12-381"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+var _ crypto.PubKey = PubKeyBls12381{}
+
+var (
+	errInvalidPrivKey = errors.New("invalid BLS12-381 private key")
+	errInvalidPubKey  = errors.New("invalid BLS12-381 public key")
+)
+
+// PubKeyBls12381Size is the number of bytes in a BLS12-381 public key, namely
+// a compressed G1 point.
+const PubKeyBls12381Size = 48
+
+// PubKeyBls12381 implements crypto.PubKey for the BLS12-381 signature scheme.
+type PubKeyBls12381 [PubKeyBls12381Size]byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKeyBls12381) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes marshals the PubKey using amino encoding.
+func (pubKey PubKeyBls12381) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies the signature of msg. The public key and signature
+// must be points of the prime order subgroups, and the public key must not
+// be the identity.
+func (pubKey PubKeyBls12381) VerifyBytes(msg []byte, sig []byte) bool {
+	p, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+	return verify([]*bls.PointG1{p}, [][]byte{msg}, sig, dstSignature)
+}
+
+// VerifyProofOfPossession checks the proof that the holder of the public key
+// knows the private key. See PrivKeyBls12381.ProofOfPossession.
+func (pubKey PubKeyBls12381) VerifyProofOfPossession(pop []byte) bool {
+	p, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+	return verify([]*bls.PointG1{p}, [][]byte{pubKey[:]}, pop, dstProofOfPossession)
+}
+
+func (pubKey PubKeyBls12381) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey[:])
+}
+
+// nolint: golint
+func (pubKey PubKeyBls12381) Equals(other crypto.PubKey) bool {
+	if otherBls, ok := other.(PubKeyBls12381); ok {
+		return bytes.Equal(pubKey[:], otherBls[:])
+	}
+	return false
+}
+
+func (pubKey PubKeyBls12381) point() (*bls.PointG1, error) {
+	g1 := bls.NewG1()
+	p, err := g1.FromCompressed(pubKey[:])
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errInvalidPubKey
+	}
+	return p, nil
+}
+
+// verify checks the signature of each message by the public key with the
+// same index, with a single final exponentiation. The public keys must have
+// been checked already.
+func verify(pubKeys []*bls.PointG1, msgs [][]byte, sig, dst []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+	g2 := bls.NewG2()
+	s, err := g2.FromCompressed(sig)
+	if err != nil {
+		return false
+	}
+
+	e := bls.NewEngine()
+	for i, msg := range msgs {
+		h, err := g2.HashToCurve(msg, dst)
+		if err != nil {
+			return false
+		}
+		e.AddPair(pubKeys[i], h)
+	}
+	e.AddPairInv(e.G1.One(), s)
+	return e.Check()
+}
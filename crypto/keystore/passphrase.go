@@ -0,0 +1,148 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// PassphraseFunc returns the passphrase of encrypted keys. It is only called
+// when an encrypted key is found.
+type PassphraseFunc func() (string, error)
+
+const (
+	// SourcePrompt reads the passphrase from the terminal.
+	SourcePrompt = "prompt"
+	// SourceEnvPrefix reads the passphrase from an environment variable, as
+	// in "env:TM_PASSPHRASE".
+	SourceEnvPrefix = "env:"
+	// SourceFdPrefix reads the passphrase from an open file descriptor, as in
+	// "fd:3".
+	SourceFdPrefix = "fd:"
+)
+
+// PassphraseFromSource returns a PassphraseFunc reading the passphrase from
+// the source, which is "prompt", "env:<VAR>" or "fd:<N>". The passphrase is
+// only read once, on the first successful call.
+func PassphraseFromSource(source, prompt string) (PassphraseFunc, error) {
+	var read PassphraseFunc
+	switch {
+	case source == SourcePrompt:
+		read = func() (string, error) {
+			return PromptPassphrase(prompt)
+		}
+
+	case strings.HasPrefix(source, SourceEnvPrefix):
+		name := strings.TrimPrefix(source, SourceEnvPrefix)
+		if name == "" {
+			return nil, fmt.Errorf("missing environment variable name in passphrase source %q", source)
+		}
+		read = func() (string, error) {
+			passphrase, ok := os.LookupEnv(name)
+			if !ok {
+				return "", fmt.Errorf("environment variable %s is not set", name)
+			}
+			return passphrase, nil
+		}
+
+	case strings.HasPrefix(source, SourceFdPrefix):
+		fd, err := strconv.ParseUint(strings.TrimPrefix(source, SourceFdPrefix), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file descriptor in passphrase source %q: %v", source, err)
+		}
+		read = func() (string, error) {
+			f := os.NewFile(uintptr(fd), "passphrase")
+			defer f.Close()
+			bz, err := ioutil.ReadAll(f)
+			if err != nil {
+				return "", fmt.Errorf("failed to read passphrase from fd %d: %v", fd, err)
+			}
+			return strings.TrimRight(string(bz), "\r\n"), nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown passphrase source %q (must be %q, %q<VAR> or %q<N>)",
+			source, SourcePrompt, SourceEnvPrefix, SourceFdPrefix)
+	}
+
+	var (
+		mtx        sync.Mutex
+		passphrase string
+		done       bool
+	)
+	return func() (string, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if !done {
+			p, err := read()
+			if err != nil {
+				return "", err
+			}
+			passphrase, done = p, true
+		}
+		return passphrase, nil
+	}, nil
+}
+
+// PromptPassphrase prints the prompt to stderr and reads a passphrase from
+// stdin, without echoing it if stdin is a terminal.
+func PromptPassphrase(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		bz, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+	}
+
+	// Read a byte at a time, so the next prompt gets the next line.
+	var (
+		line []byte
+		b    = make([]byte, 1)
+	)
+	for {
+		n, err := os.Stdin.Read(b)
+		if n == 1 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			break
+		} else if err != nil {
+			return "", fmt.Errorf("failed to read passphrase: %v", err)
+		}
+	}
+	return strings.TrimRight(string(line), "\r"), nil
+}
+
+// PromptNewPassphrase asks for a new passphrase twice, and fails if the
+// passphrases don't match or are empty.
+func PromptNewPassphrase(prompt string) (string, error) {
+	passphrase, err := PromptPassphrase(prompt)
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", errors.New("passphrase must not be empty")
+	}
+	repeat, err := PromptPassphrase("Repeat the passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase != repeat {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
@@ -127,12 +127,14 @@ func (prt *ProofRuntime) Verify(proof *Proof, root []byte, keypath string, args
 	return poz.Verify(root, keypath, args)
 }
 
-// DefaultProofRuntime only knows about Simple value
-// proofs.
-// To use e.g. IAVL proofs, register op-decoders as
-// defined in the IAVL package.
+// DefaultProofRuntime knows about Simple value proofs, and ICS23 proofs of
+// IAVL and SimpleMap trees.
+// To use other proofs, register op-decoders as
+// defined in their packages.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
 	prt.RegisterOpDecoder(ProofOpSimpleValue, SimpleValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpICS23IAVL, CommitmentOpDecoder)
+	prt.RegisterOpDecoder(ProofOpICS23Simple, CommitmentOpDecoder)
 	return
 }
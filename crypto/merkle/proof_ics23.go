@@ -0,0 +1,124 @@
+package merkle
+
+import (
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProofOpICS23IAVL is the type of ICS23 proofs of IAVL trees.
+	ProofOpICS23IAVL = "ics23:iavl"
+	// ProofOpICS23Simple is the type of ICS23 proofs of SimpleMap trees, eg.
+	// a multistore proving the roots of its substores.
+	ProofOpICS23Simple = "ics23:simple"
+)
+
+// CommitmentOp verifies an ICS23 commitment proof of the existence or
+// non-existence of a key, and produces the root hash of the tree. The proof
+// spec, and thus the tree structure, is given by the type of the operator.
+//
+// Run takes the value of the key as argument for an existence proof, or no
+// arguments for a non-existence proof.
+type CommitmentOp struct {
+	Type  string
+	Spec  *ics23.ProofSpec
+	Key   []byte
+	Proof *ics23.CommitmentProof
+}
+
+var _ ProofOperator = CommitmentOp{}
+
+// NewIAVLCommitmentOp returns a CommitmentOp for a proof of an IAVL tree.
+func NewIAVLCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpICS23IAVL,
+		Spec:  ics23.IavlSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
+// NewSimpleCommitmentOp returns a CommitmentOp for a proof of a SimpleMap
+// tree.
+func NewSimpleCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpICS23Simple,
+		Spec:  ics23.TendermintSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
+// CommitmentOpDecoder decodes a ProofOp of type ProofOpICS23IAVL or
+// ProofOpICS23Simple. ProofOp.Data is the protobuf encoded
+// ics23.CommitmentProof.
+func CommitmentOpDecoder(pop ProofOp) (ProofOperator, error) {
+	var spec *ics23.ProofSpec
+	switch pop.Type {
+	case ProofOpICS23IAVL:
+		spec = ics23.IavlSpec
+	case ProofOpICS23Simple:
+		spec = ics23.TendermintSpec
+	default:
+		return nil, errors.Errorf("unexpected ProofOp.Type; got %v, want %v or %v",
+			pop.Type, ProofOpICS23IAVL, ProofOpICS23Simple)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	err := proof.Unmarshal(pop.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding ProofOp.Data into CommitmentProof")
+	}
+	return CommitmentOp{
+		Type:  pop.Type,
+		Spec:  spec,
+		Key:   pop.Key,
+		Proof: proof,
+	}, nil
+}
+
+func (op CommitmentOp) ProofOp() ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return ProofOp{
+		Type: op.Type,
+		Key:  op.Key,
+		Data: bz,
+	}
+}
+
+func (op CommitmentOp) String() string {
+	return fmt.Sprintf("CommitmentOp{%v %v}", op.Type, op.GetKey())
+}
+
+func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
+	// The root is calculated from the proof, and then the proof is verified
+	// against it.
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, errors.Wrap(err, "calculating the root hash from the proof")
+	}
+
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key) {
+			return nil, errors.Errorf("proof of the absence of key %X is invalid", op.Key)
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+			return nil, errors.Errorf("proof of the existence of key %X is invalid", op.Key)
+		}
+	default:
+		return nil, errors.Errorf("expected 0 or 1 args, got %v", len(args))
+	}
+
+	return [][]byte{root}, nil
+}
+
+func (op CommitmentOp) GetKey() []byte {
+	return op.Key
+}
@@ -0,0 +1,135 @@
+package merkle
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The vectors in testdata/ics23 are ICS23 proofs of IAVL and SimpleMap trees,
+// which were built independently of this package and checked with the ICS23
+// reference implementation.
+type ics23Vector struct {
+	Root  string `json:"root"`
+	Proof string `json:"proof"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func loadICS23Vector(t *testing.T, path string) (root []byte, pop ProofOp, value []byte) {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	var v ics23Vector
+	require.NoError(t, json.Unmarshal(bz, &v))
+
+	root, err = hex.DecodeString(v.Root)
+	require.NoError(t, err)
+	pop.Data, err = hex.DecodeString(v.Proof)
+	require.NoError(t, err)
+	pop.Key, err = hex.DecodeString(v.Key)
+	require.NoError(t, err)
+	value, err = hex.DecodeString(v.Value)
+	require.NoError(t, err)
+	return root, pop, value
+}
+
+func TestCommitmentOpVectors(t *testing.T) {
+	prt := DefaultProofRuntime()
+	for dir, typ := range map[string]string{
+		"iavl":       ProofOpICS23IAVL,
+		"tendermint": ProofOpICS23Simple,
+	} {
+		for _, name := range []string{"exist_left", "exist_right", "exist_middle"} {
+			t.Run(dir+"/"+name, func(t *testing.T) {
+				root, pop, value := loadICS23Vector(t, filepath.Join("testdata", "ics23", dir, name+".json"))
+				pop.Type = typ
+				op, err := prt.Decode(pop)
+				require.NoError(t, err)
+
+				res, err := op.Run([][]byte{value})
+				require.NoError(t, err)
+				assert.Equal(t, [][]byte{root}, res)
+
+				_, err = op.Run([][]byte{[]byte("another value")})
+				assert.Error(t, err)
+				_, err = op.Run(nil)
+				assert.Error(t, err, "an existence proof must not prove absence")
+			})
+		}
+		for _, name := range []string{"nonexist_left", "nonexist_right", "nonexist_middle"} {
+			t.Run(dir+"/"+name, func(t *testing.T) {
+				root, pop, _ := loadICS23Vector(t, filepath.Join("testdata", "ics23", dir, name+".json"))
+				pop.Type = typ
+				op, err := prt.Decode(pop)
+				require.NoError(t, err)
+
+				res, err := op.Run(nil)
+				require.NoError(t, err)
+				assert.Equal(t, [][]byte{root}, res)
+
+				_, err = op.Run([][]byte{[]byte("value")})
+				assert.Error(t, err, "an absence proof must not prove a value")
+			})
+		}
+	}
+
+	// The specs of the two tree types differ, so proofs can't be swapped.
+	_, pop, value := loadICS23Vector(t, filepath.Join("testdata", "ics23", "iavl", "exist_middle.json"))
+	pop.Type = ProofOpICS23Simple
+	op, err := prt.Decode(pop)
+	require.NoError(t, err)
+	_, err = op.Run([][]byte{value})
+	assert.Error(t, err)
+}
+
+func TestCommitmentOpChain(t *testing.T) {
+	prt := DefaultProofRuntime()
+
+	// An IAVL store committed in a SimpleMap of stores.
+	storeRoot, pop, value := loadICS23Vector(t, filepath.Join("testdata", "ics23", "iavl", "exist_middle.json"))
+	pop.Type = ProofOpICS23IAVL
+	iavlOp, err := CommitmentOpDecoder(pop)
+	require.NoError(t, err)
+
+	root, proofs, _ := SimpleProofsFromMap(map[string][]byte{
+		"bank": []byte("the bank root"),
+		"main": storeRoot,
+	})
+	storeOp := NewSimpleValueOp([]byte("main"), proofs["main"])
+	proof := &Proof{Ops: []ProofOp{iavlOp.ProofOp(), storeOp.ProofOp()}}
+
+	kp := KeyPath{}.
+		AppendKey([]byte("main"), KeyEncodingURL).
+		AppendKey(iavlOp.GetKey(), KeyEncodingURL)
+	assert.NoError(t, prt.VerifyValue(proof, root, kp.String(), value))
+	assert.Error(t, prt.VerifyValue(proof, root, kp.String(), []byte("another value")))
+
+	// The absence of a key in the same store.
+	storeRoot, pop, _ = loadICS23Vector(t, filepath.Join("testdata", "ics23", "iavl", "nonexist_middle.json"))
+	pop.Type = ProofOpICS23IAVL
+	absenceOp, err := CommitmentOpDecoder(pop)
+	require.NoError(t, err)
+	root, proofs, _ = SimpleProofsFromMap(map[string][]byte{
+		"bank": []byte("the bank root"),
+		"main": storeRoot,
+	})
+	storeOp = NewSimpleValueOp([]byte("main"), proofs["main"])
+	proof = &Proof{Ops: []ProofOp{absenceOp.ProofOp(), storeOp.ProofOp()}}
+
+	kp = KeyPath{}.
+		AppendKey([]byte("main"), KeyEncodingURL).
+		AppendKey(absenceOp.GetKey(), KeyEncodingURL)
+	assert.NoError(t, prt.VerifyAbsence(proof, root, kp.String()))
+}
+
+func TestCommitmentOpDecoderRejectsOtherTypes(t *testing.T) {
+	_, err := CommitmentOpDecoder(ProofOp{Type: ProofOpSimpleValue})
+	assert.Error(t, err)
+	_, err = CommitmentOpDecoder(ProofOp{Type: ProofOpICS23IAVL, Data: []byte{0xff}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// SimpleMultiProof proves several leaves of the same simple Merkle tree at
+// once. Instead of an aunt per leaf and level, it holds the roots of the
+// subtrees containing none of the proven leaves, which are shared by all of
+// them. So proving k of n leaves takes about k*log2(n/k) hashes, against
+// k*log2(n) with a SimpleProof per leaf.
+type SimpleMultiProof struct {
+	Total   int      `json:"total"`   // Total number of items.
+	Indices []int    `json:"indices"` // Indices of the items to prove, in increasing order.
+	Aunts   [][]byte `json:"aunts"`   // Hashes of the subtrees without items to prove, left to right.
+}
+
+// SimpleMultiProofFromByteSlices computes a proof of the inclusion of the
+// given items. The indices are sorted and deduplicated. Panics if an index is
+// out of range.
+func SimpleMultiProofFromByteSlices(items [][]byte, indices []int) (rootHash []byte, proof *SimpleMultiProof) {
+	sorted := make([]int, 0, len(indices))
+	for _, i := range indices {
+		if i < 0 || i >= len(items) {
+			panic(fmt.Sprintf("index %d out of range [0, %d)", i, len(items)))
+		}
+		sorted = append(sorted, i)
+	}
+	sort.Ints(sorted)
+	unique := sorted[:0]
+	for i, idx := range sorted {
+		if i == 0 || idx != sorted[i-1] {
+			unique = append(unique, idx)
+		}
+	}
+
+	proof = &SimpleMultiProof{
+		Total:   len(items),
+		Indices: unique,
+		Aunts:   [][]byte{},
+	}
+	rootHash = proof.build(items, 0, unique)
+	return rootHash, proof
+}
+
+// build returns the hash of the subtree of items, which starts at offset in
+// the tree, and appends the aunts needed to prove the given indices.
+func (mp *SimpleMultiProof) build(items [][]byte, offset int, indices []int) []byte {
+	if len(indices) == 0 {
+		hash := SimpleHashFromByteSlices(items)
+		mp.Aunts = append(mp.Aunts, hash)
+		return hash
+	}
+	if len(items) == 1 {
+		return leafHash(items[0])
+	}
+	k := getSplitPoint(len(items))
+	split := sort.SearchInts(indices, offset+k)
+	left := mp.build(items[:k], offset, indices[:split])
+	right := mp.build(items[k:], offset+k, indices[split:])
+	return innerHash(left, right)
+}
+
+// Verify that the SimpleMultiProof proves the root hash. leaves are the items
+// at the indices of the proof, in the same order.
+func (mp *SimpleMultiProof) Verify(rootHash []byte, leaves [][]byte) error {
+	computedHash, err := mp.ComputeRootHash(leaves)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computedHash, rootHash) {
+		return errors.Errorf("invalid root hash: wanted %X got %X", rootHash, computedHash)
+	}
+	return nil
+}
+
+// ComputeRootHash computes the root hash given the leaves at the indices of
+// the proof. It returns an error if the proof is malformed, but does not
+// verify the result.
+func (mp *SimpleMultiProof) ComputeRootHash(leaves [][]byte) ([]byte, error) {
+	if err := mp.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if len(leaves) != len(mp.Indices) {
+		return nil, errors.Errorf("expected %d leaves, got %d", len(mp.Indices), len(leaves))
+	}
+	leafHashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		leafHashes[i] = leafHash(leaf)
+	}
+
+	aunts := mp.Aunts
+	var compute func(total, offset int, indices []int, leafHashes [][]byte) ([]byte, error)
+	compute = func(total, offset int, indices []int, leafHashes [][]byte) ([]byte, error) {
+		if len(indices) == 0 {
+			if len(aunts) == 0 {
+				return nil, errors.New("too few aunts")
+			}
+			hash := aunts[0]
+			aunts = aunts[1:]
+			return hash, nil
+		}
+		if total == 1 {
+			return leafHashes[0], nil
+		}
+		k := getSplitPoint(total)
+		split := sort.SearchInts(indices, offset+k)
+		left, err := compute(k, offset, indices[:split], leafHashes[:split])
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(total-k, offset+k, indices[split:], leafHashes[split:])
+		if err != nil {
+			return nil, err
+		}
+		return innerHash(left, right), nil
+	}
+
+	rootHash, err := compute(mp.Total, 0, mp.Indices, leafHashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(aunts) != 0 {
+		return nil, errors.Errorf("%d aunts left over", len(aunts))
+	}
+	return rootHash, nil
+}
+
+// String implements the stringer interface for SimpleMultiProof.
+// It is a wrapper around StringIndented.
+func (mp *SimpleMultiProof) String() string {
+	return mp.StringIndented("")
+}
+
+// StringIndented generates a canonical string representation of a
+// SimpleMultiProof.
+func (mp *SimpleMultiProof) StringIndented(indent string) string {
+	return fmt.Sprintf(`SimpleMultiProof{
+%s  Total:   %v
+%s  Indices: %v
+%s  Aunts:   %X
+%s}`,
+		indent, mp.Total,
+		indent, mp.Indices,
+		indent, mp.Aunts,
+		indent)
+}
+
+// ValidateBasic performs basic validation.
+// NOTE: it expects at least one index, the indices to be increasing and in
+// range, and the elements of Aunts to be of size tmhash.Size.
+func (mp *SimpleMultiProof) ValidateBasic() error {
+	if mp.Total <= 0 {
+		return errors.New("non-positive Total")
+	}
+	if len(mp.Indices) == 0 {
+		return errors.New("no Indices")
+	}
+	for i, idx := range mp.Indices {
+		if idx < 0 || idx >= mp.Total {
+			return errors.Errorf("Indices#%d (%d) out of range [0, %d)", i, idx, mp.Total)
+		}
+		if i > 0 && idx <= mp.Indices[i-1] {
+			return errors.Errorf("Indices#%d (%d) is not greater than the previous index", i, idx)
+		}
+	}
+	if len(mp.Aunts) > mp.Total {
+		return errors.Errorf("expected no more than %d aunts, got %d", mp.Total, len(mp.Aunts))
+	}
+	for i, auntHash := range mp.Aunts {
+		if len(auntHash) != tmhash.Size {
+			return errors.Errorf("expected Aunts#%d size to be %d, got %d", i, tmhash.Size, len(auntHash))
+		}
+	}
+	return nil
+}
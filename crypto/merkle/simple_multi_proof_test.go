@@ -0,0 +1,110 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestSimpleMultiProof(t *testing.T) {
+	for total := 1; total <= 40; total++ {
+		items := make([][]byte, total)
+		for i := range items {
+			items[i] = tmrand.Bytes(tmhash.Size)
+		}
+		rootHash := SimpleHashFromByteSlices(items)
+
+		for _, indices := range [][]int{
+			{0},
+			{total - 1},
+			{total - 1, 0, total / 2, 0}, // unsorted, with duplicates
+			tmrand.Perm(total)[:1+tmrand.Intn(total)],
+		} {
+			root, proof := SimpleMultiProofFromByteSlices(items, indices)
+			require.Equal(t, rootHash, root)
+
+			leaves := make([][]byte, len(proof.Indices))
+			for i, idx := range proof.Indices {
+				leaves[i] = items[idx]
+			}
+			msg := fmt.Sprintf("total %d, indices %v", total, proof.Indices)
+			require.NoError(t, proof.Verify(rootHash, leaves), msg)
+
+			// Any change of a leaf or an aunt is caught.
+			leaves[0] = []byte("tampered")
+			assert.Error(t, proof.Verify(rootHash, leaves), msg)
+			leaves[0] = items[proof.Indices[0]]
+			if len(proof.Aunts) > 0 {
+				aunt := proof.Aunts[0]
+				proof.Aunts[0] = tmrand.Bytes(tmhash.Size)
+				assert.Error(t, proof.Verify(rootHash, leaves), msg)
+				proof.Aunts[0] = aunt
+			}
+			// And so is a missing leaf.
+			assert.Error(t, proof.Verify(rootHash, leaves[1:]), msg)
+		}
+	}
+}
+
+func TestSimpleMultiProofSize(t *testing.T) {
+	items := make([][]byte, 1000)
+	for i := range items {
+		items[i] = tmrand.Bytes(tmhash.Size)
+	}
+	indices := make([]int, 500)
+	for i := range indices {
+		indices[i] = 2 * i
+	}
+	_, proof := SimpleMultiProofFromByteSlices(items, indices)
+	_, proofs := SimpleProofsFromByteSlices(items)
+
+	aunts := 0
+	for _, i := range indices {
+		aunts += len(proofs[i].Aunts)
+	}
+	assert.Equal(t, 500, len(proof.Aunts))
+	assert.True(t, len(proof.Aunts) < aunts/9, "%d vs %d aunts", len(proof.Aunts), aunts)
+}
+
+func TestSimpleMultiProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		malleateProof func(*SimpleMultiProof)
+		errStr        string
+	}{
+		{"Good", func(mp *SimpleMultiProof) {}, ""},
+		{"Zero Total", func(mp *SimpleMultiProof) { mp.Total = 0 }, "non-positive Total"},
+		{"No Indices", func(mp *SimpleMultiProof) { mp.Indices = nil }, "no Indices"},
+		{"Index out of range", func(mp *SimpleMultiProof) { mp.Indices[1] = 3 },
+			"Indices#1 (3) out of range [0, 3)"},
+		{"Unordered Indices", func(mp *SimpleMultiProof) { mp.Indices[0], mp.Indices[1] = 2, 0 },
+			"Indices#1 (0) is not greater than the previous index"},
+		{"Too many Aunts", func(mp *SimpleMultiProof) { mp.Aunts = make([][]byte, 4) },
+			"expected no more than 3 aunts, got 4"},
+		{"Invalid Aunt", func(mp *SimpleMultiProof) { mp.Aunts[0] = make([]byte, 10) },
+			"expected Aunts#0 size to be 32, got 10"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			_, proof := SimpleMultiProofFromByteSlices([][]byte{
+				[]byte("apple"),
+				[]byte("watermelon"),
+				[]byte("kiwi"),
+			}, []int{0, 2})
+			tc.malleateProof(proof)
+			err := proof.ValidateBasic()
+			if tc.errStr != "" {
+				assert.Contains(t, err.Error(), tc.errStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -61,8 +61,26 @@ func (evpool *Pool) PriorityEvidence() []types.Evidence {
 
 // PendingEvidence returns up to maxNum uncommitted evidence.
 // If maxNum is -1, all evidence is returned.
+// Key rotations that no longer apply, or that conflict with an earlier one,
+// are skipped.
 func (evpool *Pool) PendingEvidence(maxNum int64) []types.Evidence {
-	return evpool.store.PendingEvidence(maxNum)
+	var (
+		state     = evpool.State()
+		rotations = make(sm.KeyRotationSet)
+		evidence  []types.Evidence
+	)
+	for _, ev := range evpool.store.PendingEvidence(-1) {
+		if int64(len(evidence)) == maxNum {
+			break
+		}
+		if rot, ok := ev.(*types.KeyRotation); ok {
+			if sm.VerifyEvidence(evpool.stateDB, state, rot) != nil || rotations.Add(rot) != nil {
+				continue
+			}
+		}
+		evidence = append(evidence, ev)
+	}
+	return evidence
 }
 
 // State returns the current state of the evpool.
@@ -136,6 +154,9 @@ func (evpool *Pool) MarkEvidenceAsCommitted(height int64, lastBlockTime time.Tim
 	// remove committed evidence from the clist
 	evidenceParams := evpool.State().ConsensusParams.Evidence
 	evpool.removeEvidence(height, lastBlockTime, evidenceParams, blockEvidenceMap)
+
+	// remove the key rotations that no longer apply
+	evpool.removeStaleKeyRotations()
 }
 
 // IsCommitted returns true if we have already seen this exact evidence and it is already marked as committed.
@@ -168,6 +189,25 @@ func (evpool *Pool) removeEvidence(
 	}
 }
 
+// removeStaleKeyRotations removes the key rotations that no longer verify
+// against the latest state, e.g. because the validator was removed or its key
+// was already rotated, from the clist and the store.
+func (evpool *Pool) removeStaleKeyRotations() {
+	state := evpool.State()
+	for e := evpool.evidenceList.Front(); e != nil; e = e.Next() {
+		rot, ok := e.Value.(*types.KeyRotation)
+		if !ok {
+			continue
+		}
+		if err := sm.VerifyEvidence(evpool.stateDB, state, rot); err != nil {
+			evpool.logger.Info("Removing stale key rotation", "rotation", rot, "err", err)
+			evpool.evidenceList.Remove(e)
+			e.DetachPrev()
+			evpool.store.RemoveEvidence(rot)
+		}
+	}
+}
+
 func evMapKey(ev types.Evidence) string {
 	return string(ev.Hash())
 }
@@ -199,6 +199,17 @@ func (store *Store) MarkEvidenceAsCommitted(evidence types.Evidence) {
 	store.db.SetSync(lookupKey, cdc.MustMarshalBinaryBare(ei))
 }
 
+// RemoveEvidence removes uncommitted evidence that is no longer valid.
+func (store *Store) RemoveEvidence(evidence types.Evidence) {
+	ei := store.getInfo(evidence)
+	if ei.Evidence == nil || ei.Committed {
+		return
+	}
+	store.db.Delete(keyOutqueue(evidence, ei.Priority))
+	store.db.Delete(keyPending(evidence))
+	store.db.DeleteSync(keyLookup(evidence))
+}
+
 //---------------------------------------------------
 // utils
 
@@ -73,7 +73,7 @@ func RPCRoutes(c rpcclient.Client) map[string]*rpcserver.RPCFunc {
 		"status":     rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
 		"blockchain": rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight"),
 		"genesis":    rpcserver.NewRPCFunc(makeGenesisFunc(c), ""),
-		"block":      rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
+		"block":      rpcserver.NewRPCFunc(makeBlockFunc(c), "height,batch_prove"),
 		"commit":     rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
 		"tx":         rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"validators": rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height"),
@@ -111,9 +111,10 @@ func makeGenesisFunc(c rpcclient.Client) func(ctx *rpctypes.Context) (*ctypes.Re
 	}
 }
 
-func makeBlockFunc(c rpcclient.Client) func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlock, error) {
-	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlock, error) {
-		return c.Block(height)
+func makeBlockFunc(c rpcclient.Client) func(ctx *rpctypes.Context, height *int64,
+	batchProve bool) (*ctypes.ResultBlock, error) {
+	return func(ctx *rpctypes.Context, height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
+		return c.Block(height, batchProve)
 	}
 }
 
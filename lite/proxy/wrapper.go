@@ -93,8 +93,8 @@ func (w Wrapper) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlock
 }
 
 // Block returns an entire block and verifies all signatures
-func (w Wrapper) Block(height *int64) (*ctypes.ResultBlock, error) {
-	resBlock, err := w.Client.Block(height)
+func (w Wrapper) Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
+	resBlock, err := w.Client.Block(height, batchProve)
 	if err != nil {
 		return nil, err
 	}
@@ -900,8 +900,23 @@ func (c *Client) compareNewHeaderWithWitnesses(h *types.SignedHeader) error {
 			}
 
 			if !bytes.Equal(h.Hash(), altH.Hash()) {
-				if err = c.latestTrustedNextVals.VerifyCommitTrusting(c.chainID, altH.Commit.BlockID,
-					altH.Height, altH.Commit, c.trustLevel); err != nil {
+				// The signers of an aggregated commit are resolved through the
+				// validator set of the header.
+				var altVals *types.ValidatorSet
+				if altH.Commit.Aggregated() {
+					altVals, err = witness.ValidatorSet(altH.Height)
+					if err != nil {
+						c.logger.Error("Failed to get a validator set from witness", "height", altH.Height, "witness", witness)
+						continue
+					}
+					if !bytes.Equal(altH.ValidatorsHash, altVals.Hash()) {
+						c.logger.Error("Witness sent us incorrect validator set", "witness", witness)
+						witnessesToRemove = append(witnessesToRemove, i)
+						continue
+					}
+				}
+				if err = verifyCommitTrusting(c.chainID, c.latestTrustedNextVals, altH, altVals,
+					c.trustLevel); err != nil {
 					c.logger.Error("Witness sent us incorrect header", "err", err, "witness", witness)
 					witnessesToRemove = append(witnessesToRemove, i)
 					continue
@@ -313,6 +313,86 @@ func TestClient_SkippingVerification(t *testing.T) {
 	}
 }
 
+// The signers of aggregated commits are resolved through the validator set
+// of the header, so headers can still be skipped.
+func TestClient_SkippingVerificationAggregated(t *testing.T) {
+	blsKeys := genBLSPrivKeys(4)
+	blsVals := blsKeys.ToValidators(20, 10)
+	blsH1 := blsKeys.GenAggregatedSignedHeader(chainID, 1, bTime, nil, blsVals, blsVals,
+		[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(blsKeys))
+
+	// 1/3+ of blsVals
+	transitKeys := append(privKeys{blsKeys[3]}, genBLSPrivKeys(2)...)
+	transitVals := transitKeys.ToValidators(10, 1)
+	// none of blsVals
+	newKeys := genBLSPrivKeys(4)
+	newVals := newKeys.ToValidators(10, 1)
+
+	testCases := []struct {
+		name      string
+		h3        *types.SignedHeader
+		vals3     *types.ValidatorSet
+		verifyErr bool
+	}{
+		{
+			"good",
+			blsKeys.GenAggregatedSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, blsVals, blsVals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(blsKeys)),
+			blsVals,
+			false,
+		},
+		{
+			"good, but val set changes by 2/3 (1/3 of vals is still present)",
+			transitKeys.GenAggregatedSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, transitVals, transitVals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(transitKeys)),
+			transitVals,
+			false,
+		},
+		{
+			"bad: no interim header, and none of vals signed",
+			newKeys.GenAggregatedSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, newVals, newVals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(newKeys)),
+			newVals,
+			true,
+		},
+		{
+			"bad: only 1/3- of vals signed",
+			blsKeys.GenAggregatedSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, blsVals, blsVals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, 1),
+			blsVals,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// No interim header: the client has to skip from 1 to 3.
+			headers := map[int64]*types.SignedHeader{1: blsH1, 3: tc.h3}
+			valSets := map[int64]*types.ValidatorSet{1: blsVals, 2: blsVals, 3: tc.vals3, 4: tc.vals3}
+			c, err := NewClient(
+				chainID,
+				TrustOptions{Period: trustPeriod, Height: 1, Hash: blsH1.Hash()},
+				mockp.New(chainID, headers, valSets),
+				[]provider.Provider{mockp.New(chainID, headers, valSets)},
+				dbs.New(dbm.NewMemDB(), chainID),
+				SkippingVerification(DefaultTrustLevel),
+			)
+			require.NoError(t, err)
+			err = c.Start()
+			require.NoError(t, err)
+			defer c.Stop()
+
+			_, err = c.VerifyHeaderAtHeight(3, bTime.Add(3*time.Hour))
+			if tc.verifyErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestClientRemovesNoLongerTrustedHeaders(t *testing.T) {
 	c, err := NewClient(
 		chainID,
@@ -96,17 +96,18 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return nil, err
 	}
 
+	// XXX How do we encode the key into a string...
+	storeName, err := parseQueryStorePath(path)
+	if err != nil {
+		return nil, err
+	}
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
 	// Validate the value proof against the trusted header.
 	if resp.Value != nil {
 		// Value exists
-		// XXX How do we encode the key into a string...
-		storeName, err := parseQueryStorePath(path)
-		if err != nil {
-			return nil, err
-		}
-		kp := merkle.KeyPath{}
-		kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-		kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
 		err = c.prt.VerifyValue(resp.Proof, h.AppHash, kp.String(), resp.Value)
 		if err != nil {
 			return nil, errors.Wrap(err, "verify value proof")
@@ -114,9 +115,8 @@ func (c *Client) ABCIQueryWithOptions(path string, data tmbytes.HexBytes,
 		return &ctypes.ResultABCIQuery{Response: resp}, nil
 	}
 
-	// OR validate the ansence proof against the trusted header.
-	// XXX How do we encode the key into a string...
-	err = c.prt.VerifyAbsence(resp.Proof, h.AppHash, string(resp.Key))
+	// OR validate the absence proof against the trusted header.
+	err = c.prt.VerifyAbsence(resp.Proof, h.AppHash, kp.String())
 	if err != nil {
 		return nil, errors.Wrap(err, "verify absence proof")
 	}
@@ -159,6 +159,10 @@ func (c *Client) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams,
 	return c.next.ConsensusParams(height)
 }
 
+func (c *Client) ValidatorUptime(address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return c.next.ValidatorUptime(address)
+}
+
 func (c *Client) Health() (*ctypes.ResultHealth, error) {
 	return c.next.Health()
 }
@@ -208,9 +212,10 @@ func (c *Client) Genesis() (*ctypes.ResultGenesis, error) {
 	return c.next.Genesis()
 }
 
-// Block calls rpcclient#Block and then verifies the result.
-func (c *Client) Block(height *int64) (*ctypes.ResultBlock, error) {
-	res, err := c.next.Block(height)
+// Block calls rpcclient#Block and then verifies the result, including the
+// proof of the txs if it was requested.
+func (c *Client) Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
+	res, err := c.next.Block(height, batchProve)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +244,20 @@ func (c *Client) Block(height *int64) (*ctypes.ResultBlock, error) {
 			bH, tH)
 	}
 
+	// Verify the proof of the txs, and check it covers every tx.
+	if batchProve && len(res.Block.Data.Txs) > 0 {
+		if res.TxsProof == nil {
+			return nil, errors.Errorf("no proof of the txs of block #%d", res.Block.Height)
+		}
+		if err := res.TxsProof.Validate(h.DataHash); err != nil {
+			return nil, errors.Wrapf(err, "proof of the txs of block #%d", res.Block.Height)
+		}
+		if len(res.TxsProof.Data) != len(res.Block.Data.Txs) {
+			return nil, errors.Errorf("proof of the txs of block #%d has %d txs, the block %d",
+				res.Block.Height, len(res.TxsProof.Data), len(res.Block.Data.Txs))
+		}
+	}
+
 	return res, nil
 }
 
@@ -295,9 +314,67 @@ func (c *Client) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return res, res.Proof.Validate(h.DataHash)
 }
 
-func (c *Client) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(query, prove, page, perPage, orderBy)
+// TxSearch calls rpcclient#TxSearch method and then verifies the proofs if
+// such were requested.
+//
+// NOTE: the index proofs returned with batchProve are not verified: the index
+// root they are checked against isn't part of the block header, so they can't
+// show the node didn't leave out any matching tx.
+func (c *Client) TxSearch(query string, prove bool, page, perPage int, orderBy string,
+	batchProve bool) (*ctypes.ResultTxSearch, error) {
+	res, err := c.next.TxSearch(query, prove, page, perPage, orderBy, batchProve)
+	if err != nil || (!prove && !batchProve) {
+		return res, err
+	}
+
+	// Validate the proof of each tx.
+	if prove {
+		for _, tx := range res.Txs {
+			if tx.Height <= 0 {
+				return nil, errors.Errorf("invalid ResultTx: %v", tx)
+			}
+			h, err := c.updateLiteClientIfNeededTo(tx.Height)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(tx.Proof.Data, tx.Tx) {
+				return nil, errors.Errorf("proof of tx %X is for another tx", tx.Hash)
+			}
+			if err := tx.Proof.Validate(h.DataHash); err != nil {
+				return nil, errors.Wrapf(err, "proof of tx %X", tx.Hash)
+			}
+		}
+	}
+
+	// Validate the batch proof of each block, and check it covers every tx.
+	if batchProve {
+		proofs := make(map[int64]types.TxMultiProof, len(res.Proofs))
+		for _, p := range res.Proofs {
+			if p.Height <= 0 {
+				return nil, errors.Errorf("invalid TxBatchProof height %d", p.Height)
+			}
+			h, err := c.updateLiteClientIfNeededTo(p.Height)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.Proof.Validate(h.DataHash); err != nil {
+				return nil, errors.Wrapf(err, "batch proof of block #%d", p.Height)
+			}
+			proofs[p.Height] = p.Proof
+		}
+		for _, tx := range res.Txs {
+			proof, ok := proofs[tx.Height]
+			if !ok {
+				return nil, errors.Errorf("no batch proof for block #%d of tx %X", tx.Height, tx.Hash)
+			}
+			if proof.Index(tx.Tx) != int(tx.Index) {
+				return nil, errors.Errorf("batch proof of block #%d doesn't include tx %X at index %d",
+					tx.Height, tx.Hash, tx.Index)
+			}
+		}
+	}
+
+	return res, nil
 }
 
 func (c *Client) Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error) {
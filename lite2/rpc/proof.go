@@ -10,5 +10,13 @@ func defaultProofRuntime() *merkle.ProofRuntime {
 		merkle.ProofOpSimpleValue,
 		merkle.SimpleValueOpDecoder,
 	)
+	prt.RegisterOpDecoder(
+		merkle.ProofOpICS23IAVL,
+		merkle.CommitmentOpDecoder,
+	)
+	prt.RegisterOpDecoder(
+		merkle.ProofOpICS23Simple,
+		merkle.CommitmentOpDecoder,
+	)
 	return prt
 }
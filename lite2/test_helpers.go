@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/bits"
 
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -28,6 +30,16 @@ func genPrivKeys(n int) privKeys {
 	return res
 }
 
+// genBLSPrivKeys produces an array of BLS12-381 private keys to generate
+// aggregated commits.
+func genBLSPrivKeys(n int) privKeys {
+	res := make(privKeys, n)
+	for i := range res {
+		res[i] = bls12381.GenPrivKey()
+	}
+	return res
+}
+
 // // Change replaces the key at index i.
 // func (pkz privKeys) Change(i int) privKeys {
 // 	res := make(privKeys, len(pkz))
@@ -160,3 +172,44 @@ func (pkz privKeys) GenSignedHeaderLastBlockID(chainID string, height int64, bTi
 		Commit: pkz.signHeader(header, first, last),
 	}
 }
+
+// signHeaderAggregated signs the header with all keys from first to last
+// exclusive, which must be BLS12-381 keys, and aggregates the signatures.
+func (pkz privKeys) signHeaderAggregated(header *types.Header, valset *types.ValidatorSet,
+	first, last int) *types.Commit {
+
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: crypto.CRandBytes(32)},
+	}
+	commit := types.NewAggregatedCommit(header.Height, 1, blockID, bits.NewBitArray(valset.Size()), nil)
+	signBytes := commit.AggregateSignBytes(header.ChainID)
+
+	sigs := make([][]byte, 0, last-first)
+	for i := first; i < last && i < len(pkz); i++ {
+		idx, _ := valset.GetByAddress(pkz[i].PubKey().Address())
+		commit.Signers.SetIndex(idx, true)
+		sig, err := pkz[i].Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs = append(sigs, sig)
+	}
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		panic(err)
+	}
+	commit.AggregatedSignature = aggSig
+	return commit
+}
+
+// GenAggregatedSignedHeader is GenSignedHeader with an aggregated commit.
+func (pkz privKeys) GenAggregatedSignedHeader(chainID string, height int64, bTime time.Time, txs types.Txs,
+	valset, nextValset *types.ValidatorSet, appHash, consHash, resHash []byte, first, last int) *types.SignedHeader {
+
+	header := genHeader(chainID, height, bTime, txs, valset, nextValset, appHash, consHash, resHash)
+	return &types.SignedHeader{
+		Header: header,
+		Commit: pkz.signHeaderAggregated(header, valset, first, last),
+	}
+}
@@ -49,8 +49,9 @@ func VerifyNonAdjacent(
 	}
 
 	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-	err := trustedNextVals.VerifyCommitTrusting(chainID, untrustedHeader.Commit.BlockID, untrustedHeader.Height,
-		untrustedHeader.Commit, trustLevel)
+	// NOTE: the signers of an aggregated commit are resolved through
+	// untrustedVals, which must be as large as the commit.
+	err := verifyCommitTrusting(chainID, trustedNextVals, untrustedHeader, untrustedVals, trustLevel)
 	if err != nil {
 		switch e := err.(type) {
 		case types.ErrNotEnoughVotingPowerSigned:
@@ -195,3 +196,22 @@ func HeaderExpired(h *types.SignedHeader, trustingPeriod time.Duration, now time
 	expirationTime := h.Time.Add(trustingPeriod)
 	return !expirationTime.After(now)
 }
+
+// verifyCommitTrusting verifies that trustLevel of trustedVals signed the
+// commit of untrustedHeader. The signers of an aggregated commit are only
+// known by their index in untrustedVals, which must have been checked to be
+// the validator set of untrustedHeader.
+func verifyCommitTrusting(
+	chainID string,
+	trustedVals *types.ValidatorSet,
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	trustLevel tmmath.Fraction) error {
+
+	if untrustedHeader.Commit.Aggregated() {
+		return trustedVals.VerifyAggregatedCommitTrusting(chainID, untrustedHeader.Commit.BlockID,
+			untrustedHeader.Height, untrustedHeader.Commit, untrustedVals, trustLevel)
+	}
+	return trustedVals.VerifyCommitTrusting(chainID, untrustedHeader.Commit.BlockID,
+		untrustedHeader.Height, untrustedHeader.Commit, trustLevel)
+}
@@ -199,6 +199,24 @@ func (mem *CListMempool) TxsFront() *clist.CElement {
 	return mem.txs.Front()
 }
 
+// hasTx returns true if the tx with the given hash is in the mempool or was
+// seen recently.
+func (mem *CListMempool) hasTx(txHash [sha256.Size]byte) bool {
+	if _, ok := mem.txsMap.Load(txHash); ok {
+		return true
+	}
+	return mem.cache.Has(txHash)
+}
+
+// getTx returns the tx with the given hash, if it's in the mempool.
+func (mem *CListMempool) getTx(txHash [sha256.Size]byte) (types.Tx, bool) {
+	e, ok := mem.txsMap.Load(txHash)
+	if !ok {
+		return nil, false
+	}
+	return e.(*clist.CElement).Value.(*mempoolTx).tx, true
+}
+
 // TxsWaitChan returns a channel to wait on transactions. It will be closed
 // once the mempool is not empty (ie. the internal `mem.txs` has at least one
 // element)
@@ -610,6 +628,22 @@ func (mem *CListMempool) recheckTxs() {
 	mem.proxyAppConn.FlushAsync()
 }
 
+// Recheck re-validates all txs against the app. It's used after the
+// connection to the app was re-established, as the app lost any state it kept
+// for them, and a recheck that was in progress was lost as well.
+// The caller must hold the lock (see Lock) and have flushed the connection
+// (see FlushAppConn).
+func (mem *CListMempool) Recheck() {
+	mem.recheckCursor = nil
+	mem.recheckEnd = nil
+	atomic.StoreInt32(&mem.rechecking, 0)
+
+	if mem.Size() > 0 {
+		mem.logger.Info("Recheck txs", "numtxs", mem.Size())
+		mem.recheckTxs()
+	}
+}
+
 //--------------------------------------------------------------------------------
 
 // mempoolTx is a transaction that successfully ran
@@ -634,6 +668,7 @@ type txCache interface {
 	Reset()
 	Push(tx types.Tx) bool
 	Remove(tx types.Tx)
+	Has(txHash [sha256.Size]byte) bool
 }
 
 // mapTxCache maintains a LRU cache of transactions. This only stores the hash
@@ -703,13 +738,23 @@ func (cache *mapTxCache) Remove(tx types.Tx) {
 	cache.mtx.Unlock()
 }
 
+// Has returns true if the tx with the given hash is in the cache.
+func (cache *mapTxCache) Has(txHash [sha256.Size]byte) bool {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+
+	_, exists := cache.cacheMap[txHash]
+	return exists
+}
+
 type nopTxCache struct{}
 
 var _ txCache = (*nopTxCache)(nil)
 
-func (nopTxCache) Reset()             {}
-func (nopTxCache) Push(types.Tx) bool { return true }
-func (nopTxCache) Remove(types.Tx)    {}
+func (nopTxCache) Reset()                     {}
+func (nopTxCache) Push(types.Tx) bool         { return true }
+func (nopTxCache) Remove(types.Tx)            {}
+func (nopTxCache) Has([sha256.Size]byte) bool { return false }
 
 //--------------------------------------------------------------------------------
 
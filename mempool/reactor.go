@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"math"
 	"reflect"
@@ -19,6 +21,10 @@ import (
 const (
 	MempoolChannel = byte(0x30)
 
+	// MempoolAnnounceChannel carries tx hash announcements and requests. Peers
+	// which both advertise it gossip hashes instead of full txs.
+	MempoolAnnounceChannel = byte(0x31)
+
 	aminoOverheadForTxMessage = 8
 
 	peerCatchupSleepIntervalMS = 100 // If peer is behind, sleep this amount
@@ -28,6 +34,14 @@ const (
 	UnknownPeerID uint16 = 0
 
 	maxActiveIDs = math.MaxUint16
+
+	// A requested tx which hasn't been received after this long is requested
+	// from the next peer which announced it, if any.
+	txRequestTimeout = 1 * time.Second
+
+	// Announced txs are no longer requested while this many requests are
+	// pending.
+	maxTxRequests = 10000
 )
 
 // Reactor handles mempool tx broadcasting amongst peers.
@@ -35,9 +49,22 @@ const (
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
-	config  *cfg.MempoolConfig
-	mempool *CListMempool
-	ids     *mempoolIDs
+	config   *cfg.MempoolConfig
+	mempool  *CListMempool
+	ids      *mempoolIDs
+	requests *txRequests
+
+	// tx requests and replies to send to peers we exchange announcements
+	// with, and the txs already sent to each of them
+	queuesMtx sync.RWMutex
+	queues    map[p2p.ID]chan envelope
+	sent      map[p2p.ID]*mapTxCache
+}
+
+// envelope is a message queued to be sent to a peer on a channel.
+type envelope struct {
+	chID     byte
+	msgBytes []byte
 }
 
 type mempoolIDs struct {
@@ -95,6 +122,88 @@ func (ids *mempoolIDs) GetForPeer(peer p2p.Peer) uint16 {
 	return ids.peerMap[peer.ID()]
 }
 
+// txRequests tracks the txs requested from peers after they announced them,
+// so a tx announced by several peers is only requested from one of them at a
+// time.
+type txRequests struct {
+	mtx      sync.Mutex
+	requests map[[sha256.Size]byte]*txRequest
+}
+
+type txRequest struct {
+	peer       p2p.ID
+	requested  time.Time
+	announcers []p2p.ID // other peers which announced the tx
+}
+
+// txRetry is a tx to request again from peer.
+type txRetry struct {
+	txHash [sha256.Size]byte
+	peer   p2p.ID
+}
+
+func newTxRequests() *txRequests {
+	return &txRequests{
+		requests: make(map[[sha256.Size]byte]*txRequest),
+	}
+}
+
+// Add marks the tx as requested from peer and returns true, unless it's
+// already requested from another peer, or too many requests are pending. In
+// the former case, peer is remembered to request the tx from if the pending
+// request expires.
+func (r *txRequests) Add(txHash [sha256.Size]byte, peer p2p.ID) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if req, ok := r.requests[txHash]; ok {
+		if req.peer == peer {
+			return false
+		}
+		for _, announcer := range req.announcers {
+			if announcer == peer {
+				return false
+			}
+		}
+		req.announcers = append(req.announcers, peer)
+		return false
+	}
+	if len(r.requests) >= maxTxRequests {
+		return false
+	}
+	r.requests[txHash] = &txRequest{peer: peer, requested: time.Now()}
+	return true
+}
+
+// Remove marks the tx as received.
+func (r *txRequests) Remove(txHash [sha256.Size]byte) {
+	r.mtx.Lock()
+	delete(r.requests, txHash)
+	r.mtx.Unlock()
+}
+
+// Expire drops the requests made txRequestTimeout or more before now. It
+// returns the txs to request again, from the next peer which announced them.
+func (r *txRequests) Expire(now time.Time) []txRetry {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var retries []txRetry
+	for txHash, req := range r.requests {
+		if now.Sub(req.requested) < txRequestTimeout {
+			continue
+		}
+		if len(req.announcers) == 0 {
+			delete(r.requests, txHash)
+			continue
+		}
+		req.peer, req.announcers = req.announcers[0], req.announcers[1:]
+		req.requested = now
+		retries = append(retries, txRetry{txHash: txHash, peer: req.peer})
+	}
+	return retries
+}
+
 func newMempoolIDs() *mempoolIDs {
 	return &mempoolIDs{
 		peerMap:   make(map[p2p.ID]uint16),
@@ -106,9 +215,12 @@ func newMempoolIDs() *mempoolIDs {
 // NewReactor returns a new Reactor with the given config and mempool.
 func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
 	memR := &Reactor{
-		config:  config,
-		mempool: mempool,
-		ids:     newMempoolIDs(),
+		config:   config,
+		mempool:  mempool,
+		ids:      newMempoolIDs(),
+		requests: newTxRequests(),
+		queues:   make(map[p2p.ID]chan envelope),
+		sent:     make(map[p2p.ID]*mapTxCache),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Reactor", memR)
 	return memR
@@ -125,31 +237,53 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	if memR.config.AnnounceTxHashes {
+		go memR.requestRoutine()
+	}
 	return nil
 }
 
 // GetChannels implements Reactor.
 // It returns the list of channels for this reactor.
 func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
-	return []*p2p.ChannelDescriptor{
+	channels := []*p2p.ChannelDescriptor{
 		{
 			ID:       MempoolChannel,
 			Priority: 5,
 		},
 	}
+	if memR.config.AnnounceTxHashes {
+		channels = append(channels, &p2p.ChannelDescriptor{
+			ID:       MempoolAnnounceChannel,
+			Priority: 5,
+		})
+	}
+	return channels
 }
 
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all txs are forwarded to the given peer.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
 	memR.ids.ReserveForPeer(peer)
+	if memR.announcesTxs(peer) {
+		queue := make(chan envelope, memR.config.Size)
+		memR.queuesMtx.Lock()
+		memR.queues[peer.ID()] = queue
+		memR.sent[peer.ID()] = newMapTxCache(memR.config.Size)
+		memR.queuesMtx.Unlock()
+		go memR.sendRoutine(peer, queue)
+	}
 	go memR.broadcastTxRoutine(peer)
 }
 
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
-	// broadcast routine checks if peer is gone and returns
+	memR.queuesMtx.Lock()
+	delete(memR.queues, peer.ID())
+	delete(memR.sent, peer.ID())
+	memR.queuesMtx.Unlock()
+	// broadcast and send routines check if peer is gone and return
 }
 
 // Receive implements Reactor.
@@ -161,10 +295,16 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		memR.Switch.StopPeerForError(src, err)
 		return
 	}
+	if err = msg.ValidateBasic(); err != nil {
+		memR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		memR.Switch.StopPeerForError(src, err)
+		return
+	}
 	memR.Logger.Debug("Receive", "src", src, "chId", chID, "msg", msg)
 
 	switch msg := msg.(type) {
 	case *TxMessage:
+		memR.requests.Remove(txKey(msg.Tx))
 		txInfo := TxInfo{SenderID: memR.ids.GetForPeer(src)}
 		if src != nil {
 			txInfo.SenderP2PID = src.ID()
@@ -174,6 +314,24 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			memR.Logger.Info("Could not check tx", "tx", txID(msg.Tx), "err", err)
 		}
 		// broadcasting happens from go routines per peer
+	case *HaveTxMessage:
+		// request the tx unless we already have it or asked another peer for it
+		txHash := msg.txKey()
+		if memR.mempool.hasTx(txHash) || !memR.requests.Add(txHash, src.ID()) {
+			return
+		}
+		if !memR.queueMsg(src, MempoolAnnounceChannel, &WantTxMessage{Hash: msg.Hash}) {
+			memR.requests.Remove(txHash)
+		}
+	case *WantTxMessage:
+		// each tx is sent to a peer once, repeated requests are dropped
+		tx, ok := memR.mempool.getTx(msg.txKey())
+		if !ok || !memR.markSent(src, tx) {
+			return
+		}
+		if !memR.queueMsg(src, MempoolChannel, &TxMessage{Tx: tx}) {
+			memR.unmarkSent(src, tx)
+		}
 	default:
 		memR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 	}
@@ -184,13 +342,105 @@ type PeerState interface {
 	GetHeight() int64
 }
 
-// Send new mempool txs to peer.
+// announcesTxs returns true if tx hashes should be announced to the peer
+// instead of sending full txs, ie. if both ends support the announce channel.
+func (memR *Reactor) announcesTxs(peer p2p.Peer) bool {
+	if !memR.config.AnnounceTxHashes {
+		return false
+	}
+	nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return false
+	}
+	return bytes.IndexByte(nodeInfo.Channels, MempoolAnnounceChannel) != -1
+}
+
+// queueMsg queues msg to be sent to the peer by its sendRoutine, so that
+// Receive never blocks on sending. It returns false if the peer's queue is
+// full, or if we don't exchange announcements with it.
+func (memR *Reactor) queueMsg(peer p2p.Peer, chID byte, msg Message) bool {
+	memR.queuesMtx.RLock()
+	queue, ok := memR.queues[peer.ID()]
+	memR.queuesMtx.RUnlock()
+	if !ok {
+		return false
+	}
+	select {
+	case queue <- envelope{chID: chID, msgBytes: cdc.MustMarshalBinaryBare(msg)}:
+		return true
+	default:
+		memR.Logger.Debug("Send queue is full, dropping msg", "peer", peer, "msg", msg)
+		return false
+	}
+}
+
+// markSent records that tx is sent to the peer. It returns false if it was
+// already, or if we don't exchange announcements with the peer.
+func (memR *Reactor) markSent(peer p2p.Peer, tx types.Tx) bool {
+	memR.queuesMtx.RLock()
+	sent, ok := memR.sent[peer.ID()]
+	memR.queuesMtx.RUnlock()
+	return ok && sent.Push(tx)
+}
+
+func (memR *Reactor) unmarkSent(peer p2p.Peer, tx types.Tx) {
+	memR.queuesMtx.RLock()
+	sent, ok := memR.sent[peer.ID()]
+	memR.queuesMtx.RUnlock()
+	if ok {
+		sent.Remove(tx)
+	}
+}
+
+// Request the txs whose requests expired from the next peer which announced
+// them.
+func (memR *Reactor) requestRoutine() {
+	ticker := time.NewTicker(txRequestTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			for _, retry := range memR.requests.Expire(now) {
+				if memR.mempool.hasTx(retry.txHash) {
+					memR.requests.Remove(retry.txHash)
+					continue
+				}
+				// If the peer is gone or busy, the request expires and moves on
+				// to the next one.
+				if peer := memR.Switch.Peers().Get(retry.peer); peer != nil {
+					memR.queueMsg(peer, MempoolAnnounceChannel, &WantTxMessage{Hash: retry.txHash[:]})
+				}
+			}
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// Send the queued tx requests and replies to peer.
+func (memR *Reactor) sendRoutine(peer p2p.Peer, queue <-chan envelope) {
+	for {
+		select {
+		case e := <-queue:
+			// If it fails, a request is retried with the next peer which
+			// announced the tx after txRequestTimeout.
+			peer.Send(e.chID, e.msgBytes)
+		case <-peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// Send new mempool txs (or their hashes) to peer.
 func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	if !memR.config.Broadcast {
 		return
 	}
 
 	peerID := memR.ids.GetForPeer(peer)
+	announce := memR.announcesTxs(peer)
 	var next *clist.CElement
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
@@ -233,9 +483,16 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 
 		// ensure peer hasn't already sent us this tx
 		if _, ok := memTx.senders.Load(peerID); !ok {
-			// send memTx
-			msg := &TxMessage{Tx: memTx.tx}
-			success := peer.Send(MempoolChannel, cdc.MustMarshalBinaryBare(msg))
+			// send memTx, or only its hash if the peer requests the txs it's missing
+			var success bool
+			if announce {
+				txHash := txKey(memTx.tx)
+				msg := &HaveTxMessage{Hash: txHash[:]}
+				success = peer.Send(MempoolAnnounceChannel, cdc.MustMarshalBinaryBare(msg))
+			} else {
+				msg := &TxMessage{Tx: memTx.tx}
+				success = peer.Send(MempoolChannel, cdc.MustMarshalBinaryBare(msg))
+			}
 			if !success {
 				time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
 				continue
@@ -258,11 +515,15 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 // Messages
 
 // Message is a message sent or received by the Reactor.
-type Message interface{}
+type Message interface {
+	ValidateBasic() error
+}
 
 func RegisterMessages(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Message)(nil), nil)
 	cdc.RegisterConcrete(&TxMessage{}, "tendermint/mempool/TxMessage", nil)
+	cdc.RegisterConcrete(&HaveTxMessage{}, "tendermint/mempool/HaveTxMessage", nil)
+	cdc.RegisterConcrete(&WantTxMessage{}, "tendermint/mempool/WantTxMessage", nil)
 }
 
 func (memR *Reactor) decodeMsg(bz []byte) (msg Message, err error) {
@@ -281,11 +542,69 @@ type TxMessage struct {
 	Tx types.Tx
 }
 
+// ValidateBasic implements Message.
+func (m *TxMessage) ValidateBasic() error {
+	return nil
+}
+
 // String returns a string representation of the TxMessage.
 func (m *TxMessage) String() string {
 	return fmt.Sprintf("[TxMessage %v]", m.Tx)
 }
 
+//-------------------------------------
+
+// HaveTxMessage announces that the sender has a tx in its mempool. The
+// receiver replies with a WantTxMessage if it hasn't seen it yet.
+type HaveTxMessage struct {
+	Hash []byte
+}
+
+// ValidateBasic implements Message.
+func (m *HaveTxMessage) ValidateBasic() error {
+	return validateTxHash(m.Hash)
+}
+
+func (m *HaveTxMessage) txKey() (key [sha256.Size]byte) {
+	copy(key[:], m.Hash)
+	return key
+}
+
+// String returns a string representation of the HaveTxMessage.
+func (m *HaveTxMessage) String() string {
+	return fmt.Sprintf("[HaveTxMessage %X]", m.Hash)
+}
+
+//-------------------------------------
+
+// WantTxMessage requests a tx announced by a HaveTxMessage. The receiver
+// replies with a TxMessage if the tx is still in its mempool.
+type WantTxMessage struct {
+	Hash []byte
+}
+
+// ValidateBasic implements Message.
+func (m *WantTxMessage) ValidateBasic() error {
+	return validateTxHash(m.Hash)
+}
+
+func (m *WantTxMessage) txKey() (key [sha256.Size]byte) {
+	copy(key[:], m.Hash)
+	return key
+}
+
+// String returns a string representation of the WantTxMessage.
+func (m *WantTxMessage) String() string {
+	return fmt.Sprintf("[WantTxMessage %X]", m.Hash)
+}
+
+func validateTxHash(hash []byte) error {
+	if len(hash) != sha256.Size {
+		return fmt.Errorf("expected tx hash size to be %d bytes, got %d bytes", sha256.Size, len(hash))
+	}
+	return nil
+}
+
 // calcMaxMsgSize returns the max size of TxMessage
 // account for amino overhead of TxMessage
 func calcMaxMsgSize(maxTxSize int) int {
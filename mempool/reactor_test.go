@@ -43,6 +43,16 @@ func mempoolLogger() log.Logger {
 
 // connect N mempool reactors through N switches
 func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
+	mempoolConfigs := make([]*cfg.MempoolConfig, n)
+	for i := range mempoolConfigs {
+		mempoolConfigs[i] = config.Mempool
+	}
+	return makeAndConnectReactorsWithMempoolConfigs(config, mempoolConfigs)
+}
+
+// connect mempool reactors with the given mempool configs through switches
+func makeAndConnectReactorsWithMempoolConfigs(config *cfg.Config, mempoolConfigs []*cfg.MempoolConfig) []*Reactor {
+	n := len(mempoolConfigs)
 	reactors := make([]*Reactor, n)
 	logger := mempoolLogger()
 	for i := 0; i < n; i++ {
@@ -51,7 +61,7 @@ func makeAndConnectReactors(config *cfg.Config, n int) []*Reactor {
 		mempool, cleanup := newMempoolWithApp(cc)
 		defer cleanup()
 
-		reactors[i] = NewReactor(config.Mempool, mempool) // so we dont start the consensus states
+		reactors[i] = NewReactor(mempoolConfigs[i], mempool) // so we dont start the consensus states
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
 
@@ -133,6 +143,139 @@ func TestReactorBroadcastTxMessage(t *testing.T) {
 	waitForTxsOnReactors(t, txs, reactors)
 }
 
+func TestReactorBroadcastTxHashes(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.AnnounceTxHashes = true
+	const N = 4
+	reactors := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			r.Stop()
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+			assert.True(t, r.announcesTxs(peer))
+		}
+	}
+
+	// send a bunch of txs to the first reactor's mempool
+	// and wait for them all to be requested by the others
+	txs := checkTxs(t, reactors[0].mempool, NumTxs, UnknownPeerID)
+	waitForTxsOnReactors(t, txs, reactors)
+}
+
+func TestReactorBroadcastTxHashesFallsBackToPush(t *testing.T) {
+	config := cfg.TestConfig()
+	announcing := *config.Mempool
+	announcing.AnnounceTxHashes = true
+	reactors := makeAndConnectReactorsWithMempoolConfigs(config, []*cfg.MempoolConfig{&announcing, config.Mempool})
+	defer func() {
+		for _, r := range reactors {
+			r.Stop()
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+			assert.False(t, r.announcesTxs(peer))
+		}
+	}
+
+	// the peer doesn't support announcements, so it's sent full txs
+	txs := checkTxs(t, reactors[0].mempool, NumTxs, UnknownPeerID)
+	waitForTxsOnReactors(t, txs, reactors)
+}
+
+func TestReactorRequestsAnnouncedTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.AnnounceTxHashes = true
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	reactor := NewReactor(config.Mempool, mempool)
+	reactor.SetLogger(log.TestingLogger())
+
+	peer := mock.NewPeer(nil)
+	queue := make(chan envelope, 10)
+	reactor.queues[peer.ID()] = queue
+	reactor.sent[peer.ID()] = newMapTxCache(config.Mempool.Size)
+	receive := func(chID byte, msg Message) {
+		reactor.Receive(chID, peer, cdc.MustMarshalBinaryBare(msg))
+	}
+	expectSent := func(chID byte, msg Message) {
+		select {
+		case e := <-queue:
+			assert.Equal(t, chID, e.chID)
+			assert.Equal(t, cdc.MustMarshalBinaryBare(msg), e.msgBytes)
+		default:
+			t.Errorf("expected %v to be sent", msg)
+		}
+	}
+
+	// an unseen tx is requested
+	unseen := txKey(types.Tx("unseen"))
+	receive(MempoolAnnounceChannel, &HaveTxMessage{Hash: unseen[:]})
+	expectSent(MempoolAnnounceChannel, &WantTxMessage{Hash: unseen[:]})
+
+	// but only once
+	receive(MempoolAnnounceChannel, &HaveTxMessage{Hash: unseen[:]})
+	assert.Empty(t, queue)
+
+	// a tx already in the mempool isn't requested
+	txs := checkTxs(t, mempool, 1, UnknownPeerID)
+	seen := txKey(txs[0])
+	receive(MempoolAnnounceChannel, &HaveTxMessage{Hash: seen[:]})
+	assert.Empty(t, queue)
+
+	// and is sent when requested
+	receive(MempoolAnnounceChannel, &WantTxMessage{Hash: seen[:]})
+	expectSent(MempoolChannel, &TxMessage{Tx: txs[0]})
+
+	// but only once
+	receive(MempoolAnnounceChannel, &WantTxMessage{Hash: seen[:]})
+	assert.Empty(t, queue)
+}
+
+func TestTxRequestsExpire(t *testing.T) {
+	requests := newTxRequests()
+	txHash := txKey(types.Tx("tx"))
+
+	// the tx is requested from the first peer which announced it
+	assert.True(t, requests.Add(txHash, "a"))
+	start := time.Now()
+	assert.False(t, requests.Add(txHash, "a"))
+	assert.False(t, requests.Add(txHash, "b"))
+	assert.False(t, requests.Add(txHash, "c"))
+	assert.Empty(t, requests.Expire(start))
+
+	// then from the others, in turn, once it expires
+	now := start.Add(txRequestTimeout)
+	assert.Equal(t, []txRetry{{txHash: txHash, peer: "b"}}, requests.Expire(now))
+	assert.Empty(t, requests.Expire(now))
+	now = now.Add(txRequestTimeout)
+	assert.Equal(t, []txRetry{{txHash: txHash, peer: "c"}}, requests.Expire(now))
+
+	// and it's dropped once no peer is left
+	now = now.Add(txRequestTimeout)
+	assert.Empty(t, requests.Expire(now))
+	assert.Empty(t, requests.requests)
+	assert.True(t, requests.Add(txHash, "a"))
+
+	// or once received
+	requests.Remove(txHash)
+	assert.Empty(t, requests.requests)
+}
+
+func TestTxHashMessageValidateBasic(t *testing.T) {
+	txHash := txKey(types.Tx("tx"))
+	assert.NoError(t, (&HaveTxMessage{Hash: txHash[:]}).ValidateBasic())
+	assert.NoError(t, (&WantTxMessage{Hash: txHash[:]}).ValidateBasic())
+	assert.Error(t, (&HaveTxMessage{Hash: txHash[1:]}).ValidateBasic())
+	assert.Error(t, (&WantTxMessage{Hash: nil}).ValidateBasic())
+}
+
 func TestReactorNoBroadcastToSender(t *testing.T) {
 	config := cfg.TestConfig()
 	const N = 2
@@ -0,0 +1,374 @@
+package node
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// DBIDs are the IDs of the databases of a node, as given to the DBProvider.
+var DBIDs = []string{"blockstore", "state", "tx_index", "evidence"}
+
+// migrateBatchSize is the number of entries written at once to the
+// databases being migrated.
+const migrateBatchSize = 10000
+
+// migrateBackends are the backends the databases can be migrated from and to.
+// memdb isn't persisted, and tm-db doesn't support badgerdb yet.
+var migrateBackends = []dbm.BackendType{
+	dbm.GoLevelDBBackend,
+	dbm.CLevelDBBackend,
+	dbm.BoltDBBackend,
+	dbm.RocksDBBackend,
+}
+
+// migrateJournalFile is the file, in the DB dir, recording a migration while
+// the databases are swapped, so it can be finished if it's interrupted.
+const migrateJournalFile = "db_migrate.json"
+
+// dbMigration is the content of the migration journal.
+type dbMigration struct {
+	From dbm.BackendType `json:"from"`
+	To   dbm.BackendType `json:"to"`
+	IDs  []string        `json:"ids"`
+}
+
+// MigrateDBs copies the databases of the node with the given config from the
+// from backend to the to backend, in a staging directory in the DB dir. Each
+// copy is verified by comparing the number of entries and a checksum of the
+// entries with the original. Once all the databases are copied, they replace
+// the originals, which are moved to a backup directory in the DB dir. It
+// returns the IDs of the databases migrated, as those which don't exist are
+// skipped. db_backend is then set to the to backend in config.toml.
+//
+// The databases are swapped one at a time, so the migration is recorded in a
+// journal in the DB dir until they all are and config.toml was rewritten. If
+// it's interrupted, the node refuses to start (see CheckDBMigration), and
+// calling MigrateDBs again with the same backends finishes it.
+//
+// The node must be stopped.
+func MigrateDBs(config *cfg.Config, from, to dbm.BackendType, logger log.Logger) ([]string, error) {
+	if from == to {
+		return nil, fmt.Errorf("the databases already use %s", from)
+	}
+	for _, backend := range []dbm.BackendType{from, to} {
+		if err := checkMigrateBackend(backend); err != nil {
+			return nil, err
+		}
+	}
+
+	dbDir := config.DBDir()
+	configFile := filepath.Join(config.RootDir, "config", "config.toml")
+	stagingDir := filepath.Join(dbDir, fmt.Sprintf("migrate-%s", to))
+	backupDir := filepath.Join(dbDir, fmt.Sprintf("backup-%s", from))
+
+	migration, err := loadDBMigration(dbDir)
+	if err != nil {
+		return nil, err
+	}
+	if migration != nil {
+		if migration.From != from || migration.To != to {
+			return nil, interruptedMigrationError(migration)
+		}
+		logger.Info("Finishing the interrupted migration", "from", from, "to", to)
+		if err := swapDBs(migration, dbDir, stagingDir, backupDir, configFile, logger); err != nil {
+			return nil, err
+		}
+		logger.Info("Migrated the databases", "from", from, "to", to, "backup", backupDir)
+		return migration.IDs, nil
+	}
+
+	for _, dir := range []string{stagingDir, backupDir} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s exists, from a previous migration: remove it first", dir)
+		}
+	}
+
+	var ids []string
+	for _, id := range DBIDs {
+		if _, err := os.Stat(dbPath(dbDir, id)); os.IsNotExist(err) {
+			logger.Info("Skipping missing database", "db", id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no database found in %s", dbDir)
+	}
+
+	if err := os.MkdirAll(stagingDir, 0700); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		logger.Info("Copying database", "db", id, "from", from, "to", to)
+		n, err := migrateDB(id, from, dbDir, to, stagingDir)
+		if err != nil {
+			os.RemoveAll(stagingDir)
+			return nil, fmt.Errorf("failed to migrate %s: %v", id, err)
+		}
+		logger.Info("Copied and verified database", "db", id, "entries", n)
+	}
+
+	// Record the migration before swapping the databases, so it can be
+	// finished if it's interrupted.
+	if err := os.MkdirAll(backupDir, 0700); err != nil {
+		return nil, err
+	}
+	migration = &dbMigration{From: from, To: to, IDs: ids}
+	if err := saveDBMigration(dbDir, migration); err != nil {
+		return nil, err
+	}
+	if err := swapDBs(migration, dbDir, stagingDir, backupDir, configFile, logger); err != nil {
+		return nil, err
+	}
+	logger.Info("Migrated the databases", "from", from, "to", to, "backup", backupDir)
+	return ids, nil
+}
+
+// CheckDBMigration returns an error if a migration of the databases of the
+// node with the given config was interrupted, leaving databases of both
+// backends.
+func CheckDBMigration(config *cfg.Config) error {
+	migration, err := loadDBMigration(config.DBDir())
+	if err != nil {
+		return err
+	}
+	if migration != nil {
+		return interruptedMigrationError(migration)
+	}
+	return nil
+}
+
+func interruptedMigrationError(migration *dbMigration) error {
+	return fmt.Errorf("the migration of the databases from %s to %s was interrupted, "+
+		"finish it with \"tendermint db migrate --from %s --to %s\"",
+		migration.From, migration.To, migration.From, migration.To)
+}
+
+func checkMigrateBackend(backend dbm.BackendType) error {
+	for _, b := range migrateBackends {
+		if backend == b {
+			return nil
+		}
+	}
+	if backend == "badgerdb" {
+		return errors.New("badgerdb isn't supported by this version of tm-db")
+	}
+	return fmt.Errorf("can't migrate from or to %s, the backends supported are %v", backend, migrateBackends)
+}
+
+// loadDBMigration returns the migration recorded in the DB dir, or nil.
+func loadDBMigration(dbDir string) (*dbMigration, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dbDir, migrateJournalFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	migration := new(dbMigration)
+	if err := cdc.UnmarshalJSON(bz, migration); err != nil {
+		return nil, fmt.Errorf("error reading the db migration journal: %v", err)
+	}
+	return migration, nil
+}
+
+func saveDBMigration(dbDir string, migration *dbMigration) error {
+	bz, err := cdc.MarshalJSON(migration)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filepath.Join(dbDir, migrateJournalFile), bz, 0600)
+}
+
+// swapDBs moves the originals of the migrated databases to the backup
+// directory, and the copies in their place. The databases already swapped
+// are skipped, so it can be called again if it was interrupted. Once they all
+// are, db_backend is set in configFile, and the journal is removed.
+func swapDBs(migration *dbMigration, dbDir, stagingDir, backupDir, configFile string, logger log.Logger) error {
+	for _, id := range migration.IDs {
+		if err := swapDB(id, dbDir, stagingDir, backupDir); err != nil {
+			return fmt.Errorf("failed to swap in the migrated %s: %v", id, err)
+		}
+	}
+	updated, err := setDBBackend(configFile, migration.To)
+	if err != nil {
+		return fmt.Errorf("failed to set db_backend in %s: %v", configFile, err)
+	}
+	if !updated {
+		logger.Error("No db_backend to update in the config file, set it by hand",
+			"file", configFile, "db_backend", migration.To)
+	}
+	if err := os.Remove(filepath.Join(dbDir, migrateJournalFile)); err != nil {
+		return err
+	}
+	os.Remove(stagingDir)
+	return nil
+}
+
+var dbBackendLine = regexp.MustCompile(`(?m)^db_backend = ".*"$`)
+
+// setDBBackend sets db_backend to backend in configFile, which is replaced
+// atomically. It returns false if there's no db_backend line to update.
+func setDBBackend(configFile string, backend dbm.BackendType) (bool, error) {
+	bz, err := ioutil.ReadFile(configFile)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !dbBackendLine.Match(bz) {
+		return false, nil
+	}
+	bz = dbBackendLine.ReplaceAll(bz, []byte(fmt.Sprintf("db_backend = %q", backend)))
+	return true, tempfile.WriteFileAtomic(configFile, bz, 0644)
+}
+
+// dbPath returns the path of the database with the given ID, which is the
+// same for all the backends.
+func dbPath(dir, id string) string {
+	return filepath.Join(dir, id+".db")
+}
+
+func swapDB(id, dbDir, stagingDir, backupDir string) error {
+	if !pathExists(dbPath(stagingDir, id)) {
+		// Already swapped.
+		return nil
+	}
+	if !pathExists(dbPath(backupDir, id)) {
+		if err := os.Rename(dbPath(dbDir, id), dbPath(backupDir, id)); err != nil {
+			return err
+		}
+	} else if pathExists(dbPath(dbDir, id)) {
+		return fmt.Errorf("both %s and its backup exist", dbPath(dbDir, id))
+	}
+	return os.Rename(dbPath(stagingDir, id), dbPath(dbDir, id))
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return !os.IsNotExist(err)
+}
+
+// migrateDB copies the database with the given ID, and verifies the copy. It
+// returns the number of entries.
+func migrateDB(id string, from dbm.BackendType, fromDir string, to dbm.BackendType, toDir string) (int64, error) {
+	src, err := newDB(id, from, fromDir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := newDB(id, to, toDir)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+
+	n, sum, err := copyDB(src, dst)
+	if err != nil {
+		return 0, err
+	}
+	dstN, dstSum, err := checksumDB(dst)
+	if err != nil {
+		return 0, err
+	}
+	if dstN != n || !bytes.Equal(dstSum, sum) {
+		return 0, fmt.Errorf("the copy has %d entries with checksum %X, the original %d entries with checksum %X",
+			dstN, dstSum, n, sum)
+	}
+	return n, nil
+}
+
+// newDB is dbm.NewDB, returning an error instead of panicking for an unknown
+// backend or a failure to open the database.
+func newDB(id string, backend dbm.BackendType, dir string) (db dbm.DB, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return dbm.NewDB(id, backend, dir), nil
+}
+
+// copyDB copies all the entries of src to dst. It returns the number of
+// entries and their checksum.
+func copyDB(src, dst dbm.DB) (int64, []byte, error) {
+	itr, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer itr.Close()
+
+	var (
+		n     int64
+		h     = sha256.New()
+		batch = dst.NewBatch()
+	)
+	defer func() { batch.Close() }()
+	for ; itr.Valid(); itr.Next() {
+		// The iterator may reuse the key and value buffers.
+		key := append([]byte(nil), itr.Key()...)
+		value := append([]byte(nil), itr.Value()...)
+		batch.Set(key, value)
+		hashEntry(h, key, value)
+		n++
+		if n%migrateBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return 0, nil, err
+			}
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return 0, nil, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, nil, err
+	}
+	return n, h.Sum(nil), nil
+}
+
+// checksumDB returns the number of entries of db and their checksum.
+func checksumDB(db dbm.DB) (int64, []byte, error) {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer itr.Close()
+
+	var (
+		n int64
+		h = sha256.New()
+	)
+	for ; itr.Valid(); itr.Next() {
+		hashEntry(h, itr.Key(), itr.Value())
+		n++
+	}
+	if err := itr.Error(); err != nil {
+		return 0, nil, err
+	}
+	return n, h.Sum(nil), nil
+}
+
+// hashEntry writes the length-prefixed key and value to h.
+func hashEntry(h hash.Hash, key, value []byte) {
+	var buf [binary.MaxVarintLen64]byte
+	h.Write(buf[:binary.PutUvarint(buf[:], uint64(len(key)))])
+	h.Write(key)
+	h.Write(buf[:binary.PutUvarint(buf[:], uint64(len(value)))])
+	h.Write(value)
+}
@@ -0,0 +1,107 @@
+package node
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateDBs(t *testing.T) {
+	config := cfg.ResetTestRoot("node_db_migrate_test")
+	defer os.RemoveAll(config.RootDir)
+
+	_, err := MigrateDBs(config, dbm.GoLevelDBBackend, dbm.GoLevelDBBackend, log.TestingLogger())
+	assert.Error(t, err, "expected an error migrating to the same backend")
+	_, err = MigrateDBs(config, dbm.GoLevelDBBackend, dbm.MemDBBackend, log.TestingLogger())
+	assert.Error(t, err, "expected an error migrating to memdb")
+	_, err = MigrateDBs(config, dbm.GoLevelDBBackend, "badgerdb", log.TestingLogger())
+	assert.Error(t, err, "expected an error migrating to badgerdb")
+	_, err = MigrateDBs(config, dbm.GoLevelDBBackend, dbm.CLevelDBBackend, log.TestingLogger())
+	assert.Error(t, err, "expected an error without databases")
+}
+
+func TestMigrateDBsInterrupted(t *testing.T) {
+	config := cfg.ResetTestRoot("node_db_migrate_test")
+	defer os.RemoveAll(config.RootDir)
+	dbDir := config.DBDir()
+	stagingDir := filepath.Join(dbDir, "migrate-rocksdb")
+	backupDir := filepath.Join(dbDir, "backup-goleveldb")
+
+	// The blockstore and state were copied, and the migration was interrupted
+	// after the blockstore was swapped.
+	ids := []string{"blockstore", "state"}
+	for _, dir := range []string{dbDir, stagingDir, backupDir} {
+		require.NoError(t, os.MkdirAll(dir, 0700))
+	}
+	for _, id := range ids {
+		require.NoError(t, os.Mkdir(dbPath(dbDir, id), 0700))
+		require.NoError(t, os.Mkdir(dbPath(stagingDir, id), 0700))
+	}
+	migration := &dbMigration{From: dbm.GoLevelDBBackend, To: dbm.RocksDBBackend, IDs: ids}
+	require.NoError(t, saveDBMigration(dbDir, migration))
+	require.NoError(t, swapDB("blockstore", dbDir, stagingDir, backupDir))
+
+	// The node doesn't start, and the migration is only finished with the
+	// same backends.
+	assert.Error(t, CheckDBMigration(config))
+	_, err := MigrateDBs(config, dbm.GoLevelDBBackend, dbm.BoltDBBackend, log.TestingLogger())
+	assert.Error(t, err)
+
+	migrated, err := MigrateDBs(config, dbm.GoLevelDBBackend, dbm.RocksDBBackend, log.TestingLogger())
+	require.NoError(t, err)
+	assert.Equal(t, ids, migrated)
+	assert.NoError(t, CheckDBMigration(config))
+	bz, err := ioutil.ReadFile(filepath.Join(config.RootDir, "config", "config.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), `db_backend = "rocksdb"`)
+	for _, id := range ids {
+		assert.DirExists(t, dbPath(dbDir, id))
+		assert.DirExists(t, dbPath(backupDir, id))
+	}
+	_, err = os.Stat(stagingDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMigrateDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "db_migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stagingDir, err := ioutil.TempDir(dir, "staging")
+	require.NoError(t, err)
+	backupDir, err := ioutil.TempDir(dir, "backup")
+	require.NoError(t, err)
+
+	// Enough entries for several batches.
+	db := dbm.NewDB("state", dbm.GoLevelDBBackend, dir)
+	for i := 0; i < 2*migrateBatchSize+1; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key/%05d", i)), []byte(fmt.Sprintf("value/%d", i))))
+	}
+	wantN, wantSum, err := checksumDB(db)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	n, err := migrateDB("state", dbm.GoLevelDBBackend, dir, dbm.GoLevelDBBackend, stagingDir)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2*migrateBatchSize+1, n)
+
+	require.NoError(t, swapDB("state", dir, stagingDir, backupDir))
+	assert.DirExists(t, dbPath(backupDir, "state"))
+	_, err = os.Stat(dbPath(stagingDir, "state"))
+	assert.True(t, os.IsNotExist(err))
+
+	db = dbm.NewDB("state", dbm.GoLevelDBBackend, dir)
+	defer db.Close()
+	gotN, gotSum, err := checksumDB(db)
+	require.NoError(t, err)
+	assert.Equal(t, wantN, gotN)
+	assert.Equal(t, wantSum, gotSum)
+}
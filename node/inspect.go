@@ -0,0 +1,205 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/rs/cors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	amino "github.com/tendermint/go-amino"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	rpccore "github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// InspectRoutes are the names of the rpc/core routes served by the
+// Inspector, which only read the block store, the state and the tx index.
+var InspectRoutes = []string{
+	"health",
+	"genesis",
+	"blockchain",
+	"block",
+	"block_by_hash",
+	"block_results",
+	"commit",
+	"tx",
+	"tx_search",
+	"validators",
+	"consensus_params",
+}
+
+// ReadOnlyDBProvider returns a database like DefaultDBProvider, opened
+// read-only. Only goleveldb can be opened read-only with tm-db, so it returns
+// an error for the other backends.
+func ReadOnlyDBProvider(ctx *DBContext) (dbm.DB, error) {
+	dbType := dbm.BackendType(ctx.Config.DBBackend)
+	if dbType != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("%s databases can't be opened read-only, only %s ones can",
+			dbType, dbm.GoLevelDBBackend)
+	}
+	return dbm.NewGoLevelDBWithOpts(ctx.ID, ctx.Config.DBDir(), &opt.Options{ReadOnly: true})
+}
+
+// Inspector serves the InspectRoutes from the databases of a stopped or
+// crashed node, without starting the app, consensus or the p2p layer.
+type Inspector struct {
+	service.BaseService
+
+	config       *cfg.Config
+	genesisDoc   *types.GenesisDoc
+	blockStoreDB dbm.DB
+	stateDB      dbm.DB
+	txIndexer    txindex.TxIndexer
+	rpcListeners []net.Listener
+}
+
+// NewInspector opens the databases of the node with the given config with
+// dbProvider, usually ReadOnlyDBProvider.
+func NewInspector(config *cfg.Config, dbProvider DBProvider, logger log.Logger) (*Inspector, error) {
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	blockStoreDB, err := dbProvider(&DBContext{"blockstore", config})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the block store: %v", err)
+	}
+	stateDB, err := dbProvider(&DBContext{"state", config})
+	if err != nil {
+		blockStoreDB.Close()
+		return nil, fmt.Errorf("failed to open the state: %v", err)
+	}
+	txIndexer, err := createTxIndexer(config, dbProvider)
+	if err != nil {
+		blockStoreDB.Close()
+		stateDB.Close()
+		return nil, fmt.Errorf("failed to open the tx index: %v", err)
+	}
+
+	ins := &Inspector{
+		config:       config,
+		genesisDoc:   genDoc,
+		blockStoreDB: blockStoreDB,
+		stateDB:      stateDB,
+		txIndexer:    txIndexer,
+	}
+	ins.BaseService = *service.NewBaseService(logger, "Inspector", ins)
+	return ins, nil
+}
+
+// OnStart implements service.Service.
+func (ins *Inspector) OnStart() error {
+	rpccore.SetStateDB(ins.stateDB)
+	rpccore.SetBlockStore(store.NewBlockStore(ins.blockStoreDB))
+	rpccore.SetConsensusState(stateConsensus{ins.stateDB})
+	rpccore.SetGenesisDoc(ins.genesisDoc)
+	rpccore.SetTxIndexer(ins.txIndexer)
+	rpccore.SetLogger(ins.Logger.With("module", "rpc"))
+	rpccore.SetConfig(*ins.config.RPC)
+
+	routes := make(map[string]*rpcserver.RPCFunc, len(InspectRoutes))
+	for _, name := range InspectRoutes {
+		routes[name] = rpccore.Routes[name]
+	}
+	coreCodec := amino.NewCodec()
+	ctypes.RegisterAmino(coreCodec)
+
+	config := rpcserver.DefaultConfig()
+	config.MaxBodyBytes = ins.config.RPC.MaxBodyBytes
+	config.MaxHeaderBytes = ins.config.RPC.MaxHeaderBytes
+	config.MaxOpenConnections = ins.config.RPC.MaxOpenConnections
+
+	for _, listenAddr := range splitAndTrimEmpty(ins.config.RPC.ListenAddress, ",", " ") {
+		mux := http.NewServeMux()
+		rpcLogger := ins.Logger.With("module", "rpc-server")
+		rpcserver.RegisterRPCFuncs(mux, routes, coreCodec, rpcLogger)
+		listener, err := rpcserver.Listen(listenAddr, config)
+		if err != nil {
+			return err
+		}
+
+		var rootHandler http.Handler = mux
+		if ins.config.RPC.IsCorsEnabled() {
+			corsMiddleware := cors.New(cors.Options{
+				AllowedOrigins: ins.config.RPC.CORSAllowedOrigins,
+				AllowedMethods: ins.config.RPC.CORSAllowedMethods,
+				AllowedHeaders: ins.config.RPC.CORSAllowedHeaders,
+			})
+			rootHandler = corsMiddleware.Handler(mux)
+		}
+		if ins.config.RPC.IsTLSEnabled() {
+			go rpcserver.StartHTTPAndTLSServer(
+				listener,
+				rootHandler,
+				ins.config.RPC.CertFile(),
+				ins.config.RPC.KeyFile(),
+				rpcLogger,
+				config,
+			)
+		} else {
+			go rpcserver.StartHTTPServer(
+				listener,
+				rootHandler,
+				rpcLogger,
+				config,
+			)
+		}
+		ins.rpcListeners = append(ins.rpcListeners, listener)
+	}
+	return nil
+}
+
+// OnStop implements service.Service.
+func (ins *Inspector) OnStop() {
+	for _, l := range ins.rpcListeners {
+		ins.Logger.Info("Closing rpc listener", "listener", l)
+		if err := l.Close(); err != nil {
+			ins.Logger.Error("Error closing listener", "listener", l, "err", err)
+		}
+	}
+	ins.blockStoreDB.Close()
+	ins.stateDB.Close()
+}
+
+// stateConsensus implements rpccore.Consensus from the state saved in the db,
+// as consensus isn't running.
+type stateConsensus struct {
+	stateDB dbm.DB
+}
+
+var errConsensusNotRunning = errors.New("consensus is not running")
+
+func (c stateConsensus) GetState() sm.State {
+	return sm.LoadState(c.stateDB)
+}
+
+func (c stateConsensus) GetValidators() (int64, []*types.Validator) {
+	state := c.GetState()
+	if state.IsEmpty() {
+		return 0, nil
+	}
+	return state.LastBlockHeight, state.Validators.Copy().Validators
+}
+
+func (c stateConsensus) GetLastHeight() int64 {
+	return c.GetState().LastBlockHeight
+}
+
+func (c stateConsensus) GetRoundStateJSON() ([]byte, error) {
+	return nil, errConsensusNotRunning
+}
+
+func (c stateConsensus) GetRoundStateSimpleJSON() ([]byte, error) {
+	return nil, errConsensusNotRunning
+}
@@ -0,0 +1,73 @@
+package node
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestReadOnlyDBProvider(t *testing.T) {
+	config := cfg.ResetTestRoot("node_inspect_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.DBBackend = "memdb"
+	_, err := ReadOnlyDBProvider(&DBContext{"blockstore", config})
+	assert.Error(t, err, "expected an error for a backend which can't be opened read-only")
+}
+
+func TestInspector(t *testing.T) {
+	config := cfg.ResetTestRoot("node_inspect_test")
+	defer os.RemoveAll(config.RootDir)
+	config.DBBackend = "goleveldb"
+	config.TxIndex.Indexer = "null"
+	config.RPC.ListenAddress = "tcp://127.0.0.1:0"
+
+	// Save the state and a block, like a node stopped at height 1.
+	blockStoreDB, err := DefaultDBProvider(&DBContext{"blockstore", config})
+	require.NoError(t, err)
+	stateDB, err := DefaultDBProvider(&DBContext{"state", config})
+	require.NoError(t, err)
+	state, err := sm.LoadStateFromDBOrGenesisFile(stateDB, config.GenesisFile())
+	require.NoError(t, err)
+	sm.SaveState(stateDB, state)
+	block, partSet := state.MakeBlock(1, nil, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	store.NewBlockStore(blockStoreDB).SaveBlock(block, partSet, &types.Commit{Height: 1})
+	require.NoError(t, stateDB.Close())
+	require.NoError(t, blockStoreDB.Close())
+
+	ins, err := NewInspector(config, ReadOnlyDBProvider, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, ins.Start())
+	defer ins.Stop()
+
+	get := func(route string) (int, string) {
+		res, err := http.Get(fmt.Sprintf("http://%s/%s", ins.rpcListeners[0].Addr(), route))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	code, body := get("block?height=1")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, fmt.Sprintf("%X", block.Hash()))
+	code, body = get("validators")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, state.Validators.Validators[0].Address.String())
+
+	// Routes which need a running node aren't served.
+	code, _ = get("status")
+	assert.Equal(t, http.StatusNotFound, code)
+}
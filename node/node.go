@@ -17,6 +17,7 @@ import (
 	"github.com/rs/cors"
 
 	amino "github.com/tendermint/go-amino"
+	abcicli "github.com/tendermint/tendermint/abci/client"
 	abci "github.com/tendermint/tendermint/abci/types"
 	bcv0 "github.com/tendermint/tendermint/blockchain/v0"
 	bcv1 "github.com/tendermint/tendermint/blockchain/v1"
@@ -24,6 +25,7 @@ import (
 	"github.com/tendermint/tendermint/consensus"
 	cs "github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/keystore"
 	"github.com/tendermint/tendermint/evidence"
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
@@ -86,8 +88,14 @@ type Provider func(*cfg.Config, log.Logger) (*Node, error)
 // PrivValidator, ClientCreator, GenesisDoc, and DBProvider.
 // It implements NodeProvider.
 func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
+	// The passphrase is only read if the keys are encrypted.
+	passphrase, err := keystore.PassphraseFromSource(config.KeyPassphrase, "Enter the passphrase of the keys: ")
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate node PrivKey
-	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	nodeKey, err := p2p.LoadOrGenNodeKeyWithPassphrase(config.NodeKeyFile(), passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -110,9 +118,9 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	}
 
 	return NewNode(config,
-		privval.LoadOrGenFilePV(newPrivValKey, newPrivValState),
+		privval.LoadOrGenFilePVWithPassphrase(newPrivValKey, newPrivValState, passphrase),
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir(), config.ABCIConcurrency),
 		DefaultGenesisDocProviderFunc(config),
 		DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
@@ -120,20 +128,21 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state and proxy Metrics.
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics()
 	}
 }
 
@@ -191,11 +200,14 @@ type Node struct {
 	bcReactor        p2p.Reactor       // for fast-syncing
 	mempoolReactor   *mempl.Reactor    // for gossipping transactions
 	mempool          mempl.Mempool
+	blockExec        *sm.BlockExecutor
+	uptimeTracker    *sm.UptimeTracker // nil if disabled
 	consensusState   *cs.State      // latest consensus state
 	consensusReactor *cs.Reactor    // for participating in the consensus
 	pexReactor       *pex.Reactor   // for exchanging peer addresses
 	evidencePool     *evidence.Pool // tracking evidence
 	proxyApp         proxy.AppConns // connection to the application
+	abciRecorder     *abcicli.Recorder
 	rpcListeners     []net.Listener // rpc servers
 	txIndexer        txindex.TxIndexer
 	indexerService   *txindex.IndexerService
@@ -218,8 +230,9 @@ func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.Block
 	return
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator)
+func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, metrics *proxy.Metrics,
+	logger log.Logger) (proxy.AppConns, error) {
+	proxyApp := proxy.NewAppConns(clientCreator, proxy.WithMetrics(metrics))
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -236,26 +249,32 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
-func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
-	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
-
-	var txIndexer txindex.TxIndexer
+func createTxIndexer(config *cfg.Config, dbProvider DBProvider) (txindex.TxIndexer, error) {
 	switch config.TxIndex.Indexer {
 	case "kv":
 		store, err := dbProvider(&DBContext{"tx_index", config})
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		switch {
 		case config.TxIndex.IndexKeys != "":
-			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")))
+			return kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " "))), nil
 		case config.TxIndex.IndexAllKeys:
-			txIndexer = kv.NewTxIndex(store, kv.IndexAllEvents())
+			return kv.NewTxIndex(store, kv.IndexAllEvents()), nil
 		default:
-			txIndexer = kv.NewTxIndex(store)
+			return kv.NewTxIndex(store), nil
 		}
 	default:
-		txIndexer = &null.TxIndex{}
+		return &null.TxIndex{}, nil
+	}
+}
+
+func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
+	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
+
+	txIndexer, err := createTxIndexer(config, dbProvider)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	indexerService := txindex.NewIndexerService(txIndexer, eventBus)
@@ -273,18 +292,54 @@ func doHandshake(
 	genDoc *types.GenesisDoc,
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
+	pipelinedExecution bool,
 	consensusLogger log.Logger) error {
 
 	handshaker := cs.NewHandshaker(stateDB, state, blockStore, genDoc)
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
+	handshaker.SetPipelinedExecution(pipelinedExecution)
 	if err := handshaker.Handshake(proxyApp); err != nil {
 		return fmt.Errorf("error during handshake: %v", err)
 	}
 	return nil
 }
 
-func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
+// appReconnectHandler brings the app back in sync with the node when the
+// connections to it are re-established (ie. after it crashed).
+type appReconnectHandler struct {
+	stateDB            dbm.DB
+	blockStore         sm.BlockStore
+	genDoc             *types.GenesisDoc
+	eventBus           types.BlockEventPublisher
+	mempool            *mempl.CListMempool
+	pipelinedExecution bool
+	logger             log.Logger
+}
+
+var _ proxy.ReconnectHandler = (*appReconnectHandler)(nil)
+
+// Handshake replays the blocks the app is missing, including the one being
+// applied when the connection broke, if any.
+func (h *appReconnectHandler) Handshake(proxyApp proxy.AppConns) error {
+	state := sm.LoadState(h.stateDB)
+	return doHandshake(h.stateDB, state, h.blockStore, h.genDoc, h.eventBus, proxyApp,
+		h.pipelinedExecution, h.logger)
+}
+
+// Resumed rechecks the mempool txs, as the app lost them.
+func (h *appReconnectHandler) Resumed() {
+	h.mempool.Lock()
+	defer h.mempool.Unlock()
+	if err := h.mempool.FlushAppConn(); err != nil {
+		h.logger.Error("Failed to flush mempool connection", "err", err)
+		return
+	}
+	h.mempool.Recheck()
+}
+
+func logNodeStartupInfo(state sm.State, addr types.Address, pubKey crypto.PubKey,
+	logger, consensusLogger log.Logger) {
 	// Log the version info.
 	logger.Info("Version info",
 		"software", version.TMCoreSemVer,
@@ -300,7 +355,6 @@ func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusL
 		)
 	}
 
-	addr := pubKey.Address()
 	// Log whether this node is a validator or an observer
 	if state.Validators.HasAddress(addr) {
 		consensusLogger.Info("This node is a validator", "addr", addr, "pubKey", pubKey)
@@ -314,7 +368,7 @@ func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
 		return false
 	}
 	addr, _ := state.Validators.GetByIndex(0)
-	return bytes.Equal(privVal.GetPubKey().Address(), addr)
+	return bytes.Equal(types.PrivValidatorAddress(privVal), addr)
 }
 
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
@@ -381,6 +435,7 @@ func createConsensusReactor(config *cfg.Config,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
+	tracer *cs.Tracer,
 	fastSync bool,
 	eventBus *types.EventBus,
 	consensusLogger log.Logger) (*consensus.Reactor, *consensus.State) {
@@ -393,6 +448,7 @@ func createConsensusReactor(config *cfg.Config,
 		mempool,
 		evidencePool,
 		cs.StateMetrics(csMetrics),
+		cs.StateTracer(tracer),
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
@@ -560,6 +616,10 @@ func NewNode(config *cfg.Config,
 	logger log.Logger,
 	options ...Option) (*Node, error) {
 
+	if err := CheckDBMigration(config); err != nil {
+		return nil, err
+	}
+
 	blockStore, stateDB, err := initDBs(config, dbProvider)
 	if err != nil {
 		return nil, err
@@ -570,8 +630,19 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, proxyMetrics := metricsProvider(genDoc.ChainID)
+
+	var abciRecorder *abcicli.Recorder
+	if config.ABCIRecordFile() != "" {
+		abciRecorder, err = abcicli.OpenRecorder(config.ABCIRecordFile())
+		if err != nil {
+			return nil, fmt.Errorf("error opening ABCI record file: %v", err)
+		}
+		clientCreator = proxy.NewRecordingClientCreator(clientCreator, abciRecorder)
+	}
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger)
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, proxyMetrics, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -591,10 +662,16 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	// The blocks in the store must have been applied the same way.
+	if err := sm.CheckPipelinedExecution(stateDB, config.Consensus.PipelinedExecution); err != nil {
+		return nil, fmt.Errorf("consensus.pipelined_execution doesn't match: %v", err)
+	}
+
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync tendermint with the app.
 	consensusLogger := logger.With("module", "consensus")
-	if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp, consensusLogger); err != nil {
+	if err := doHandshake(stateDB, state, blockStore, genDoc, eventBus, proxyApp,
+		config.Consensus.PipelinedExecution, consensusLogger); err != nil {
 		return nil, err
 	}
 
@@ -619,32 +696,84 @@ func NewNode(config *cfg.Config,
 		return nil, errors.New("could not retrieve public key from private validator")
 	}
 
-	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
+	logNodeStartupInfo(state, types.PrivValidatorAddress(privValidator), pubKey, logger, consensusLogger)
+
+	// Refuse to start if the validator signed one of the last blocks after it
+	// last signed here: it's likely running on another node.
+	if err := cs.CheckDoubleSignRisk(stateDB, blockStore, privValidator,
+		config.Consensus.DoubleSignCheckHeight, consensusLogger); err != nil {
+		return nil, err
+	}
 
 	// Decide whether to fast-sync or not
 	// We don't fast-sync when the only validator is us.
 	fastSync := config.FastSyncMode && !onlyValidatorIsUs(state, privValidator)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
-
 	// Make MempoolReactor
 	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
 
+	// If the connection to the app breaks, re-run the handshake once it's
+	// re-established.
+	proxyApp.SetReconnectHandler(&appReconnectHandler{
+		stateDB:            stateDB,
+		blockStore:         blockStore,
+		genDoc:             genDoc,
+		eventBus:           eventBus,
+		mempool:            mempool,
+		pipelinedExecution: config.Consensus.PipelinedExecution,
+		logger:             consensusLogger,
+	})
+
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, logger)
 	if err != nil {
 		return nil, err
 	}
 
+	// Submit the key rotation of the validator, if any, to be committed.
+	if rpv, ok := privValidator.(types.RotatingPrivValidator); ok {
+		if rot := rpv.PendingKeyRotation(); rot != nil && !evidencePool.IsCommitted(rot) {
+			if err := evidencePool.AddEvidence(rot); err != nil {
+				consensusLogger.Error("Failed to submit the validator key rotation", "rotation", rot, "err", err)
+			} else {
+				consensusLogger.Info("Submitted the validator key rotation", "rotation", rot)
+			}
+		}
+	}
+
 	// make block executor for consensus and blockchain reactors to execute blocks
+	blockExecOptions := []sm.BlockExecutorOption{sm.BlockExecutorWithMetrics(smMetrics)}
+	var uptimeTracker *sm.UptimeTracker
+	if config.Instrumentation.UptimeWindow > 0 {
+		uptimeTracker = sm.NewUptimeTracker(config.Instrumentation.UptimeWindow)
+		uptimeTracker.SetMetrics(smMetrics, types.PrivValidatorAddress(privValidator))
+		if err := uptimeTracker.Load(stateDB, blockStore); err != nil {
+			return nil, errors.Wrap(err, "could not load the validator uptime")
+		}
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithUptimeTracker(uptimeTracker))
+	}
+	if config.Consensus.PipelinedExecution {
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithPipelinedExecution())
+	}
+	if config.Consensus.HaltHeight > 0 || config.Consensus.HaltTime > 0 {
+		var haltTime time.Time
+		if config.Consensus.HaltTime > 0 {
+			haltTime = time.Unix(config.Consensus.HaltTime, 0)
+		}
+		blockExecOptions = append(blockExecOptions, sm.BlockExecutorWithHalt(config.Consensus.HaltHeight, haltTime))
+	}
 	blockExec := sm.NewBlockExecutor(
 		stateDB,
 		logger.With("module", "state"),
 		proxyApp.Consensus(),
 		mempool,
 		evidencePool,
-		sm.BlockExecutorWithMetrics(smMetrics),
+		blockExecOptions...,
 	)
+	if blockExec.IsHalted(state) {
+		return nil, fmt.Errorf("the node halted at height %d (see consensus.halt_height and consensus.halt_time)",
+			state.LastBlockHeight)
+	}
 
 	// Make BlockchainReactor
 	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync, logger)
@@ -653,9 +782,17 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Make ConsensusReactor
+	var tracer *cs.Tracer
+	if traceFile := config.Consensus.TraceFile(); traceFile != "" {
+		tracer, err = cs.NewTracer(traceFile, nodeKey.ID())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open the consensus trace file")
+		}
+		tracer.SetLogger(consensusLogger.With("trace", traceFile))
+	}
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, fastSync, eventBus, consensusLogger,
+		privValidator, csMetrics, tracer, fastSync, eventBus, consensusLogger,
 	)
 
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
@@ -727,11 +864,14 @@ func NewNode(config *cfg.Config,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		blockExec:        blockExec,
+		uptimeTracker:    uptimeTracker,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		pexReactor:       pexReactor,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
+		abciRecorder:     abciRecorder,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
 		eventBus:         eventBus,
@@ -808,6 +948,12 @@ func (n *Node) OnStop() {
 
 	n.Logger.Info("Stopping Node")
 
+	// Requests to the app are paused while it's down, so release them before
+	// stopping the reactors, which may be waiting on them.
+	if n.proxyApp.Query().Error() != nil {
+		n.proxyApp.Stop()
+	}
+
 	// first stop the non-reactor services
 	n.eventBus.Stop()
 	n.indexerService.Stop()
@@ -838,6 +984,17 @@ func (n *Node) OnStop() {
 		pvsc.Stop()
 	}
 
+	if n.proxyApp.IsRunning() {
+		if err := n.proxyApp.Stop(); err != nil {
+			n.Logger.Error("Error stopping proxy app connections", "err", err)
+		}
+	}
+	if n.abciRecorder != nil {
+		if err := n.abciRecorder.Close(); err != nil {
+			n.Logger.Error("Error recording ABCI requests", "err", err)
+		}
+	}
+
 	if n.prometheusSrv != nil {
 		if err := n.prometheusSrv.Shutdown(context.Background()); err != nil {
 			// Error from closing listeners, or context timeout:
@@ -858,9 +1015,11 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetP2PTransport(n)
 	pubKey := n.privValidator.GetPubKey()
 	rpccore.SetPubKey(pubKey)
+	rpccore.SetPrivValidatorAddress(types.PrivValidatorAddress(n.privValidator))
 	rpccore.SetGenesisDoc(n.genesisDoc)
 	rpccore.SetProxyAppQuery(n.proxyApp.Query())
 	rpccore.SetTxIndexer(n.txIndexer)
+	rpccore.SetUptimeTracker(n.uptimeTracker)
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
@@ -1005,6 +1164,20 @@ func (n *Node) ConsensusState() *cs.State {
 	return n.consensusState
 }
 
+// Halted returns a channel which is closed once the node committed the block
+// at consensus.halt_height or consensus.halt_time, and can be stopped.
+func (n *Node) Halted() <-chan struct{} {
+	return n.blockExec.Halted()
+}
+
+// DoubleSignRisk returns a channel which is closed once the node found a
+// precommit of its validator it didn't sign, and must be stopped (see
+// consensus.double_sign_check_height). The error is returned by
+// ConsensusState().DoubleSignRiskErr.
+func (n *Node) DoubleSignRisk() <-chan struct{} {
+	return n.consensusState.DoubleSignRisk()
+}
+
 // ConsensusReactor returns the Node's ConsensusReactor.
 func (n *Node) ConsensusReactor() *cs.Reactor {
 	return n.consensusReactor
@@ -1117,6 +1290,10 @@ func makeNodeInfo(
 		},
 	}
 
+	if config.Mempool.AnnounceTxHashes {
+		nodeInfo.Channels = append(nodeInfo.Channels, mempl.MempoolAnnounceChannel)
+	}
+
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
+	"github.com/tendermint/tendermint/abci/server"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/evidence"
@@ -72,6 +73,62 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeReconnectsToApp(t *testing.T) {
+	config := cfg.ResetTestRoot("node_reconnects_to_app_test")
+	defer os.RemoveAll(config.RootDir)
+
+	sockPath := fmt.Sprintf("unix:///tmp/node_app_%v.sock", tmrand.Str(6))
+	startApp := func() *server.SocketServer {
+		s := server.NewSocketServer(sockPath, kvstore.NewApplication()).(*server.SocketServer)
+		s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+		require.NoError(t, s.Start())
+		return s
+	}
+	app := startApp()
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	n, err := NewNode(config,
+		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewRemoteClientCreator(sockPath, "socket", true),
+		DefaultGenesisDocProviderFunc(config),
+		DefaultDBProvider,
+		DefaultMetricsProvider(config.Instrumentation),
+		log.TestingLogger(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer n.Stop()
+
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	waitForHeight := func(height int64) {
+		for {
+			select {
+			case msg := <-blocksSub.Out():
+				if msg.Data().(types.EventDataNewBlock).Block.Height >= height {
+					return
+				}
+			case <-blocksSub.Cancelled():
+				t.Fatal("blocksSub was cancelled")
+			case <-time.After(10 * time.Second):
+				t.Fatalf("timed out waiting for height %d", height)
+			}
+		}
+	}
+	waitForHeight(2)
+
+	// The app crashes and comes back without any state: the node replays the
+	// chain on it and carries on.
+	app.Stop()
+	time.Sleep(200 * time.Millisecond)
+	app = startApp()
+	defer app.Stop()
+
+	waitForHeight(n.BlockStore().Height() + 2)
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -280,11 +337,13 @@ func TestCreateProposalBlock(t *testing.T) {
 	)
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
-	block, _ := blockExec.CreateProposalBlock(
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
 		state, commit,
 		proposerAddr,
+		nil,
 	)
+	require.NoError(t, err)
 
 	err = blockExec.ValidateBlock(state, block)
 	assert.NoError(t, err)
@@ -303,7 +362,7 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	n, err := NewNode(config,
 		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir(), config.ABCIConcurrency),
 		DefaultGenesisDocProviderFunc(config),
 		DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
@@ -102,7 +102,10 @@ func (fc *FuzzedConnection) SetWriteDeadline(t time.Time) error {
 }
 
 func (fc *FuzzedConnection) randomDuration() time.Duration {
-	maxDelayMillis := int(fc.config.MaxDelay.Nanoseconds() / 1000)
+	maxDelayMillis := int(fc.config.MaxDelay / time.Millisecond)
+	if maxDelayMillis == 0 {
+		return 0
+	}
 	return time.Millisecond * time.Duration(tmrand.Int()%maxDelayMillis) // nolint: gas
 }
 
@@ -0,0 +1,28 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tendermint/tendermint/config"
+)
+
+func TestFuzzedConnectionRandomDuration(t *testing.T) {
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	cfg := config.DefaultFuzzConnConfig()
+	cfg.MaxDelay = 10 * time.Millisecond
+	fc := FuzzConnFromConfig(c1, cfg).(*FuzzedConnection)
+	for i := 0; i < 100; i++ {
+		assert.True(t, fc.randomDuration() < cfg.MaxDelay)
+	}
+
+	// no delay, rather than a division by zero
+	cfg.MaxDelay = 0
+	assert.Zero(t, fc.randomDuration())
+}
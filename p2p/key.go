@@ -8,7 +8,9 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/keystore"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/libs/tempfile"
 )
 
 // ID is a hex-encoded crypto.Address
@@ -20,7 +22,6 @@ const IDByteLength = crypto.AddressSize
 
 //------------------------------------------------------------------------------
 // Persistent peer ID
-// TODO: encrypt on disk
 
 // NodeKey is the persistent peer key.
 // It contains the nodes private key for authentication.
@@ -47,8 +48,15 @@ func PubKeyToID(pubKey crypto.PubKey) ID {
 // LoadOrGenNodeKey attempts to load the NodeKey from the given filePath.
 // If the file does not exist, it generates and saves a new NodeKey.
 func LoadOrGenNodeKey(filePath string) (*NodeKey, error) {
+	return LoadOrGenNodeKeyWithPassphrase(filePath, nil)
+}
+
+// LoadOrGenNodeKeyWithPassphrase is like LoadOrGenNodeKey, but an existing
+// key file may be encrypted, in which case it is decrypted with the
+// passphrase. A new key is saved unencrypted.
+func LoadOrGenNodeKeyWithPassphrase(filePath string, passphrase keystore.PassphraseFunc) (*NodeKey, error) {
 	if tmos.FileExists(filePath) {
-		nodeKey, err := LoadNodeKey(filePath)
+		nodeKey, err := LoadNodeKeyWithPassphrase(filePath, passphrase)
 		if err != nil {
 			return nil, err
 		}
@@ -58,29 +66,64 @@ func LoadOrGenNodeKey(filePath string) (*NodeKey, error) {
 }
 
 func LoadNodeKey(filePath string) (*NodeKey, error) {
-	jsonBytes, err := ioutil.ReadFile(filePath)
+	return LoadNodeKeyWithPassphrase(filePath, nil)
+}
+
+// LoadNodeKeyWithPassphrase loads the NodeKey from the given filePath. An
+// encrypted key file is decrypted with the passphrase, if not nil.
+func LoadNodeKeyWithPassphrase(filePath string, passphrase keystore.PassphraseFunc) (*NodeKey, error) {
+	keyBytes, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
+
 	nodeKey := new(NodeKey)
-	err = cdc.UnmarshalJSON(jsonBytes, nodeKey)
+	if keystore.IsArmored(keyBytes) {
+		if passphrase == nil {
+			return nil, fmt.Errorf("error reading NodeKey from %v: the key is encrypted, but no passphrase was given",
+				filePath)
+		}
+		p, err := passphrase()
+		if err != nil {
+			return nil, err
+		}
+		nodeKey.PrivKey, err = keystore.UnarmorDecryptPrivKey(string(keyBytes), p)
+		if err != nil {
+			return nil, fmt.Errorf("error reading NodeKey from %v: %v", filePath, err)
+		}
+		return nodeKey, nil
+	}
+
+	err = cdc.UnmarshalJSON(keyBytes, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("error reading NodeKey from %v: %v", filePath, err)
 	}
 	return nodeKey, nil
 }
 
+// SaveAs persists the NodeKey to filePath, in plaintext.
+func (nodeKey *NodeKey) SaveAs(filePath string) error {
+	jsonBytes, err := cdc.MarshalJSON(nodeKey)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filePath, jsonBytes, 0600)
+}
+
+// SaveEncryptedAs persists the NodeKey to filePath, encrypted with the
+// passphrase. See crypto/keystore.
+func (nodeKey *NodeKey) SaveEncryptedAs(filePath, passphrase string) error {
+	armored := keystore.EncryptArmorPrivKey(nodeKey.PrivKey, passphrase)
+	return tempfile.WriteFileAtomic(filePath, []byte(armored), 0600)
+}
+
 func genNodeKey(filePath string) (*NodeKey, error) {
 	privKey := ed25519.GenPrivKey()
 	nodeKey := &NodeKey{
 		PrivKey: privKey,
 	}
 
-	jsonBytes, err := cdc.MarshalJSON(nodeKey)
-	if err != nil {
-		return nil, err
-	}
-	err = ioutil.WriteFile(filePath, jsonBytes, 0600)
+	err := nodeKey.SaveAs(filePath)
 	if err != nil {
 		return nil, err
 	}
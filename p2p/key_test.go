@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
@@ -22,6 +24,24 @@ func TestLoadOrGenNodeKey(t *testing.T) {
 	assert.Equal(t, nodeKey, nodeKey2)
 }
 
+func TestLoadEncryptedNodeKey(t *testing.T) {
+	filePath := filepath.Join(os.TempDir(), tmrand.Str(12)+"_peer_id.json")
+	defer os.Remove(filePath)
+
+	nodeKey, err := LoadOrGenNodeKey(filePath)
+	require.NoError(t, err)
+	require.NoError(t, nodeKey.SaveEncryptedAs(filePath, "passphrase"))
+
+	_, err = LoadNodeKey(filePath)
+	assert.Error(t, err)
+	_, err = LoadNodeKeyWithPassphrase(filePath, func() (string, error) { return "wrong passphrase", nil })
+	assert.Error(t, err)
+
+	nodeKey2, err := LoadOrGenNodeKeyWithPassphrase(filePath, func() (string, error) { return "passphrase", nil })
+	require.NoError(t, err)
+	assert.Equal(t, nodeKey, nodeKey2)
+}
+
 //----------------------------------------------------------
 
 func padBytes(bz []byte, targetBytes int) []byte {
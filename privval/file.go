@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/keystore"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/libs/tempfile"
@@ -16,6 +18,10 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
+// blockTypePVKey is the armor block type of an encrypted FilePVKey with a
+// key rotation, which can't be encrypted as a bare private key.
+const blockTypePVKey = "TENDERMINT PRIVATE VALIDATOR KEY"
+
 // TODO: type ?
 const (
 	stepNone      int8 = 0 // Used to distinguish the initial state
@@ -44,10 +50,20 @@ type FilePVKey struct {
 	PubKey  crypto.PubKey  `json:"pub_key"`
 	PrivKey crypto.PrivKey `json:"priv_key"`
 
-	filePath string
+	// The key being rotated to, and the rotation to commit in a block. See
+	// types.KeyRotation.
+	NextPrivKey crypto.PrivKey     `json:"next_priv_key,omitempty"`
+	KeyRotation *types.KeyRotation `json:"key_rotation,omitempty"`
+	// The height PrivKey signs from, once a key was rotated. Address remains
+	// the address of the first key.
+	HandoverHeight int64 `json:"handover_height,omitempty"`
+
+	filePath   string
+	passphrase string
 }
 
-// Save persists the FilePVKey to its filePath.
+// Save persists the FilePVKey to its filePath, encrypted if it has a
+// passphrase.
 func (pvKey FilePVKey) Save() {
 	outFile := pvKey.filePath
 	if outFile == "" {
@@ -58,13 +74,38 @@ func (pvKey FilePVKey) Save() {
 	if err != nil {
 		panic(err)
 	}
-	err = tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
+	bz := jsonBytes
+	if pvKey.passphrase != "" {
+		if pvKey.Rotated() {
+			bz = []byte(keystore.EncryptArmor(blockTypePVKey, jsonBytes, pvKey.passphrase))
+		} else {
+			bz = []byte(keystore.EncryptArmorPrivKey(pvKey.PrivKey, pvKey.passphrase))
+		}
+	}
+	err = tempfile.WriteFileAtomic(outFile, bz, 0600)
 	if err != nil {
 		panic(err)
 	}
 
 }
 
+// Rotated returns true if the key was or is being rotated, in which case it
+// can't be used as a bare private key.
+func (pvKey FilePVKey) Rotated() bool {
+	return pvKey.HandoverHeight > 0 || pvKey.NextPrivKey != nil
+}
+
+// SetPassphrase sets the passphrase the key is encrypted with by Save. An
+// empty passphrase saves it in plaintext. See crypto/keystore.
+func (pvKey *FilePVKey) SetPassphrase(passphrase string) {
+	pvKey.passphrase = passphrase
+}
+
+// Encrypted returns true if the key is saved encrypted.
+func (pvKey FilePVKey) Encrypted() bool {
+	return pvKey.passphrase != ""
+}
+
 //-------------------------------------------------------------------------------
 
 // FilePVLastSignState stores the mutable part of PrivValidator.
@@ -147,11 +188,9 @@ type FilePV struct {
 	LastSignState FilePVLastSignState
 }
 
-// GenFilePV generates a new validator with randomly generated private key
-// and sets the filePaths, but does not call Save().
-func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
-	privKey := ed25519.GenPrivKey()
-
+// NewFilePV returns a validator with the given private key, and sets the
+// filePaths, but does not call Save().
+func NewFilePV(privKey crypto.PrivKey, keyFilePath, stateFilePath string) *FilePV {
 	return &FilePV{
 		Key: FilePVKey{
 			Address:  privKey.PubKey().Address(),
@@ -166,36 +205,39 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 	}
 }
 
+// GenFilePV generates a new validator with randomly generated private key
+// and sets the filePaths, but does not call Save().
+func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
+	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
+}
+
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
 func LoadFilePV(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, true)
+	return loadFilePV(keyFilePath, stateFilePath, true, nil)
+}
+
+// LoadFilePVWithPassphrase is like LoadFilePV, but the key file may be
+// encrypted, in which case it is decrypted with the passphrase.
+func LoadFilePVWithPassphrase(keyFilePath, stateFilePath string, passphrase keystore.PassphraseFunc) *FilePV {
+	return loadFilePV(keyFilePath, stateFilePath, true, passphrase)
 }
 
 // LoadFilePVEmptyState loads a FilePV from the given keyFilePath, with an empty LastSignState.
 // If the keyFilePath does not exist, the program will exit.
 func LoadFilePVEmptyState(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, false)
+	return loadFilePV(keyFilePath, stateFilePath, false, nil)
 }
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
-	keyJSONBytes, err := ioutil.ReadFile(keyFilePath)
-	if err != nil {
-		tmos.Exit(err.Error())
-	}
-	pvKey := FilePVKey{}
-	err = cdc.UnmarshalJSON(keyJSONBytes, &pvKey)
+// An encrypted key file is decrypted with the passphrase, if not nil.
+func loadFilePV(keyFilePath, stateFilePath string, loadState bool, passphrase keystore.PassphraseFunc) *FilePV {
+	pvKey, err := LoadFilePVKey(keyFilePath, passphrase)
 	if err != nil {
 		tmos.Exit(fmt.Sprintf("Error reading PrivValidator key from %v: %v\n", keyFilePath, err))
 	}
 
-	// overwrite pubkey and address for convenience
-	pvKey.PubKey = pvKey.PrivKey.PubKey()
-	pvKey.Address = pvKey.PubKey.Address()
-	pvKey.filePath = keyFilePath
-
 	pvState := FilePVLastSignState{}
 	if loadState {
 		stateJSONBytes, err := ioutil.ReadFile(stateFilePath)
@@ -216,12 +258,69 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 	}
 }
 
+// LoadFilePVKey loads only the key of a FilePV from the keyFilePath. An
+// encrypted key file is decrypted with the passphrase, if not nil.
+func LoadFilePVKey(keyFilePath string, passphrase keystore.PassphraseFunc) (FilePVKey, error) {
+	pvKey := FilePVKey{}
+	keyBytes, err := ioutil.ReadFile(keyFilePath)
+	if err != nil {
+		return pvKey, err
+	}
+
+	if keystore.IsArmored(keyBytes) {
+		if passphrase == nil {
+			return pvKey, errors.New("the key is encrypted, but no passphrase was given")
+		}
+		p, err := passphrase()
+		if err != nil {
+			return pvKey, err
+		}
+		blockType, plaintext, err := keystore.UnarmorDecrypt(string(keyBytes), p)
+		if err != nil {
+			return pvKey, err
+		}
+		switch blockType {
+		case keystore.BlockTypePrivKey:
+			pvKey.PrivKey, err = keystore.UnarmorDecryptPrivKey(string(keyBytes), p)
+		case blockTypePVKey:
+			err = cdc.UnmarshalJSON(plaintext, &pvKey)
+		default:
+			err = fmt.Errorf("unrecognized armor type %q", blockType)
+		}
+		if err != nil {
+			return pvKey, err
+		}
+		pvKey.passphrase = p
+	} else {
+		err = cdc.UnmarshalJSON(keyBytes, &pvKey)
+		if err != nil {
+			return pvKey, err
+		}
+	}
+
+	// overwrite pubkey and address for convenience, unless the key was
+	// rotated and the address is the one of the first key
+	pvKey.PubKey = pvKey.PrivKey.PubKey()
+	if pvKey.HandoverHeight == 0 {
+		pvKey.Address = pvKey.PubKey.Address()
+	}
+	pvKey.filePath = keyFilePath
+	return pvKey, nil
+}
+
 // LoadOrGenFilePV loads a FilePV from the given filePaths
 // or else generates a new one and saves it to the filePaths.
 func LoadOrGenFilePV(keyFilePath, stateFilePath string) *FilePV {
+	return LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath, nil)
+}
+
+// LoadOrGenFilePVWithPassphrase is like LoadOrGenFilePV, but an existing key
+// file may be encrypted, in which case it is decrypted with the passphrase.
+// A new key is saved unencrypted.
+func LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath string, passphrase keystore.PassphraseFunc) *FilePV {
 	var pv *FilePV
 	if tmos.FileExists(keyFilePath) {
-		pv = LoadFilePV(keyFilePath, stateFilePath)
+		pv = LoadFilePVWithPassphrase(keyFilePath, stateFilePath, passphrase)
 	} else {
 		pv = GenFilePV(keyFilePath, stateFilePath)
 		pv.Save()
@@ -241,6 +340,75 @@ func (pv *FilePV) GetPubKey() crypto.PubKey {
 	return pv.Key.PubKey
 }
 
+// GetNextPubKey returns the public key the validator is rotating to, or nil.
+// Implements RotatingPrivValidator.
+func (pv *FilePV) GetNextPubKey() crypto.PubKey {
+	if pv.Key.NextPrivKey == nil {
+		return nil
+	}
+	return pv.Key.NextPrivKey.PubKey()
+}
+
+// PendingKeyRotation returns the rotation to the next key, or nil.
+// Implements RotatingPrivValidator.
+func (pv *FilePV) PendingKeyRotation() *types.KeyRotation {
+	return pv.Key.KeyRotation
+}
+
+// RotateKey signs the rotation to newPrivKey with the current and the new
+// key, and saves the new key along with the rotation until HandOver. It
+// replaces any pending rotation. height must be a height the current key is
+// the validator's key at.
+func (pv *FilePV) RotateKey(chainID string, newPrivKey crypto.PrivKey, height int64, t time.Time) (*types.KeyRotation, error) {
+	rot := &types.KeyRotation{
+		ValidatorAddress: pv.Key.Address,
+		SignedHeight:     height,
+		Timestamp:        t,
+		NewPubKey:        newPrivKey.PubKey(),
+	}
+	if blsKey, ok := newPrivKey.(bls12381.PrivKeyBls12381); ok {
+		rot.ProofOfPossession = blsKey.ProofOfPossession()
+	}
+
+	signBytes := rot.SignBytes(chainID)
+	var err error
+	rot.OldSignature, err = pv.Key.PrivKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+	rot.NewSignature, err = newPrivKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := rot.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	pv.Key.NextPrivKey = newPrivKey
+	pv.Key.KeyRotation = rot
+	pv.Key.Save()
+	return rot, nil
+}
+
+// HandOver replaces the key with the next key, which signs from height on.
+// Implements RotatingPrivValidator.
+func (pv *FilePV) HandOver(height int64) error {
+	if pv.Key.NextPrivKey == nil {
+		return errors.New("no key rotation is pending")
+	}
+	if height <= pv.LastSignState.Height {
+		return fmt.Errorf("handover height %d is not above the last signed height %d",
+			height, pv.LastSignState.Height)
+	}
+	pv.Key.PrivKey = pv.Key.NextPrivKey
+	pv.Key.PubKey = pv.Key.PrivKey.PubKey()
+	pv.Key.NextPrivKey = nil
+	pv.Key.KeyRotation = nil
+	pv.Key.HandoverHeight = height
+	pv.Key.Save()
+	return nil
+}
+
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (pv *FilePV) SignVote(chainID string, vote *types.Vote) error {
@@ -259,6 +427,13 @@ func (pv *FilePV) SignProposal(chainID string, proposal *types.Proposal) error {
 	return nil
 }
 
+// LastSignedHeight returns the height of the last vote or proposal signed, so
+// the consensus can tell the precommits of the validator it signed from the
+// ones of another node running it. See consensus.double_sign_check_height.
+func (pv *FilePV) LastSignedHeight() int64 {
+	return pv.LastSignState.Height
+}
+
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
@@ -296,6 +471,10 @@ func (pv *FilePV) String() string {
 func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 	height, round, step := vote.Height, vote.Round, voteToStep(vote)
 
+	if err := pv.checkHandoverHeight(height); err != nil {
+		return err
+	}
+
 	lss := pv.LastSignState
 
 	sameHRS, err := lss.CheckHRS(height, round, step)
@@ -317,9 +496,12 @@ func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 			vote.Timestamp = timestamp
 			vote.Signature = lss.Signature
 		} else {
-			err = fmt.Errorf("conflicting data")
+			return fmt.Errorf("conflicting data")
 		}
-		return err
+		if err := pv.signVoteExtension(chainID, vote); err != nil {
+			return err
+		}
+		return pv.signVoteAggregation(chainID, vote)
 	}
 
 	// It passed the checks. Sign the vote
@@ -329,6 +511,40 @@ func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 	}
 	pv.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
+	if err := pv.signVoteExtension(chainID, vote); err != nil {
+		return err
+	}
+	return pv.signVoteAggregation(chainID, vote)
+}
+
+// signVoteExtension signs the extension of a precommit for a block. The
+// extension is not part of the vote's sign bytes, so it is not covered by the
+// double sign protection: signing a different extension for the same vote
+// can't produce conflicting votes.
+func (pv *FilePV) signVoteExtension(chainID string, vote *types.Vote) error {
+	if !vote.IsExtendable() {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(vote.ExtensionSignBytes(chainID))
+	if err != nil {
+		return err
+	}
+	vote.ExtensionSignature = sig
+	return nil
+}
+
+// signVoteAggregation signs a precommit for a block for aggregation, if the key
+// is a BLS12-381 key. It is only signed along with the vote, whose sign bytes
+// only add the timestamp, so it is covered by the double sign protection.
+func (pv *FilePV) signVoteAggregation(chainID string, vote *types.Vote) error {
+	if _, ok := pv.Key.PrivKey.(bls12381.PrivKeyBls12381); !ok || !vote.IsExtendable() {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(vote.AggregationSignBytes(chainID))
+	if err != nil {
+		return err
+	}
+	vote.AggregationSignature = sig
 	return nil
 }
 
@@ -338,6 +554,10 @@ func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 func (pv *FilePV) signProposal(chainID string, proposal *types.Proposal) error {
 	height, round, step := proposal.Height, proposal.Round, stepPropose
 
+	if err := pv.checkHandoverHeight(height); err != nil {
+		return err
+	}
+
 	lss := pv.LastSignState
 
 	sameHRS, err := lss.CheckHRS(height, round, step)
@@ -374,6 +594,15 @@ func (pv *FilePV) signProposal(chainID string, proposal *types.Proposal) error {
 	return nil
 }
 
+// checkHandoverHeight refuses to sign below the handover height, where the
+// validator's key is the previous key.
+func (pv *FilePV) checkHandoverHeight(height int64) error {
+	if height < pv.Key.HandoverHeight {
+		return fmt.Errorf("height %d is below the handover height %d of the key", height, pv.Key.HandoverHeight)
+	}
+	return nil
+}
+
 // Persist height/round/step and signature
 func (pv *FilePV) saveSigned(height int64, round int, step int8,
 	signBytes []byte, sig []byte) {
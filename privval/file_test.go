@@ -10,7 +10,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/keystore"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
@@ -35,6 +37,95 @@ func TestGenLoadValidator(t *testing.T) {
 	assert.Equal(height, privVal.LastSignState.Height, "expected privval.LastHeight to have been saved")
 }
 
+func TestGenLoadEncryptedValidator(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal.Key.SetPassphrase("passphrase")
+	privVal.Save()
+	assert.True(t, privVal.Key.Encrypted())
+
+	keyBytes, err := ioutil.ReadFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.True(t, keystore.IsArmored(keyBytes))
+
+	_, err = LoadFilePVKey(tempKeyFile.Name(), nil)
+	assert.Error(t, err)
+	_, err = LoadFilePVKey(tempKeyFile.Name(), func() (string, error) { return "wrong passphrase", nil })
+	assert.Equal(t, keystore.ErrWrongPassphrase, err)
+
+	loaded := LoadFilePVWithPassphrase(tempKeyFile.Name(), tempStateFile.Name(),
+		func() (string, error) { return "passphrase", nil })
+	assert.Equal(t, privVal.Key.PrivKey, loaded.Key.PrivKey)
+	assert.Equal(t, privVal.GetAddress(), loaded.GetAddress())
+
+	// Saving again keeps the key encrypted, unless the passphrase is cleared.
+	loaded.Save()
+	keyBytes, err = ioutil.ReadFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.True(t, keystore.IsArmored(keyBytes))
+
+	loaded.Key.SetPassphrase("")
+	loaded.Save()
+	assert.Equal(t, privVal.GetAddress(), LoadFilePV(tempKeyFile.Name(), tempStateFile.Name()).GetAddress())
+}
+
+func TestRotateKeyAndHandOver(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal.Key.SetPassphrase("passphrase")
+	privVal.Save()
+	addr, oldPubKey := privVal.GetAddress(), privVal.GetPubKey()
+	assert.Error(t, privVal.HandOver(5))
+
+	newPrivKey := ed25519.GenPrivKey()
+	rot, err := privVal.RotateKey("mychainid", newPrivKey, 3, tmtime.Now())
+	require.NoError(t, err)
+	assert.NoError(t, rot.Verify("mychainid", oldPubKey))
+	assert.Equal(t, addr, rot.ValidatorAddress)
+
+	// The rotation is saved encrypted, along with the next key.
+	passphrase := func() (string, error) { return "passphrase", nil }
+	keyBytes, err := ioutil.ReadFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.True(t, keystore.IsArmored(keyBytes))
+	privVal = LoadFilePVWithPassphrase(tempKeyFile.Name(), tempStateFile.Name(), passphrase)
+	assert.Equal(t, rot, privVal.PendingKeyRotation())
+	assert.Equal(t, newPrivKey.PubKey(), privVal.GetNextPubKey())
+	assert.Equal(t, oldPubKey, privVal.GetPubKey())
+
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	vote := newVote(addr, 0, 4, 0, byte(types.PrevoteType), block)
+	require.NoError(t, privVal.SignVote("mychainid", vote))
+
+	// The handover must be above the last signed height.
+	assert.Error(t, privVal.HandOver(4))
+	require.NoError(t, privVal.HandOver(5))
+	assert.Nil(t, privVal.PendingKeyRotation())
+	assert.Nil(t, privVal.GetNextPubKey())
+
+	// The validator keeps its address, and only signs from the handover
+	// height with the new key.
+	privVal = LoadFilePVWithPassphrase(tempKeyFile.Name(), tempStateFile.Name(), passphrase)
+	assert.Equal(t, addr, privVal.GetAddress())
+	assert.Equal(t, newPrivKey.PubKey(), privVal.GetPubKey())
+	assert.EqualValues(t, 5, privVal.Key.HandoverHeight)
+
+	privVal.Reset()
+	vote = newVote(addr, 0, 4, 0, byte(types.PrevoteType), block)
+	assert.Error(t, privVal.SignVote("mychainid", vote))
+	vote = newVote(addr, 0, 5, 0, byte(types.PrevoteType), block)
+	require.NoError(t, privVal.SignVote("mychainid", vote))
+	assert.True(t, newPrivKey.PubKey().VerifyBytes(vote.SignBytes("mychainid"), vote.Signature))
+}
+
 func TestResetValidator(t *testing.T) {
 	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
 	require.Nil(t, err)
@@ -198,6 +289,71 @@ func TestSignVote(t *testing.T) {
 	assert.Equal(sig, vote.Signature)
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	pubKey := privVal.GetPubKey()
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	height, round := int64(10), 1
+
+	// prevotes don't get an extension signature
+	vote := newVote(privVal.Key.Address, 0, height, round, byte(types.PrevoteType), block1)
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.Empty(t, vote.ExtensionSignature)
+
+	// precommits for a block do
+	vote = newVote(privVal.Key.Address, 0, height, round, byte(types.PrecommitType), block1)
+	vote.Extension = []byte("extension")
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.NoError(t, vote.VerifyExtension("mychainid", pubKey))
+
+	// signing the same precommit with another extension is not a double sign
+	sig := vote.Signature
+	vote.Extension = []byte("other extension")
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.Equal(t, sig, vote.Signature)
+	assert.NoError(t, vote.VerifyExtension("mychainid", pubKey))
+}
+
+func TestSignVoteAggregation(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := NewFilePV(bls12381.GenPrivKey(), tempKeyFile.Name(), tempStateFile.Name())
+	val := types.NewValidator(privVal.GetPubKey(), 10)
+
+	block1 := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+	height, round := int64(10), 1
+
+	// prevotes don't get an aggregation signature
+	vote := newVote(privVal.Key.Address, 0, height, round, byte(types.PrevoteType), block1)
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.Empty(t, vote.AggregationSignature)
+
+	// precommits for a block do, also when signed again
+	vote = newVote(privVal.Key.Address, 0, height, round, byte(types.PrecommitType), block1)
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.NoError(t, vote.VerifyValidatorAggregation("mychainid", val))
+
+	vote.AggregationSignature = nil
+	vote.Timestamp = vote.Timestamp.Add(time.Second)
+	err = privVal.SignVote("mychainid", vote)
+	require.NoError(t, err)
+	assert.NoError(t, vote.VerifyValidatorAggregation("mychainid", val))
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1,10 +1,28 @@
 package proxy
 
 import (
+	"sync"
+
+	"github.com/pkg/errors"
+
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/types"
 )
 
+var (
+	// ErrAppConnReset is returned for requests which were lost because the
+	// connection to the app broke while they were in flight. It is only
+	// returned once the connection has been re-established and the app
+	// brought back in sync (see ReconnectHandler).
+	ErrAppConnReset = errors.New("connection to the app was reset")
+	// ErrAppConnDown is returned by Error while the connection to the app is
+	// being re-established.
+	ErrAppConnDown = errors.New("connection to the app is down")
+	// ErrAppConnClosed is returned for requests made after the connections
+	// to the app were stopped.
+	ErrAppConnClosed = errors.New("connection to the app is closed")
+)
+
 //----------------------------------------------------------------------------------------
 // Enforce which abci msgs can be sent on a connection at the type level
 
@@ -18,6 +36,14 @@ type AppConnConsensus interface {
 	DeliverTxAsync(types.RequestDeliverTx) *abcicli.ReqRes
 	EndBlockSync(types.RequestEndBlock) (*types.ResponseEndBlock, error)
 	CommitSync() (*types.ResponseCommit, error)
+
+	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
+
+	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
+
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
 }
 
 type AppConnMempool interface {
@@ -36,6 +62,7 @@ type AppConnQuery interface {
 	EchoSync(string) (*types.ResponseEcho, error)
 	InfoSync(types.RequestInfo) (*types.ResponseInfo, error)
 	QuerySync(types.RequestQuery) (*types.ResponseQuery, error)
+	ExportStateSync(types.RequestExportState) (*types.ResponseExportState, error)
 
 	//	SetOptionSync(key string, value string) (res types.Result)
 }
@@ -44,101 +71,387 @@ type AppConnQuery interface {
 // Implements AppConnConsensus (subset of abcicli.Client)
 
 type appConnConsensus struct {
-	appConn abcicli.Client
+	appConn *clientGate
+
+	// The client executing the current block, from BeginBlock to Commit.
+	// The block can't be continued on another client when it's replaced.
+	mtx         sync.Mutex
+	blockClient abcicli.Client
+	replaced    <-chan struct{}
 }
 
 func NewAppConnConsensus(appConn abcicli.Client) *appConnConsensus {
 	return &appConnConsensus{
-		appConn: appConn,
+		appConn: newClientGate(appConn),
 	}
 }
 
 func (app *appConnConsensus) SetResponseCallback(cb abcicli.Callback) {
-	app.appConn.SetResponseCallback(cb)
+	app.appConn.setResponseCallback(cb)
 }
 
 func (app *appConnConsensus) Error() error {
-	return app.appConn.Error()
+	if cli, _ := app.block(); cli != nil {
+		if err := cli.Error(); err != nil || cli.IsRunning() {
+			return err
+		}
+		return ErrAppConnDown
+	}
+	return app.appConn.error()
 }
 
 func (app *appConnConsensus) InitChainSync(req types.RequestInitChain) (*types.ResponseInitChain, error) {
-	return app.appConn.InitChainSync(req)
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.InitChainSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
 }
 
 func (app *appConnConsensus) BeginBlockSync(req types.RequestBeginBlock) (*types.ResponseBeginBlock, error) {
-	return app.appConn.BeginBlockSync(req)
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	app.setBlock(cli, replaced)
+	res, err := cli.BeginBlockSync(req)
+	return res, app.endBlockOnError(app.appConn.wrap(cli, replaced, err))
 }
 
 func (app *appConnConsensus) DeliverTxAsync(req types.RequestDeliverTx) *abcicli.ReqRes {
-	return app.appConn.DeliverTxAsync(req)
+	cli, _ := app.block()
+	if cli == nil {
+		if cli = app.appConn.current(); cli == nil {
+			return closedReqRes(types.ToRequestDeliverTx(req))
+		}
+	}
+	return cli.DeliverTxAsync(req)
 }
 
 func (app *appConnConsensus) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
-	return app.appConn.EndBlockSync(req)
+	cli, replaced, err := app.blockOrGet()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.EndBlockSync(req)
+	if err == nil && !cli.IsRunning() {
+		// A stopped local client still answers, but DeliverTxs sent after it
+		// stopped were dropped.
+		err = ErrAppConnDown
+	}
+	return res, app.endBlockOnError(app.appConn.wrap(cli, replaced, err))
 }
 
 func (app *appConnConsensus) CommitSync() (*types.ResponseCommit, error) {
-	return app.appConn.CommitSync()
+	cli, replaced, err := app.blockOrGet()
+	if err != nil {
+		return nil, err
+	}
+	defer app.setBlock(nil, nil)
+	res, err := cli.CommitSync()
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+func (app *appConnConsensus) block() (abcicli.Client, <-chan struct{}) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return app.blockClient, app.replaced
+}
+
+func (app *appConnConsensus) setBlock(cli abcicli.Client, replaced <-chan struct{}) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.blockClient, app.replaced = cli, replaced
+}
+
+// blockOrGet returns the client executing the current block, if any.
+func (app *appConnConsensus) blockOrGet() (abcicli.Client, <-chan struct{}, error) {
+	if cli, replaced := app.block(); cli != nil {
+		return cli, replaced, nil
+	}
+	return app.appConn.get()
+}
+
+// endBlockOnError forgets the client executing the current block if the
+// block failed.
+func (app *appConnConsensus) endBlockOnError(err error) error {
+	if err != nil {
+		app.setBlock(nil, nil)
+	}
+	return err
+}
+
+func (app *appConnConsensus) PrepareProposalSync(
+	req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.PrepareProposalSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+func (app *appConnConsensus) ProcessProposalSync(
+	req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.ProcessProposalSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+func (app *appConnConsensus) ExtendVoteSync(
+	req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.ExtendVoteSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+func (app *appConnConsensus) VerifyVoteExtensionSync(
+	req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.VerifyVoteExtensionSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
 }
 
 //------------------------------------------------
 // Implements AppConnMempool (subset of abcicli.Client)
 
 type appConnMempool struct {
-	appConn abcicli.Client
+	appConn *clientGate
 }
 
 func NewAppConnMempool(appConn abcicli.Client) *appConnMempool {
 	return &appConnMempool{
-		appConn: appConn,
+		appConn: newClientGate(appConn),
 	}
 }
 
 func (app *appConnMempool) SetResponseCallback(cb abcicli.Callback) {
-	app.appConn.SetResponseCallback(cb)
+	app.appConn.setResponseCallback(cb)
 }
 
 func (app *appConnMempool) Error() error {
-	return app.appConn.Error()
+	return app.appConn.error()
 }
 
 func (app *appConnMempool) FlushAsync() *abcicli.ReqRes {
-	return app.appConn.FlushAsync()
+	cli := app.appConn.current()
+	if cli == nil {
+		return closedReqRes(types.ToRequestFlush())
+	}
+	return cli.FlushAsync()
 }
 
 func (app *appConnMempool) FlushSync() error {
-	return app.appConn.FlushSync()
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return err
+	}
+	return app.appConn.wrap(cli, replaced, cli.FlushSync())
 }
 
 func (app *appConnMempool) CheckTxAsync(req types.RequestCheckTx) *abcicli.ReqRes {
-	return app.appConn.CheckTxAsync(req)
+	cli := app.appConn.current()
+	if cli == nil {
+		return closedReqRes(types.ToRequestCheckTx(req))
+	}
+	return cli.CheckTxAsync(req)
 }
 
 //------------------------------------------------
 // Implements AppConnQuery (subset of abcicli.Client)
 
 type appConnQuery struct {
-	appConn abcicli.Client
+	appConn *clientGate
 }
 
 func NewAppConnQuery(appConn abcicli.Client) *appConnQuery {
 	return &appConnQuery{
-		appConn: appConn,
+		appConn: newClientGate(appConn),
 	}
 }
 
 func (app *appConnQuery) Error() error {
-	return app.appConn.Error()
+	return app.appConn.error()
 }
 
 func (app *appConnQuery) EchoSync(msg string) (*types.ResponseEcho, error) {
-	return app.appConn.EchoSync(msg)
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.EchoSync(msg)
+	return res, app.appConn.wrap(cli, replaced, err)
 }
 
 func (app *appConnQuery) InfoSync(req types.RequestInfo) (*types.ResponseInfo, error) {
-	return app.appConn.InfoSync(req)
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.InfoSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
 }
 
 func (app *appConnQuery) QuerySync(reqQuery types.RequestQuery) (*types.ResponseQuery, error) {
-	return app.appConn.QuerySync(reqQuery)
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.QuerySync(reqQuery)
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+func (app *appConnQuery) ExportStateSync(req types.RequestExportState) (*types.ResponseExportState, error) {
+	cli, replaced, err := app.appConn.get()
+	if err != nil {
+		return nil, err
+	}
+	res, err := cli.ExportStateSync(req)
+	return res, app.appConn.wrap(cli, replaced, err)
+}
+
+//------------------------------------------------
+// clientGate
+
+// clientGate holds the client behind a connection. When the connection to the
+// app is lost, the supervising multiAppConn closes the gate and later replaces
+// the client. Synchronous requests made in the meantime wait for the new one,
+// while asynchronous ones are dropped.
+type clientGate struct {
+	supervised bool // set if a multiAppConn replaces client when it's stopped
+
+	mtx      sync.Mutex
+	client   abcicli.Client
+	resCb    abcicli.Callback
+	open     chan struct{} // closed while requests can be sent to client
+	replaced chan struct{} // closed when client is replaced
+	quit     chan struct{} // closed when the connection is stopped for good
+	quitOnce sync.Once
+}
+
+func newClientGate(client abcicli.Client) *clientGate {
+	g := &clientGate{
+		client:   client,
+		open:     make(chan struct{}),
+		replaced: make(chan struct{}),
+		quit:     make(chan struct{}),
+	}
+	close(g.open)
+	return g
+}
+
+// get returns the current client, waiting for the gate to be open. It also
+// returns a channel which is closed when the client is replaced.
+func (g *clientGate) get() (abcicli.Client, <-chan struct{}, error) {
+	g.mtx.Lock()
+	client, open, replaced := g.client, g.open, g.replaced
+	g.mtx.Unlock()
+
+	select {
+	case <-open:
+		return client, replaced, nil
+	case <-g.quit:
+		return nil, nil, ErrAppConnClosed
+	}
+}
+
+// wrap returns the error of a request sent to client. If the client failed
+// because it was stopped, it waits for the client to be replaced and returns
+// ErrAppConnReset, as the request was lost with the old connection.
+func (g *clientGate) wrap(client abcicli.Client, replaced <-chan struct{}, err error) error {
+	if err == nil || !g.supervised || client.IsRunning() {
+		return err
+	}
+	select {
+	case <-replaced:
+		return ErrAppConnReset
+	case <-g.quit:
+		return ErrAppConnClosed
+	}
+}
+
+// current returns the current client without waiting for the gate to be
+// open, or nil if the connection was stopped. Requests sent to a client that
+// was stopped are dropped.
+func (g *clientGate) current() abcicli.Client {
+	select {
+	case <-g.quit:
+		return nil
+	default:
+	}
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.client
+}
+
+func (g *clientGate) error() error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if err := g.client.Error(); err != nil {
+		return err
+	}
+	select {
+	case <-g.open:
+		return nil
+	default:
+		return ErrAppConnDown
+	}
+}
+
+func (g *clientGate) setResponseCallback(cb abcicli.Callback) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.resCb = cb
+	g.client.SetResponseCallback(cb)
+}
+
+// close makes requests wait until the client is replaced.
+func (g *clientGate) close() {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	select {
+	case <-g.open:
+		g.open = make(chan struct{})
+	default:
+	}
+}
+
+// replace installs client and opens the gate, releasing waiting requests.
+func (g *clientGate) replace(client abcicli.Client) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if g.resCb != nil {
+		client.SetResponseCallback(g.resCb)
+	}
+	g.client = client
+	close(g.replaced)
+	g.replaced = make(chan struct{})
+	select {
+	case <-g.open:
+	default:
+		close(g.open)
+	}
+}
+
+// stop releases waiting requests with ErrAppConnClosed.
+func (g *clientGate) stop() {
+	g.quitOnce.Do(func() { close(g.quit) })
+}
+
+// closedReqRes returns a request which is already done without a response,
+// as a client does for requests it can no longer send.
+func closedReqRes(req *types.Request) *abcicli.ReqRes {
+	reqRes := abcicli.NewReqRes(req)
+	reqRes.Done()
+	return reqRes
 }
@@ -16,6 +16,20 @@ type ClientCreator interface {
 	NewABCIClient() (abcicli.Client, error)
 }
 
+// connClientCreator is implemented by the creators whose clients depend on
+// the connection they're made for: "query", "mempool" or "consensus".
+type connClientCreator interface {
+	NewConnABCIClient(conn string) (abcicli.Client, error)
+}
+
+// newClient returns a client for the named connection made by clientCreator.
+func newClient(clientCreator ClientCreator, conn string) (abcicli.Client, error) {
+	if cc, ok := clientCreator.(connClientCreator); ok {
+		return cc.NewConnABCIClient(conn)
+	}
+	return clientCreator.NewABCIClient()
+}
+
 //----------------------------------------------------
 // local proxy uses a mutex on an in-proc app
 
@@ -42,17 +56,28 @@ type remoteClientCreator struct {
 	addr        string
 	transport   string
 	mustConnect bool
+	concurrency int
 }
 
 func NewRemoteClientCreator(addr, transport string, mustConnect bool) ClientCreator {
+	return NewRemoteStreamClientCreator(addr, transport, mustConnect, 1)
+}
+
+// NewRemoteStreamClientCreator is like NewRemoteClientCreator, but the
+// clients open concurrency streams if the transport is "grpc-stream".
+func NewRemoteStreamClientCreator(addr, transport string, mustConnect bool, concurrency int) ClientCreator {
 	return &remoteClientCreator{
 		addr:        addr,
 		transport:   transport,
 		mustConnect: mustConnect,
+		concurrency: concurrency,
 	}
 }
 
 func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
+	if r.transport == "grpc-stream" {
+		return abcicli.NewGRPCStreamClient(r.addr, r.mustConnect, r.concurrency), nil
+	}
 	remoteApp, err := abcicli.NewClient(r.addr, r.transport, r.mustConnect)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to connect to proxy")
@@ -60,10 +85,46 @@ func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
 	return remoteApp, nil
 }
 
+//-----------------------------------------------------------------
+// recording proxy records the requests made to the app and its responses
+
+type recordingClientCreator struct {
+	clientCreator ClientCreator
+	recorder      *abcicli.Recorder
+}
+
+// NewRecordingClientCreator returns a creator wrapping the clients made by
+// clientCreator, so they record their requests and the responses with
+// recorder, tagged with the name of their connection. The log can then be
+// replayed with `abci-cli replay`.
+func NewRecordingClientCreator(clientCreator ClientCreator, recorder *abcicli.Recorder) ClientCreator {
+	return &recordingClientCreator{
+		clientCreator: clientCreator,
+		recorder:      recorder,
+	}
+}
+
+// NewABCIClient returns a client recording its requests as made on an
+// unnamed connection.
+func (r *recordingClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return r.NewConnABCIClient("")
+}
+
+func (r *recordingClientCreator) NewConnABCIClient(conn string) (abcicli.Client, error) {
+	client, err := newClient(r.clientCreator, conn)
+	if err != nil {
+		return nil, err
+	}
+	return abcicli.NewRecordingClient(client, r.recorder, conn), nil
+}
+
 //-----------------------------------------------------------------
 // default
 
-func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
+// DefaultClientCreator returns a creator for the named built-in app, or for
+// clients of the app at addr otherwise. concurrency is the number of streams
+// opened per connection to a remote app when transport is "grpc-stream".
+func DefaultClientCreator(addr, transport, dbDir string, concurrency int) ClientCreator {
 	switch addr {
 	case "counter":
 		return NewLocalClientCreator(counter.NewApplication(false))
@@ -77,6 +138,6 @@ func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
 		return NewLocalClientCreator(types.NewBaseApplication())
 	default:
 		mustConnect := false // loop retrying
-		return NewRemoteClientCreator(addr, transport, mustConnect)
+		return NewRemoteStreamClientCreator(addr, transport, mustConnect, concurrency)
 	}
 }
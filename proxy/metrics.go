@@ -0,0 +1,73 @@
+package proxy
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "abci_connection"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Whether the connections to the app are up (1) or not (0).
+	Connected metrics.Gauge
+	// Number of times the connections to the app were lost.
+	Disconnects metrics.Counter
+	// Number of attempts made to re-establish the connections.
+	ReconnectAttempts metrics.Counter
+	// Time the connections were down for, in seconds.
+	DowntimeSeconds metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		Connected: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "connected",
+			Help:      "Whether the connections to the app are up (1) or not (0).",
+		}, labels).With(labelsAndValues...),
+		Disconnects: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "disconnects",
+			Help:      "Number of times the connections to the app were lost.",
+		}, labels).With(labelsAndValues...),
+		ReconnectAttempts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reconnect_attempts",
+			Help:      "Number of attempts made to re-establish the connections to the app.",
+		}, labels).With(labelsAndValues...),
+		DowntimeSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "downtime_seconds",
+			Help:      "Time the connections to the app were down for, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.1, 4, 8),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Connected:         discard.NewGauge(),
+		Disconnects:       discard.NewCounter(),
+		ReconnectAttempts: discard.NewCounter(),
+		DowntimeSeconds:   discard.NewHistogram(),
+	}
+}
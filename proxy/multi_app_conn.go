@@ -1,11 +1,20 @@
 package proxy
 
 import (
+	"sync"
+	"time"
+
 	"github.com/pkg/errors"
 
+	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/libs/service"
 )
 
+const (
+	defaultReconnectBackoffMin = 500 * time.Millisecond
+	defaultReconnectBackoffMax = 30 * time.Second
+)
+
 //-----------------------------
 
 // Tendermint's interface to the application consists of multiple connections
@@ -15,18 +24,38 @@ type AppConns interface {
 	Mempool() AppConnMempool
 	Consensus() AppConnConsensus
 	Query() AppConnQuery
+
+	// SetReconnectHandler sets the handler used to bring the app back in sync
+	// after the connections to it were re-established.
+	SetReconnectHandler(ReconnectHandler)
+}
+
+// ReconnectHandler is used by AppConns when the connection to the app breaks
+// (ie. the app crashed). All connections are then re-established together,
+// and requests made on the old ones block until that is done.
+type ReconnectHandler interface {
+	// Handshake is called with the new connections, before they replace the
+	// old ones. It must bring the app back in sync with the node (see
+	// consensus.Handshaker). If it fails, the connections are dropped and
+	// re-established again.
+	Handshake(AppConns) error
+
+	// Resumed is called once the new connections replaced the old ones and
+	// the blocked requests were released.
+	Resumed()
 }
 
-func NewAppConns(clientCreator ClientCreator) AppConns {
-	return NewMultiAppConn(clientCreator)
+func NewAppConns(clientCreator ClientCreator, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, options...)
 }
 
 //-----------------------------
 // multiAppConn implements AppConns
 
 // a multiAppConn is made of a few appConns (mempool, consensus, query)
-// and manages their underlying abci clients
-// TODO: on app restart, clients must reboot together
+// and manages their underlying abci clients.
+// If any of the clients stops (ie. the app went away), all of them are stopped
+// and re-created, with an exponential backoff between attempts.
 type multiAppConn struct {
 	service.BaseService
 
@@ -35,14 +64,45 @@ type multiAppConn struct {
 	queryConn     *appConnQuery
 
 	clientCreator ClientCreator
+	metrics       *Metrics
+
+	backoffMin time.Duration
+	backoffMax time.Duration
+
+	mtx              sync.Mutex
+	clients          []abcicli.Client // query, mempool and consensus clients
+	reconnectHandler ReconnectHandler
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) MultiAppConnOption {
+	return func(app *multiAppConn) { app.metrics = metrics }
+}
+
+// WithReconnectBackoff sets the minimum and maximum time to wait between two
+// attempts to re-establish the connections to the app.
+func WithReconnectBackoff(min, max time.Duration) MultiAppConnOption {
+	return func(app *multiAppConn) {
+		app.backoffMin = min
+		app.backoffMax = max
+	}
 }
 
 // Make all necessary abci connections to the application
-func NewMultiAppConn(clientCreator ClientCreator) *multiAppConn {
+func NewMultiAppConn(clientCreator ClientCreator, options ...MultiAppConnOption) *multiAppConn {
 	multiAppConn := &multiAppConn{
 		clientCreator: clientCreator,
+		metrics:       NopMetrics(),
+		backoffMin:    defaultReconnectBackoffMin,
+		backoffMax:    defaultReconnectBackoffMax,
 	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
+	for _, option := range options {
+		option(multiAppConn)
+	}
 	return multiAppConn
 }
 
@@ -61,39 +121,186 @@ func (app *multiAppConn) Query() AppConnQuery {
 	return app.queryConn
 }
 
+// SetReconnectHandler implements AppConns.
+func (app *multiAppConn) SetReconnectHandler(handler ReconnectHandler) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.reconnectHandler = handler
+}
+
 func (app *multiAppConn) OnStart() error {
-	// query connection
-	querycli, err := app.clientCreator.NewABCIClient()
+	clients, err := app.startClients()
 	if err != nil {
-		return errors.Wrap(err, "Error creating ABCI client (query connection)")
+		return err
 	}
-	querycli.SetLogger(app.Logger.With("module", "abci-client", "connection", "query"))
-	if err := querycli.Start(); err != nil {
-		return errors.Wrap(err, "Error starting ABCI client (query connection)")
+	app.clients = clients
+	app.queryConn = NewAppConnQuery(clients[0])
+	app.mempoolConn = NewAppConnMempool(clients[1])
+	app.consensusConn = NewAppConnConsensus(clients[2])
+	for _, g := range app.gates() {
+		g.supervised = true
 	}
-	app.queryConn = NewAppConnQuery(querycli)
+	app.metrics.Connected.Set(1)
 
-	// mempool connection
-	memcli, err := app.clientCreator.NewABCIClient()
-	if err != nil {
-		return errors.Wrap(err, "Error creating ABCI client (mempool connection)")
+	go app.supervise()
+
+	return nil
+}
+
+func (app *multiAppConn) OnStop() {
+	for _, g := range app.gates() {
+		g.stop()
 	}
-	memcli.SetLogger(app.Logger.With("module", "abci-client", "connection", "mempool"))
-	if err := memcli.Start(); err != nil {
-		return errors.Wrap(err, "Error starting ABCI client (mempool connection)")
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	stopClients(app.clients)
+}
+
+func (app *multiAppConn) gates() []*clientGate {
+	return []*clientGate{app.queryConn.appConn, app.mempoolConn.appConn, app.consensusConn.appConn}
+}
+
+// startClients creates and starts the query, mempool and consensus clients.
+func (app *multiAppConn) startClients() ([]abcicli.Client, error) {
+	var clients []abcicli.Client
+	for _, name := range []string{"query", "mempool", "consensus"} {
+		cli, err := newClient(app.clientCreator, name)
+		if err != nil {
+			stopClients(clients)
+			return nil, errors.Wrapf(err, "Error creating ABCI client (%s connection)", name)
+		}
+		cli.SetLogger(app.Logger.With("module", "abci-client", "connection", name))
+		if err := cli.Start(); err != nil {
+			stopClients(clients)
+			return nil, errors.Wrapf(err, "Error starting ABCI client (%s connection)", name)
+		}
+		clients = append(clients, cli)
 	}
-	app.mempoolConn = NewAppConnMempool(memcli)
+	return clients, nil
+}
 
-	// consensus connection
-	concli, err := app.clientCreator.NewABCIClient()
-	if err != nil {
-		return errors.Wrap(err, "Error creating ABCI client (consensus connection)")
+func stopClients(clients []abcicli.Client) {
+	for _, cli := range clients {
+		if cli.IsRunning() {
+			cli.Stop()
+		}
 	}
-	concli.SetLogger(app.Logger.With("module", "abci-client", "connection", "consensus"))
-	if err := concli.Start(); err != nil {
-		return errors.Wrap(err, "Error starting ABCI client (consensus connection)")
+}
+
+// supervise waits for any of the clients to stop, then re-establishes all of
+// the connections.
+func (app *multiAppConn) supervise() {
+	for {
+		app.mtx.Lock()
+		clients := app.clients
+		app.mtx.Unlock()
+
+		select {
+		case <-clients[0].Quit():
+		case <-clients[1].Quit():
+		case <-clients[2].Quit():
+		case <-app.Quit():
+			return
+		}
+		if !app.IsRunning() {
+			return
+		}
+
+		var err error
+		for _, cli := range clients {
+			if err = cli.Error(); err != nil {
+				break
+			}
+		}
+		app.Logger.Error("Lost connection to the ABCI app, pausing requests until it's re-established", "err", err)
+		app.metrics.Connected.Set(0)
+		app.metrics.Disconnects.Add(1)
+		downSince := time.Now()
+
+		// The connections must be re-established together.
+		for _, g := range app.gates() {
+			g.close()
+		}
+		app.mtx.Lock()
+		stopClients(app.clients)
+		app.mtx.Unlock()
+
+		if !app.reconnect() {
+			return
+		}
+
+		app.metrics.Connected.Set(1)
+		app.metrics.DowntimeSeconds.Observe(time.Since(downSince).Seconds())
+		app.Logger.Info("Re-established connection to the ABCI app, resuming requests",
+			"downtime", time.Since(downSince))
+
+		app.mtx.Lock()
+		handler := app.reconnectHandler
+		app.mtx.Unlock()
+		if handler != nil {
+			handler.Resumed()
+		}
 	}
-	app.consensusConn = NewAppConnConsensus(concli)
+}
 
+// reconnect re-establishes the connections and runs the handshake on them
+// until it succeeds, then installs them. It returns false if app was stopped.
+func (app *multiAppConn) reconnect() bool {
+	backoff := app.backoffMin
+	for attempt := 1; ; attempt++ {
+		app.metrics.ReconnectAttempts.Add(1)
+		app.Logger.Info("Reconnecting to the ABCI app", "attempt", attempt)
+
+		clients, err := app.startClients()
+		if err == nil {
+			err = app.handshake(clients)
+			if err != nil {
+				stopClients(clients)
+			}
+		}
+		if err == nil {
+			app.mtx.Lock()
+			defer app.mtx.Unlock()
+			if !app.IsRunning() {
+				stopClients(clients)
+				return false
+			}
+			app.clients = clients
+			for i, g := range app.gates() {
+				g.replace(clients[i])
+			}
+			return true
+		}
+
+		app.Logger.Error("Failed to reconnect to the ABCI app", "attempt", attempt, "err", err, "retryIn", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-app.Quit():
+			return false
+		}
+		backoff *= 2
+		if backoff > app.backoffMax {
+			backoff = app.backoffMax
+		}
+	}
+}
+
+// handshake runs the reconnect handler, if any, on the new clients.
+func (app *multiAppConn) handshake(clients []abcicli.Client) error {
+	app.mtx.Lock()
+	handler := app.reconnectHandler
+	app.mtx.Unlock()
+	if handler == nil {
+		return nil
+	}
+
+	app.Logger.Info("Running the handshake with the ABCI app")
+	conns := NewMultiAppConn(app.clientCreator)
+	conns.queryConn = NewAppConnQuery(clients[0])
+	conns.mempoolConn = NewAppConnMempool(clients[1])
+	conns.consensusConn = NewAppConnConsensus(clients[2])
+	if err := handler.Handshake(conns); err != nil {
+		return errors.Wrap(err, "handshake failed")
+	}
 	return nil
 }
@@ -0,0 +1,173 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	"github.com/tendermint/tendermint/abci/server"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+type testReconnectHandler struct {
+	mtx        sync.Mutex
+	handshakes int
+	failures   int // number of handshakes to fail
+	resumed    chan struct{}
+}
+
+func (h *testReconnectHandler) Handshake(conns AppConns) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.handshakes++
+	if h.handshakes <= h.failures {
+		return errors.New("handshake failed")
+	}
+	_, err := conns.Query().InfoSync(RequestInfo)
+	return err
+}
+
+func (h *testReconnectHandler) Resumed() {
+	h.resumed <- struct{}{}
+}
+
+func startSocketServer(t *testing.T, sockPath string) service.Service {
+	s := server.NewSocketServer(sockPath, kvstore.NewApplication())
+	s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+	require.NoError(t, s.Start())
+	return s
+}
+
+func startAppConns(t *testing.T, sockPath string, handler ReconnectHandler) AppConns {
+	appConns := NewAppConns(NewRemoteClientCreator(sockPath, SOCKET, true),
+		WithReconnectBackoff(10*time.Millisecond, 100*time.Millisecond))
+	appConns.SetLogger(log.TestingLogger())
+	appConns.SetReconnectHandler(handler)
+	require.NoError(t, appConns.Start())
+	return appConns
+}
+
+func TestAppConnsReconnect(t *testing.T) {
+	sockPath := fmt.Sprintf("unix:///tmp/echo_%v.sock", tmrand.Str(6))
+	s := startSocketServer(t, sockPath)
+	handler := &testReconnectHandler{failures: 1, resumed: make(chan struct{}, 1)}
+	appConns := startAppConns(t, sockPath, handler)
+	defer appConns.Stop()
+
+	_, err := appConns.Query().InfoSync(RequestInfo)
+	require.NoError(t, err)
+
+	// The app goes away: requests wait for it to come back.
+	s.Stop()
+	require.Eventually(t, func() bool { return appConns.Query().Error() != nil }, time.Second, 10*time.Millisecond)
+	done := make(chan error, 1)
+	go func() {
+		_, err := appConns.Query().InfoSync(RequestInfo)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("request returned while the app is down: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	s = startSocketServer(t, sockPath)
+	defer s.Stop()
+
+	select {
+	case <-handler.resumed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connections weren't re-established")
+	}
+	select {
+	case err := <-done:
+		// The request may have been sent before the connection was found broken.
+		if err != ErrAppConnReset {
+			require.NoError(t, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request wasn't released")
+	}
+	// The first handshake failed, so the connections were re-established twice.
+	handler.mtx.Lock()
+	assert.Equal(t, 2, handler.handshakes)
+	handler.mtx.Unlock()
+
+	// The response callback carries over to the new connections.
+	responses := make(chan *types.Response, 1)
+	appConns.Mempool().SetResponseCallback(func(req *types.Request, res *types.Response) {
+		if _, ok := res.Value.(*types.Response_CheckTx); ok {
+			responses <- res
+		}
+	})
+	appConns.Mempool().CheckTxAsync(types.RequestCheckTx{Tx: []byte("key=value")})
+	require.NoError(t, appConns.Mempool().FlushSync())
+	select {
+	case res := <-responses:
+		assert.EqualValues(t, types.CodeTypeOK, res.GetCheckTx().Code)
+	case <-time.After(time.Second):
+		t.Fatal("no CheckTx response")
+	}
+}
+
+func TestAppConnsStopReleasesRequests(t *testing.T) {
+	sockPath := fmt.Sprintf("unix:///tmp/echo_%v.sock", tmrand.Str(6))
+	s := startSocketServer(t, sockPath)
+	appConns := startAppConns(t, sockPath, nil)
+
+	s.Stop()
+	require.Eventually(t, func() bool { return appConns.Query().Error() != nil }, time.Second, 10*time.Millisecond)
+	done := make(chan error, 1)
+	go func() {
+		_, err := appConns.Consensus().CommitSync()
+		done <- err
+	}()
+
+	require.NoError(t, appConns.Stop())
+	select {
+	case err := <-done:
+		assert.Equal(t, ErrAppConnClosed, err)
+	case <-time.After(time.Second):
+		t.Fatal("request wasn't released")
+	}
+}
+
+func TestAppConnsRecordConnections(t *testing.T) {
+	buf := new(bytes.Buffer)
+	rec := abcicli.NewRecorder(buf)
+	appConns := NewAppConns(NewRecordingClientCreator(NewLocalClientCreator(kvstore.NewApplication()), rec))
+	require.NoError(t, appConns.Start())
+
+	appConns.Mempool().CheckTxAsync(types.RequestCheckTx{Tx: []byte("a=1")})
+	require.NoError(t, appConns.Mempool().FlushSync())
+	_, err := appConns.Consensus().CommitSync()
+	require.NoError(t, err)
+	_, err = appConns.Query().InfoSync(RequestInfo)
+	require.NoError(t, err)
+	require.NoError(t, appConns.Stop())
+	require.NoError(t, rec.Close())
+
+	// each record is tagged with its connection
+	var conns []string
+	r := bufio.NewReader(buf)
+	for {
+		conn, _, _, err := abcicli.ReadRecord(r)
+		if err != nil {
+			break
+		}
+		conns = append(conns, conn)
+	}
+	assert.Equal(t, []string{"mempool", "consensus", "query"}, conns)
+}
@@ -285,6 +285,15 @@ func (c *baseRPCClient) ConsensusParams(height *int64) (*ctypes.ResultConsensusP
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorUptime(address []byte) (*ctypes.ResultValidatorUptime, error) {
+	result := new(ctypes.ResultValidatorUptime)
+	_, err := c.caller.Call("validator_uptime", map[string]interface{}{"address": address}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "ValidatorUptime")
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health() (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call("health", map[string]interface{}{}, result)
@@ -314,9 +323,13 @@ func (c *baseRPCClient) Genesis() (*ctypes.ResultGenesis, error) {
 	return result, nil
 }
 
-func (c *baseRPCClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+func (c *baseRPCClient) Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
 	result := new(ctypes.ResultBlock)
-	_, err := c.caller.Call("block", map[string]interface{}{"height": height}, result)
+	params := map[string]interface{}{
+		"height":      height,
+		"batch_prove": batchProve,
+	}
+	_, err := c.caller.Call("block", params, result)
 	if err != nil {
 		return nil, errors.Wrap(err, "Block")
 	}
@@ -354,15 +367,16 @@ func (c *baseRPCClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return result, nil
 }
 
-func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
+func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int, orderBy string,
+	batchProve bool) (*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
 	params := map[string]interface{}{
-		"query":    query,
-		"prove":    prove,
-		"page":     page,
-		"per_page": perPage,
-		"order_by": orderBy,
+		"query":       query,
+		"prove":       prove,
+		"page":        page,
+		"per_page":    perPage,
+		"order_by":    orderBy,
+		"batch_prove": batchProve,
 	}
 	_, err := c.caller.Call("tx_search", params, result)
 	if err != nil {
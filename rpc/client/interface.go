@@ -64,12 +64,13 @@ type ABCIClient interface {
 // SignClient groups together the functionality needed to get valid signatures
 // and prove anything about the chain.
 type SignClient interface {
-	Block(height *int64) (*ctypes.ResultBlock, error)
+	Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error)
 	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
 	Commit(height *int64) (*ctypes.ResultCommit, error)
 	Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error)
 	Tx(hash []byte, prove bool) (*ctypes.ResultTx, error)
-	TxSearch(query string, prove bool, page, perPage int, orderBy string) (*ctypes.ResultTxSearch, error)
+	TxSearch(query string, prove bool, page, perPage int, orderBy string,
+		batchProve bool) (*ctypes.ResultTxSearch, error)
 }
 
 // HistoryClient provides access to data from genesis to now in large chunks.
@@ -90,6 +91,7 @@ type NetworkClient interface {
 	DumpConsensusState() (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState() (*ctypes.ResultConsensusState, error)
 	ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, error)
+	ValidatorUptime(address []byte) (*ctypes.ResultValidatorUptime, error)
 	Health() (*ctypes.ResultHealth, error)
 }
 
@@ -120,6 +120,10 @@ func (c *Local) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, e
 	return core.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ValidatorUptime(address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return core.ValidatorUptime(c.ctx, address)
+}
+
 func (c *Local) Health() (*ctypes.ResultHealth, error) {
 	return core.Health(c.ctx)
 }
@@ -140,8 +144,8 @@ func (c *Local) Genesis() (*ctypes.ResultGenesis, error) {
 	return core.Genesis(c.ctx)
 }
 
-func (c *Local) Block(height *int64) (*ctypes.ResultBlock, error) {
-	return core.Block(c.ctx, height)
+func (c *Local) Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
+	return core.Block(c.ctx, height, batchProve)
 }
 
 func (c *Local) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
@@ -160,9 +164,9 @@ func (c *Local) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(c.ctx, hash, prove)
 }
 
-func (c *Local) TxSearch(query string, prove bool, page, perPage int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+func (c *Local) TxSearch(query string, prove bool, page, perPage int, orderBy string,
+	batchProve bool) (*ctypes.ResultTxSearch, error) {
+	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy, batchProve)
 }
 
 func (c *Local) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
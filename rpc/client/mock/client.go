@@ -126,6 +126,10 @@ func (c Client) ConsensusParams(height *int64) (*ctypes.ResultConsensusParams, e
 	return core.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) ValidatorUptime(address []byte) (*ctypes.ResultValidatorUptime, error) {
+	return core.ValidatorUptime(&rpctypes.Context{}, address)
+}
+
 func (c Client) Health() (*ctypes.ResultHealth, error) {
 	return core.Health(&rpctypes.Context{})
 }
@@ -146,8 +150,8 @@ func (c Client) Genesis() (*ctypes.ResultGenesis, error) {
 	return core.Genesis(&rpctypes.Context{})
 }
 
-func (c Client) Block(height *int64) (*ctypes.ResultBlock, error) {
-	return core.Block(&rpctypes.Context{}, height)
+func (c Client) Block(height *int64, batchProve bool) (*ctypes.ResultBlock, error) {
+	return core.Block(&rpctypes.Context{}, height, batchProve)
 }
 
 func (c Client) Commit(height *int64) (*ctypes.ResultCommit, error) {
@@ -172,6 +172,29 @@ func TestGenesisAndValidators(t *testing.T) {
 	}
 }
 
+func TestValidatorUptime(t *testing.T) {
+	for i, c := range GetClients() {
+		err := client.WaitForHeight(c, 3, nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+
+		res, err := c.ValidatorUptime(nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.True(t, res.FromHeight >= 1)
+		assert.True(t, res.ToHeight >= 2)
+		require.Equal(t, 1, len(res.Validators))
+		uptime := res.Validators[0]
+		assert.EqualValues(t, res.ToHeight-res.FromHeight+1, uptime.Signed+uptime.Missed)
+
+		res, err = c.ValidatorUptime(uptime.Address)
+		require.Nil(t, err, "%d: %+v", i, err)
+		require.Equal(t, 1, len(res.Validators))
+		assert.Equal(t, uptime.Address, res.Validators[0].Address)
+
+		_, err = c.ValidatorUptime([]byte("unknown"))
+		assert.Error(t, err)
+	}
+}
+
 func TestABCIQuery(t *testing.T) {
 	for i, c := range GetClients() {
 		// write something
@@ -203,7 +226,7 @@ func TestAppCalls(t *testing.T) {
 
 		// look for the future
 		h := sh + 2
-		_, err = c.Block(&h)
+		_, err = c.Block(&h, false)
 		assert.NotNil(err) // no block yet
 
 		// write something
@@ -232,7 +255,7 @@ func TestAppCalls(t *testing.T) {
 		assert.EqualValues(tx, ptx.Tx)
 
 		// and we can even check the block is added
-		block, err := c.Block(&apph)
+		block, err := c.Block(&apph, false)
 		require.Nil(err, "%d: %+v", i, err)
 		appHash := block.Block.Header.AppHash
 		assert.True(len(appHash) > 0)
@@ -426,7 +449,7 @@ func TestTxSearch(t *testing.T) {
 
 	// since we're not using an isolated test server, we'll have lingering transactions
 	// from other tests as well
-	result, err := c.TxSearch("tx.height >= 0", true, 1, 100, "asc")
+	result, err := c.TxSearch("tx.height >= 0", true, 1, 100, "asc", false)
 	require.NoError(t, err)
 	txCount := len(result.Txs)
 
@@ -438,7 +461,7 @@ func TestTxSearch(t *testing.T) {
 		t.Logf("client %d", i)
 
 		// now we query for the tx.
-		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", find.Hash), true, 1, 30, "asc")
+		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", find.Hash), true, 1, 30, "asc", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 		require.Equal(t, find.Hash, result.Txs[0].Hash)
@@ -456,43 +479,72 @@ func TestTxSearch(t *testing.T) {
 		}
 
 		// query by height
-		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", find.Height), true, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", find.Height), true, 1, 30, "asc", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 
+		// query with a batch proof per block
+		result, err = c.TxSearch("tx.height >= 1", false, 1, 30, "asc", true)
+		require.Nil(t, err)
+		require.NotEmpty(t, result.Proofs)
+		proofs := make(map[int64]types.TxMultiProof)
+		for _, p := range result.Proofs {
+			block, err := c.Block(&p.Height, true)
+			require.NoError(t, err)
+			require.NoError(t, p.Proof.Validate(block.Block.DataHash))
+			proofs[p.Height] = p.Proof
+
+			// the block comes with a proof of all its txs
+			require.NotNil(t, block.TxsProof)
+			require.NoError(t, block.TxsProof.Validate(block.Block.DataHash))
+			assert.Equal(t, block.Block.Data.Txs, block.TxsProof.Data)
+		}
+		for _, tx := range result.Txs {
+			require.Contains(t, proofs, tx.Height)
+			assert.Equal(t, int(tx.Index), proofs[tx.Height].Index(tx.Tx))
+		}
+
+		// and proofs no tx was left out of a block
+		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", find.Height), false, 1, 30, "asc", true)
+		require.Nil(t, err)
+		require.Len(t, result.IndexProofs, 1)
+		hashes, err := result.IndexProofs[0].Verify(result.IndexProofs[0].RootHash)
+		require.NoError(t, err)
+		assert.Len(t, hashes, result.TotalCount)
+
 		// query for non existing tx
-		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30, "asc", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
 		// query using a compositeKey (see kvstore application)
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "asc", false)
 		require.Nil(t, err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query using a compositeKey (see kvstore application) and height
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30, "asc", false)
 		require.Nil(t, err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query a non existing tx with page 1 and txsPerPage 1
-		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1, "asc")
+		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1, "asc", false)
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
 		// check sorting
-		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "asc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "asc", false)
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.LessOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
 			require.LessOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
 		}
 
-		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "desc")
+		result, err = c.TxSearch(fmt.Sprintf("tx.height >= 1"), false, 1, 30, "desc", false)
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.GreaterOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
@@ -507,7 +559,7 @@ func TestTxSearch(t *testing.T) {
 			pages     = int(math.Ceil(float64(txCount) / float64(perPage)))
 		)
 		for page := 1; page <= pages; page++ {
-			result, err = c.TxSearch("tx.height >= 1", false, page, perPage, "asc")
+			result, err = c.TxSearch("tx.height >= 1", false, page, perPage, "asc", false)
 			require.NoError(t, err)
 			if page < pages {
 				require.Len(t, result.Txs, perPage)
@@ -68,7 +68,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 // Block gets block at a given height.
 // If no height is provided, it will fetch the latest block.
 // More: https://docs.tendermint.com/master/rpc/#/Info/block
-func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error) {
+func Block(ctx *rpctypes.Context, heightPtr *int64, batchProve bool) (*ctypes.ResultBlock, error) {
 	storeHeight := blockStore.Height()
 	height, err := getHeight(storeHeight, heightPtr)
 	if err != nil {
@@ -76,11 +76,20 @@ func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error)
 	}
 
 	block := blockStore.LoadBlock(height)
+	var txsProof *types.TxMultiProof
+	if batchProve && block != nil && len(block.Data.Txs) > 0 {
+		indices := make([]int, len(block.Data.Txs))
+		for i := range indices {
+			indices[i] = i
+		}
+		proof := block.Data.Txs.MultiProof(indices)
+		txsProof = &proof
+	}
 	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
-		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}, nil
+		return &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block, TxsProof: txsProof}, nil
 	}
-	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
+	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block, TxsProof: txsProof}, nil
 }
 
 // BlockByHash gets block by hash.
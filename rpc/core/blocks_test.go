@@ -120,3 +120,5 @@ func (mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { retur
 func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (mockBlockStore) LoadExtendedCommit(height int64) *types.ExtendedCommit { return nil }
+func (mockBlockStore) SaveExtendedCommit(extCommit *types.ExtendedCommit)    {}
@@ -1,6 +1,10 @@
 package core
 
 import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
 	cm "github.com/tendermint/tendermint/consensus"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -44,6 +48,30 @@ func Validators(ctx *rpctypes.Context, heightPtr *int64, page, perPage int) (*ct
 		Validators:  v}, nil
 }
 
+// ValidatorUptime gets the number of blocks signed and missed by each
+// validator over the last instrumentation.uptime_window heights, with the
+// ranges of the heights missed. If an address is provided, only the uptime of
+// this validator is returned.
+// More: https://docs.tendermint.com/master/rpc/#/Info/validator_uptime
+func ValidatorUptime(ctx *rpctypes.Context, address []byte) (*ctypes.ResultValidatorUptime, error) {
+	if uptimeTracker == nil {
+		return nil, errors.New("validator uptime is disabled (see instrumentation.uptime_window)")
+	}
+
+	from, to := uptimeTracker.Window()
+	result := &ctypes.ResultValidatorUptime{FromHeight: from, ToHeight: to}
+	if len(address) == 0 {
+		result.Validators = uptimeTracker.Uptimes()
+		return result, nil
+	}
+	uptime, ok := uptimeTracker.Uptime(address)
+	if !ok {
+		return nil, fmt.Errorf("%X was not a validator from height %d to %d", address, from, to)
+	}
+	result.Validators = []sm.ValidatorUptime{uptime}
+	return result, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.tendermint.com/master/rpc/#/Info/dump_consensus_state
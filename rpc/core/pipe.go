@@ -68,8 +68,10 @@ var (
 
 	// objects
 	pubKey           crypto.PubKey
+	privValAddress   types.Address
 	genDoc           *types.GenesisDoc // cache the genesis structure
 	txIndexer        txindex.TxIndexer
+	uptimeTracker    *sm.UptimeTracker
 	consensusReactor *consensus.Reactor
 	eventBus         *types.EventBus // thread safe
 	mempool          mempl.Mempool
@@ -111,6 +113,12 @@ func SetPubKey(pk crypto.PubKey) {
 	pubKey = pk
 }
 
+// SetPrivValidatorAddress sets the address of the validator, which is not the
+// address of its key if it rotated it.
+func SetPrivValidatorAddress(addr types.Address) {
+	privValAddress = addr
+}
+
 func SetGenesisDoc(doc *types.GenesisDoc) {
 	genDoc = doc
 }
@@ -123,6 +131,10 @@ func SetTxIndexer(indexer txindex.TxIndexer) {
 	txIndexer = indexer
 }
 
+func SetUptimeTracker(tracker *sm.UptimeTracker) {
+	uptimeTracker = tracker
+}
+
 func SetConsensusReactor(conR *consensus.Reactor) {
 	consensusReactor = conR
 }
@@ -53,7 +53,7 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			CatchingUp:        consensusReactor.FastSync(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
-			Address:     pubKey.Address(),
+			Address:     privValAddress,
 			PubKey:      pubKey,
 			VotingPower: votingPower,
 		},
@@ -63,8 +63,6 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 }
 
 func validatorAtHeight(h int64) *types.Validator {
-	privValAddress := pubKey.Address()
-
 	// If we're still at height h, search in the current validator set.
 	lastBlockHeight, vals := consensusState.GetValidators()
 	if lastBlockHeight == h {
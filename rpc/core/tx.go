@@ -10,6 +10,7 @@ import (
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
@@ -53,10 +54,14 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 }
 
 // TxSearch allows you to query for multiple transactions results. It returns a
-// list of transactions (maximum ?per_page entries) and the total count.
+// list of transactions (maximum ?per_page entries) and the total count. With
+// ?batch_prove, it also returns a single proof of the inclusion of the
+// transactions of each block in the page and, if the query is on a single
+// block and the indexer supports it, proofs that no matching transaction was
+// left out.
 // More: https://docs.tendermint.com/master/rpc/#/Info/tx_search
-func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy string) (
-	*ctypes.ResultTxSearch, error) {
+func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int, orderBy string,
+	batchProve bool) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := txIndexer.(*null.TxIndex); ok {
 		return nil, errors.New("transaction indexing is disabled")
@@ -122,5 +127,52 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int
 		})
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	var (
+		batchProofs []ctypes.TxBatchProof
+		indexProofs []*txindex.RangeProof
+	)
+	if batchProve {
+		batchProofs, err = txBatchProofs(apiResults)
+		if err != nil {
+			return nil, err
+		}
+		if prover, ok := txIndexer.(txindex.SearchProver); ok {
+			indexProofs, err = prover.ProveSearch(q)
+			if err != nil && err != txindex.ErrUnprovableQuery && err != txindex.ErrNoIndexProof {
+				return nil, err
+			}
+		}
+	}
+
+	return &ctypes.ResultTxSearch{
+		Txs:         apiResults,
+		TotalCount:  totalCount,
+		Proofs:      batchProofs,
+		IndexProofs: indexProofs,
+	}, nil
+}
+
+// txBatchProofs returns a proof of the inclusion of the given txs per block.
+// The txs must be sorted by height.
+func txBatchProofs(txs []*ctypes.ResultTx) ([]ctypes.TxBatchProof, error) {
+	proofs := make([]ctypes.TxBatchProof, 0)
+	for start := 0; start < len(txs); {
+		height := txs[start].Height
+		end := start
+		indices := make([]int, 0)
+		for ; end < len(txs) && txs[end].Height == height; end++ {
+			indices = append(indices, int(txs[end].Index)) // XXX: overflow on 32-bit machines
+		}
+
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return nil, fmt.Errorf("block %d of tx %X not found", height, txs[start].Hash)
+		}
+		proofs = append(proofs, ctypes.TxBatchProof{
+			Height: height,
+			Proof:  block.Data.Txs.MultiProof(indices),
+		})
+		start = end
+	}
+	return proofs, nil
 }
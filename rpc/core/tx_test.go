@@ -0,0 +1,18 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTxBatchProofsMissingBlock(t *testing.T) {
+	// The block of the tx was pruned.
+	blockStore = mockBlockStore{height: 100}
+	tx := types.Tx("a=1")
+	_, err := txBatchProofs([]*ctypes.ResultTx{{Hash: tx.Hash(), Height: 10, Tx: tx}})
+	assert.Error(t, err)
+}
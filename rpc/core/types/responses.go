@@ -9,6 +9,8 @@ import (
 	"github.com/tendermint/tendermint/libs/bytes"
 
 	"github.com/tendermint/tendermint/p2p"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -27,6 +29,9 @@ type ResultGenesis struct {
 type ResultBlock struct {
 	BlockID types.BlockID `json:"block_id"`
 	Block   *types.Block  `json:"block"`
+	// Proof of the inclusion of all the txs of the block, if requested and
+	// the block has txs.
+	TxsProof *types.TxMultiProof `json:"txs_proof,omitempty"`
 }
 
 // Commit and Header
@@ -122,6 +127,14 @@ type ResultValidators struct {
 	Validators  []*types.Validator `json:"validators"`
 }
 
+// Signed and missed blocks of validators over the heights from FromHeight to
+// ToHeight
+type ResultValidatorUptime struct {
+	FromHeight int64                `json:"from_height"`
+	ToHeight   int64                `json:"to_height"`
+	Validators []sm.ValidatorUptime `json:"validators"`
+}
+
 // ConsensusParams for given height
 type ResultConsensusParams struct {
 	BlockHeight     int64                 `json:"block_height"`
@@ -175,8 +188,18 @@ type ResultTx struct {
 
 // Result of searching for txs
 type ResultTxSearch struct {
-	Txs        []*ResultTx `json:"txs"`
-	TotalCount int         `json:"total_count"`
+	Txs        []*ResultTx    `json:"txs"`
+	TotalCount int            `json:"total_count"`
+	Proofs     []TxBatchProof `json:"proofs,omitempty"`
+	// Proofs that the txs found are all the matching ones, if the query can
+	// be proven: one per condition, in order.
+	IndexProofs []*txindex.RangeProof `json:"index_proofs,omitempty"`
+}
+
+// TxBatchProof proves the inclusion of the txs found in the block at Height.
+type TxBatchProof struct {
+	Height int64              `json:"height"`
+	Proof  types.TxMultiProof `json:"proof"`
 }
 
 // List of mempool txs
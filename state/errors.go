@@ -43,6 +43,19 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrInvalidPreparedProposal struct {
+		Reason string
+	}
+
+	ErrInvalidVoteExtension struct {
+		Height  int64
+		Address []byte
+	}
+
+	ErrHalted struct {
+		Height int64
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -89,3 +102,15 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("Could not find results for height #%d", e.Height)
 }
+
+func (e ErrInvalidPreparedProposal) Error() string {
+	return fmt.Sprintf("App returned invalid txs from PrepareProposal: %s", e.Reason)
+}
+
+func (e ErrInvalidVoteExtension) Error() string {
+	return fmt.Sprintf("App rejected vote extension from %X at height #%d", e.Address, e.Height)
+}
+
+func (e ErrHalted) Error() string {
+	return fmt.Sprintf("Halted at height #%d, no more blocks can be applied", e.Height)
+}
@@ -2,8 +2,11 @@ package state
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/fail"
 	"github.com/tendermint/tendermint/libs/log"
@@ -37,6 +40,22 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// execute blocks in the background, deferring their results by one height.
+	// pending is the block being executed, if any.
+	pipelined  bool
+	pendingMtx sync.Mutex
+	pending    *pendingBlock
+
+	// stop applying blocks after the one at haltHeight, or the first one at
+	// or after haltTime. haltCh is closed once it's committed.
+	haltHeight int64
+	haltTime   time.Time
+	haltOnce   sync.Once
+	haltCh     chan struct{}
+
+	// records the signatures of the LastCommit of each block applied.
+	uptime *UptimeTracker
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -47,6 +66,48 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithPipelinedExecution makes ApplyBlock execute the block in
+// the background, so consensus can proceed on the next height while the app
+// is still busy. The app hash and results of block H are then included in
+// block H+2 instead of H+1. All validators of a chain must agree on this
+// setting. The calls made on the app for the next height (PrepareProposal,
+// ProcessProposal, ExtendVote and VerifyVoteExtension) don't wait for the
+// block to be committed: the ABCI client sends the requests of the consensus
+// connection in order, so they are interleaved with those of the block.
+func BlockExecutorWithPipelinedExecution() BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.pipelined = true
+	}
+}
+
+// BlockExecutorWithHalt makes ApplyBlock refuse blocks after the one at
+// height, or the first one with a time at or after t. Zero values are
+// ignored.
+func BlockExecutorWithHalt(height int64, t time.Time) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.haltHeight = height
+		blockExec.haltTime = t
+	}
+}
+
+// BlockExecutorWithUptimeTracker makes ApplyBlock record the LastCommit of
+// each block to the given tracker.
+func BlockExecutorWithUptimeTracker(uptime *UptimeTracker) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.uptime = uptime
+	}
+}
+
+// pendingBlock is a block being executed in the background.
+// abciResponses, appHash and err are set once done is closed.
+type pendingBlock struct {
+	block         *types.Block
+	done          chan struct{}
+	abciResponses *ABCIResponses
+	appHash       []byte
+	err           error
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -65,6 +126,7 @@ func NewBlockExecutor(
 		evpool:   evpool,
 		logger:   logger,
 		metrics:  NopMetrics(),
+		haltCh:   make(chan struct{}),
 	}
 
 	for _, option := range options {
@@ -78,6 +140,24 @@ func (blockExec *BlockExecutor) DB() dbm.DB {
 	return blockExec.db
 }
 
+// IsHalted returns true if state is at or past the halt height or time, so no
+// more blocks can be applied on top of it.
+func (blockExec *BlockExecutor) IsHalted(state State) bool {
+	if state.LastBlockHeight == 0 {
+		return false
+	}
+	if blockExec.haltHeight > 0 && state.LastBlockHeight >= blockExec.haltHeight {
+		return true
+	}
+	return !blockExec.haltTime.IsZero() && !state.LastBlockTime.Before(blockExec.haltTime)
+}
+
+// Halted returns a channel which is closed once ApplyBlock committed the last
+// block before the halt height or time.
+func (blockExec *BlockExecutor) Halted() <-chan struct{} {
+	return blockExec.haltCh
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -88,11 +168,18 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
+//
+// The reaped txs are passed to the app via PrepareProposal, which may reorder,
+// drop or add txs. The txs it returns must still fit in the block's data, or
+// an error is returned. voteExtensions are the extensions of the precommits in
+// commit, indexed by validator index (see types.VoteSet#VoteExtensions). They
+// are passed along to the app and may be nil.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
 	proposerAddr []byte,
-) (*types.Block, *types.PartSet) {
+	voteExtensions [][]byte,
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	maxGas := state.ConsensusParams.Block.MaxGas
@@ -104,8 +191,88 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
+	if pending := blockExec.getPending(); pending != nil {
+		// The mempool is only updated once the pending block is committed.
+		txs = excludeTxs(txs, pending.block.Txs)
+	}
+
+	// Let the app decide which txs go into the block
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		Height:          height,
+		ProposerAddress: proposerAddr,
+		MaxTxBytes:      maxDataBytes,
+		MaxGas:          maxGas,
+		Txs:             txs.ToSliceOfBytes(),
+		VoteExtensions:  extendedVoteInfos(state.LastValidators, voteExtensions),
+	})
+	if err != nil {
+		return nil, nil, ErrProxyAppConn(err)
+	}
+	txs = types.ToTxs(res.Txs)
+	if size := txs.TotalAminoSize(); size > maxDataBytes {
+		return nil, nil, ErrInvalidPreparedProposal{
+			Reason: fmt.Sprintf("txs take up %d bytes, max is %d", size, maxDataBytes),
+		}
+	}
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	block, parts := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	return block, parts, nil
+}
+
+// ProcessProposal asks the app whether the given (complete) proposal block is
+// acceptable. It returns false if the app rejected it. The block is expected
+// to have been validated with ValidateBlock beforehand.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block) (bool, error) {
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:   block.Hash(),
+		Header: types.TM2PB.Header(&block.Header),
+		Txs:    block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return false, ErrProxyAppConn(err)
+	}
+	if res.IsStatusUnknown() {
+		return false, fmt.Errorf("app returned unknown status from ProcessProposal")
+	}
+	return res.IsAccepted(), nil
+}
+
+// ExtendVote asks the app for the extension to attach to our precommit for the
+// given vote's block.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+		Round:  int32(vote.Round),
+	})
+	if err != nil {
+		return nil, ErrProxyAppConn(err)
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the app whether the extension of a precommit
+// received from another validator is valid. It returns an error if the app
+// rejected it. The extension signature is expected to have been checked
+// beforehand.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		Round:            int32(vote.Round),
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return ErrProxyAppConn(err)
+	}
+	if res.IsStatusUnknown() {
+		return fmt.Errorf("app returned unknown status from VerifyVoteExtension")
+	}
+	if !res.IsAccepted() {
+		return ErrInvalidVoteExtension{Height: vote.Height, Address: vote.ValidatorAddress}
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -121,16 +288,46 @@ func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) e
 // It's the only function that needs to be called
 // from outside this package to process and commit an entire block.
 // It takes a blockID to avoid recomputing the parts hash.
+// Once the halt height or time is reached, it closes Halted and refuses any
+// further block with ErrHalted.
 func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, block *types.Block) (State, error) {
+	if blockExec.IsHalted(state) {
+		return state, ErrHalted{Height: state.LastBlockHeight}
+	}
+
+	state, err := blockExec.applyBlock(state, blockID, block)
+	if err != nil || !blockExec.IsHalted(state) {
+		return state, err
+	}
 
+	// Don't halt with the block still executing in the background.
+	if err := blockExec.WaitPendingBlock(); err != nil {
+		return state, err
+	}
+	blockExec.logger.Info("Reached the halt height or time, halting",
+		"height", state.LastBlockHeight, "time", state.LastBlockTime)
+	blockExec.haltOnce.Do(func() { close(blockExec.haltCh) })
+	return state, nil
+}
+
+func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block) (State, error) {
 	if err := blockExec.ValidateBlock(state, block); err != nil {
 		return state, ErrInvalidBlock(err)
 	}
 
+	blockExec.uptime.Record(block.Height-1, block.LastCommit, state.LastValidators)
+
+	if blockExec.pipelined {
+		return blockExec.applyBlockPipelined(state, blockID, block)
+	}
+
 	startTime := time.Now().UnixNano()
 	abciResponses, err := execBlockOnProxyApp(blockExec.logger, blockExec.proxyApp, block, blockExec.db)
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	if errors.Cause(err) == proxy.ErrAppConnReset {
+		return blockExec.recoverBlock(state, block)
+	}
 	if err != nil {
 		return state, ErrProxyAppConn(err)
 	}
@@ -142,28 +339,24 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 
 	fail.Fail() // XXX
 
-	// validate the validator updates and convert to tendermint types
-	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
-	err = validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
-	if err != nil {
-		return state, fmt.Errorf("error in validator updates: %v", err)
-	}
-	validatorUpdates, err := types.PB2TM.ValidatorUpdates(abciValUpdates)
+	validatorUpdates, err := blockExec.validatorUpdates(state, abciResponses)
 	if err != nil {
 		return state, err
 	}
-	if len(validatorUpdates) > 0 {
-		blockExec.logger.Info("Updates to validators", "updates", types.ValidatorListString(validatorUpdates))
-	}
 
 	// Update the state with the block and responses.
-	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates)
+	rotations := keyRotations(block.Evidence.Evidence)
+	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates, rotations)
 	if err != nil {
 		return state, fmt.Errorf("commit failed for application: %v", err)
 	}
+	saveKeyRotationHeights(blockExec.db, block.Height, rotations)
 
 	// Lock mempool, commit app state, update mempoool.
 	appHash, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
+	if errors.Cause(err) == proxy.ErrAppConnReset {
+		return blockExec.recoverBlock(state, block)
+	}
 	if err != nil {
 		return state, fmt.Errorf("commit failed for application: %v", err)
 	}
@@ -186,6 +379,225 @@ func (blockExec *BlockExecutor) ApplyBlock(state State, blockID types.BlockID, b
 	return state, nil
 }
 
+// applyBlockPipelined updates the state with the block and the responses of
+// the previous one, waiting for it to be executed if needed, saves the state
+// and starts executing the block in the background.
+func (blockExec *BlockExecutor) applyBlockPipelined(
+	state State,
+	blockID types.BlockID,
+	block *types.Block,
+) (State, error) {
+	last := blockExec.getPending()
+	abciResponses, appHash, err := blockExec.lastResponses(state)
+	if errors.Cause(err) == proxy.ErrAppConnReset && last != nil {
+		// The block was replayed by the reconnection handshake, see
+		// recoverBlock.
+		if err := blockExec.updateMempool(state, last.block); err != nil {
+			return state, err
+		}
+		if newState := LoadState(blockExec.db); newState.LastBlockHeight == block.Height {
+			// So was this one, as it was already in the store.
+			return blockExec.recoverBlock(state, block)
+		}
+		abciResponses, appHash, err = blockExec.lastResponses(state)
+	}
+	if err != nil {
+		return state, err
+	}
+
+	validatorUpdates, err := blockExec.validatorUpdates(state, abciResponses)
+	if err != nil {
+		return state, err
+	}
+
+	// Update the state with the block and the previous block's responses.
+	rotations := keyRotations(block.Evidence.Evidence)
+	state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates, rotations)
+	if err != nil {
+		return state, fmt.Errorf("commit failed for application: %v", err)
+	}
+	saveKeyRotationHeights(blockExec.db, block.Height, rotations)
+	state.AppHash = appHash
+
+	// Update evpool with the block and state.
+	blockExec.evpool.Update(block, state)
+
+	fail.Fail() // XXX
+
+	// Save the state before executing the block, so that on restart the app is
+	// never more than one block ahead of it.
+	SaveState(blockExec.db, state)
+
+	fail.Fail() // XXX
+
+	pending := &pendingBlock{block: block, done: make(chan struct{})}
+	go func() {
+		defer close(pending.done)
+		pending.abciResponses, pending.appHash, pending.err = blockExec.execCommitBlock(state, block)
+	}()
+	blockExec.setPending(pending)
+
+	return state, nil
+}
+
+// execCommitBlock executes and commits the block, saving its responses and the
+// resulting app hash, and fires the relevant events.
+func (blockExec *BlockExecutor) execCommitBlock(
+	state State,
+	block *types.Block,
+) (*ABCIResponses, []byte, error) {
+	startTime := time.Now().UnixNano()
+	abciResponses, err := execBlockOnProxyApp(blockExec.logger, blockExec.proxyApp, block, blockExec.db)
+	endTime := time.Now().UnixNano()
+	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	if errors.Cause(err) == proxy.ErrAppConnReset {
+		return nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, ErrProxyAppConn(err)
+	}
+
+	fail.Fail() // XXX
+
+	// Save the results before we commit.
+	SaveABCIResponses(blockExec.db, block.Height, abciResponses)
+
+	fail.Fail() // XXX
+
+	validatorUpdates, err := blockExec.validatorUpdates(state, abciResponses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Lock mempool, commit app state, update mempoool.
+	appHash, err := blockExec.Commit(state, block, abciResponses.DeliverTxs)
+	if errors.Cause(err) == proxy.ErrAppConnReset {
+		return nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("commit failed for application: %v", err)
+	}
+
+	fail.Fail() // XXX
+
+	// Save the app hash, as it only goes into the state once the next block
+	// is applied.
+	SaveAppHash(blockExec.db, block.Height, appHash)
+
+	fireEvents(blockExec.logger, blockExec.eventBus, block, abciResponses, validatorUpdates)
+
+	return abciResponses, appHash, nil
+}
+
+// recoverBlock is called when the connection to the app was reset while block
+// was being applied. By then, the app was reconnected and the handshake (see
+// proxy.ReconnectHandler) replayed the block, as it's already in the store.
+// It returns the resulting state and updates what the replay didn't.
+func (blockExec *BlockExecutor) recoverBlock(state State, block *types.Block) (State, error) {
+	newState := LoadState(blockExec.db)
+	if newState.LastBlockHeight != block.Height {
+		return state, ErrProxyAppConn(fmt.Errorf(
+			"connection to the app was reset and block %d wasn't replayed (state is at %d)",
+			block.Height, newState.LastBlockHeight))
+	}
+	blockExec.logger.Info("Block was replayed after reconnecting to the app", "height", block.Height)
+
+	if err := blockExec.updateMempool(newState, block); err != nil {
+		return state, err
+	}
+	blockExec.evpool.Update(block, newState)
+	return newState, nil
+}
+
+// updateMempool updates the mempool with a block which was executed without
+// it, using the responses saved in the db.
+func (blockExec *BlockExecutor) updateMempool(state State, block *types.Block) error {
+	abciResponses, err := LoadABCIResponses(blockExec.db, block.Height)
+	if err != nil {
+		return err
+	}
+
+	blockExec.mempool.Lock()
+	defer blockExec.mempool.Unlock()
+	if err := blockExec.mempool.FlushAppConn(); err != nil {
+		return err
+	}
+	return blockExec.mempool.Update(
+		block.Height,
+		block.Txs,
+		abciResponses.DeliverTxs,
+		TxPreCheck(state),
+		TxPostCheck(state),
+	)
+}
+
+// lastResponses returns the responses and app hash of the last block in
+// state, waiting for it to be executed if needed.
+func (blockExec *BlockExecutor) lastResponses(state State) (*ABCIResponses, []byte, error) {
+	pending := blockExec.getPending()
+	if err := blockExec.WaitPendingBlock(); err != nil {
+		return nil, nil, err
+	}
+	if pending != nil && pending.block.Height == state.LastBlockHeight {
+		return pending.abciResponses, pending.appHash, nil
+	}
+	if state.LastBlockHeight == 0 {
+		return &ABCIResponses{EndBlock: &abci.ResponseEndBlock{}}, state.AppHash, nil
+	}
+	// No block is being executed (ie. after a restart), so load them from the db.
+	abciResponses, err := LoadABCIResponses(blockExec.db, state.LastBlockHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	return abciResponses, LoadAppHash(blockExec.db, state.LastBlockHeight), nil
+}
+
+// WaitPendingBlock waits for the block being executed in the background, if
+// any, and returns the error it failed with. It's a no-op unless pipelined
+// execution is enabled.
+func (blockExec *BlockExecutor) WaitPendingBlock() error {
+	pending := blockExec.getPending()
+	if pending == nil {
+		return nil
+	}
+	<-pending.done
+	blockExec.setPending(nil)
+	return pending.err
+}
+
+func (blockExec *BlockExecutor) getPending() *pendingBlock {
+	blockExec.pendingMtx.Lock()
+	defer blockExec.pendingMtx.Unlock()
+	return blockExec.pending
+}
+
+func (blockExec *BlockExecutor) setPending(pending *pendingBlock) {
+	blockExec.pendingMtx.Lock()
+	defer blockExec.pendingMtx.Unlock()
+	blockExec.pending = pending
+}
+
+// validatorUpdates validates the validator updates in abciResponses and
+// converts them to tendermint types.
+func (blockExec *BlockExecutor) validatorUpdates(
+	state State,
+	abciResponses *ABCIResponses,
+) ([]*types.Validator, error) {
+	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
+	err := validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("error in validator updates: %v", err)
+	}
+	validatorUpdates, err := types.PB2TM.ValidatorUpdates(abciValUpdates)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorUpdates) > 0 {
+		blockExec.logger.Info("Updates to validators", "updates", types.ValidatorListString(validatorUpdates))
+	}
+	return validatorUpdates, nil
+}
+
 // Commit locks the mempool, runs the ABCI Commit message, and updates the
 // mempool.
 // It returns the result of calling abci.Commit (the AppHash), and an error.
@@ -291,13 +703,21 @@ func execBlockOnProxyApp(
 	// Run txs of block.
 	for _, tx := range block.Txs {
 		proxyAppConn.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx})
-		if err := proxyAppConn.Error(); err != nil {
-			return nil, err
+		if proxyAppConn.Error() != nil {
+			break
 		}
 	}
 
 	// End block.
 	abciResponses.EndBlock, err = proxyAppConn.EndBlockSync(abci.RequestEndBlock{Height: block.Height})
+	if err == nil {
+		// EndBlock may still succeed after the connection broke (ie. with a
+		// stopped local client), leaving txs without a response.
+		err = proxyAppConn.Error()
+	}
+	if err == nil && txIndex != len(block.Txs) {
+		err = fmt.Errorf("got responses for %d txs out of %d", txIndex, len(block.Txs))
+	}
 	if err != nil {
 		logger.Error("Error in proxyAppConn.EndBlock", "err", err)
 		return nil, err
@@ -308,6 +728,47 @@ func execBlockOnProxyApp(
 	return abciResponses, nil
 }
 
+// excludeTxs returns the txs that are not in excluded.
+func excludeTxs(txs, excluded types.Txs) types.Txs {
+	if len(excluded) == 0 {
+		return txs
+	}
+	skip := make(map[string]struct{}, len(excluded))
+	for _, tx := range excluded {
+		skip[string(tx)] = struct{}{}
+	}
+	res := make(types.Txs, 0, len(txs))
+	for _, tx := range txs {
+		if _, ok := skip[string(tx)]; !ok {
+			res = append(res, tx)
+		}
+	}
+	return res
+}
+
+// extendedVoteInfos pairs each vote extension with the validator that produced
+// it. Validators without an extension are left out.
+func extendedVoteInfos(valSet *types.ValidatorSet, voteExtensions [][]byte) []abci.ExtendedVoteInfo {
+	if valSet == nil {
+		return nil
+	}
+	var infos []abci.ExtendedVoteInfo
+	for i, ext := range voteExtensions {
+		if len(ext) == 0 {
+			continue
+		}
+		_, val := valSet.GetByIndex(i)
+		if val == nil {
+			continue
+		}
+		infos = append(infos, abci.ExtendedVoteInfo{
+			Validator:     types.TM2PB.Validator(val),
+			VoteExtension: ext,
+		})
+	}
+	return infos
+}
+
 func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCommitInfo, []abci.Evidence) {
 	voteInfos := make([]abci.VoteInfo, block.LastCommit.Size())
 	// block.Height=1 -> LastCommitInfo.Votes are empty.
@@ -327,20 +788,23 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 		)
 		if commitSize != valSetLen {
 			panic(fmt.Sprintf("commit size (%d) doesn't match valset length (%d) at height %d\n\n%v\n\n%v",
-				commitSize, valSetLen, block.Height, block.LastCommit.Signatures, lastValSet.Validators))
+				commitSize, valSetLen, block.Height, block.LastCommit, lastValSet.Validators))
 		}
 
 		for i, val := range lastValSet.Validators {
-			commitSig := block.LastCommit.Signatures[i]
 			voteInfos[i] = abci.VoteInfo{
 				Validator:       types.TM2PB.Validator(val),
-				SignedLastBlock: !commitSig.Absent(),
+				SignedLastBlock: block.LastCommit.BlockIDFlag(i) != types.BlockIDFlagAbsent,
 			}
 		}
 	}
 
-	byzVals := make([]abci.Evidence, len(block.Evidence.Evidence))
-	for i, ev := range block.Evidence.Evidence {
+	byzVals := make([]abci.Evidence, 0, len(block.Evidence.Evidence))
+	for _, ev := range block.Evidence.Evidence {
+		// Key rotations are not misbehaviour.
+		if _, ok := ev.(*types.KeyRotation); ok {
+			continue
+		}
 		// We need the validator set. We already did this in validateBlock.
 		// TODO: Should we instead cache the valset in the evidence itself and add
 		// `SetValidatorSet()` and `ToABCI` methods ?
@@ -348,7 +812,7 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 		if err != nil {
 			panic(err)
 		}
-		byzVals[i] = types.TM2PB.Evidence(ev, valset, block.Time)
+		byzVals = append(byzVals, types.TM2PB.Evidence(ev, valset, block.Time))
 	}
 
 	return abci.LastCommitInfo{
@@ -357,6 +821,17 @@ func getBeginBlockValidatorInfo(block *types.Block, stateDB dbm.DB) (abci.LastCo
 	}, byzVals
 }
 
+// keyRotations returns the key rotations among the evidence of a block.
+func keyRotations(evidence types.EvidenceList) []*types.KeyRotation {
+	var rotations []*types.KeyRotation
+	for _, ev := range evidence {
+		if rot, ok := ev.(*types.KeyRotation); ok {
+			rotations = append(rotations, rot)
+		}
+	}
+	return rotations
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params types.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
@@ -385,14 +860,27 @@ func updateState(
 	header *types.Header,
 	abciResponses *ABCIResponses,
 	validatorUpdates []*types.Validator,
+	keyRotations []*types.KeyRotation,
 ) (State, error) {
 
 	// Copy the valset so we can apply changes from EndBlock
 	// and update s.LastValidators and s.Validators.
 	nValSet := state.NextValidators.Copy()
 
-	// Update the validator set with the latest abciResponses.
 	lastHeightValsChanged := state.LastHeightValidatorsChanged
+
+	// Rotate the keys first, so the validator updates of the app can refer to
+	// the validators by either key. The rotations were verified in
+	// validateBlock.
+	for _, rot := range keyRotations {
+		if err := nValSet.RotateKey(rot.ValidatorAddress, rot.NewPubKey); err != nil {
+			return state, fmt.Errorf("error rotating validator key: %v", err)
+		}
+		// Like the validator updates, applies to the next next height.
+		lastHeightValsChanged = header.Height + 1 + 1
+	}
+
+	// Update the validator set with the latest abciResponses.
 	if len(validatorUpdates) > 0 {
 		err := nValSet.UpdateWithChangeSet(validatorUpdates)
 		if err != nil {
@@ -503,3 +991,27 @@ func ExecCommitBlock(
 	// ResponseCommit has no error or log, just data
 	return res.Data, nil
 }
+
+// ExecCommitBlockAndSaveResponses is like ExecCommitBlock, but also saves the
+// ABCI responses and the resulting app hash, as needed to apply the next block
+// with pipelined execution.
+func ExecCommitBlockAndSaveResponses(
+	appConnConsensus proxy.AppConnConsensus,
+	block *types.Block,
+	logger log.Logger,
+	stateDB dbm.DB,
+) ([]byte, error) {
+	abciResponses, err := execBlockOnProxyApp(logger, appConnConsensus, block, stateDB)
+	if err != nil {
+		logger.Error("Error executing block on proxy app", "height", block.Height, "err", err)
+		return nil, err
+	}
+	SaveABCIResponses(stateDB, block.Height, abciResponses)
+	res, err := appConnConsensus.CommitSync()
+	if err != nil {
+		logger.Error("Client error during proxyAppConn.CommitSync", "err", res)
+		return nil, err
+	}
+	SaveAppHash(stateDB, block.Height, res.Data)
+	return res.Data, nil
+}
@@ -2,16 +2,21 @@ package state_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
+	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/mock"
 	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
@@ -47,6 +52,114 @@ func TestApplyBlock(t *testing.T) {
 	// TODO check state and mempool
 }
 
+// stoppingApp stops the connections to it on the given DeliverTx.
+type stoppingApp struct {
+	*kvstore.Application
+	stopAt   int
+	txs      int
+	proxyApp proxy.AppConns
+}
+
+func (app *stoppingApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.txs++
+	if app.txs == app.stopAt {
+		app.proxyApp.Stop()
+	}
+	return app.Application.DeliverTx(req)
+}
+
+// TestApplyBlockAppStopped ensures a block fails if the app is stopped before
+// all its txs are delivered.
+func TestApplyBlockAppStopped(t *testing.T) {
+	app := &stoppingApp{Application: kvstore.NewApplication(), stopAt: 3}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	app.proxyApp = proxyApp
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	block := makeBlock(state, 1)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, sm.LoadState(stateDB).LastBlockHeight)
+}
+
+// TestApplyBlockKeyRotation ensures a key rotation takes effect at the next
+// next height, and is not reported to the app as misbehaviour.
+func TestApplyBlockKeyRotation(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(2, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	oldKey := ed25519.GenPrivKeyFromSecret([]byte("test0"))
+	otherKey := ed25519.GenPrivKeyFromSecret([]byte("test1"))
+	addr := oldKey.PubKey().Address()
+	rotate := func(newKey crypto.PrivKey) *types.KeyRotation {
+		rot := &types.KeyRotation{
+			ValidatorAddress: addr,
+			SignedHeight:     1,
+			Timestamp:        tmtime.Now(),
+			NewPubKey:        newKey.PubKey(),
+		}
+		rot.OldSignature, err = oldKey.Sign(rot.SignBytes(chainID))
+		require.NoError(t, err)
+		rot.NewSignature, err = newKey.Sign(rot.SignBytes(chainID))
+		require.NoError(t, err)
+		return rot
+	}
+	makeBlockWithRotations := func(rotations ...types.Evidence) (*types.Block, types.BlockID) {
+		block, _ := state.MakeBlock(1, makeTxs(1), new(types.Commit), rotations,
+			state.Validators.GetProposer().Address)
+		return block, types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	}
+
+	// The new key must not be the key of another validator, and the key can
+	// only be rotated once per block.
+	block, blockID := makeBlockWithRotations(rotate(otherKey))
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	assert.Error(t, err)
+	block, blockID = makeBlockWithRotations(rotate(ed25519.GenPrivKey()), rotate(ed25519.GenPrivKey()))
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	assert.Error(t, err)
+
+	newKey := ed25519.GenPrivKey()
+	block, blockID = makeBlockWithRotations(rotate(newKey))
+	state, err = blockExec.ApplyBlock(state, blockID, block)
+	require.NoError(t, err)
+	assert.Empty(t, app.ByzantineValidators)
+
+	_, val := state.Validators.GetByAddress(addr)
+	require.NotNil(t, val)
+	assert.True(t, oldKey.PubKey().Equals(val.PubKey))
+	_, val = state.NextValidators.GetByAddress(addr)
+	require.NotNil(t, val)
+	assert.True(t, newKey.PubKey().Equals(val.PubKey))
+	assert.EqualValues(t, 3, state.LastHeightValidatorsChanged)
+	assert.EqualValues(t, 3, sm.LoadKeyRotationHeight(stateDB, addr))
+
+	// Once the key was rotated back to the old key, a rotation signed by the
+	// old key before can't be replayed.
+	rotatedBack := state.Copy()
+	rotatedBack.NextValidators = state.Validators.Copy()
+	err = sm.VerifyEvidence(stateDB, rotatedBack, rotate(ed25519.GenPrivKey()))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "before the last rotation")
+	}
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
@@ -414,3 +527,440 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 
 }
+
+// TestCreateProposalBlockPrepareProposal ensures the txs returned by the app's
+// PrepareProposal end up in the proposal block and are bounded by MaxBytes.
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, _ := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(
+		stateDB,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mock.Mempool{},
+		sm.MockEvidencePool{},
+	)
+
+	proposerAddr := state.Validators.Validators[0].Address
+	commit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	// the app injects txs the mempool never saw
+	app.PreparedTxs = types.Txs(makeTxs(1)).ToSliceOfBytes()
+	block, _, err := blockExec.CreateProposalBlock(1, state, commit, proposerAddr, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, makeTxs(1), block.Txs)
+	assert.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// the app returns more txs than fit in a block
+	app.PreparedTxs = [][]byte{make([]byte, state.ConsensusParams.Block.MaxBytes)}
+	_, _, err = blockExec.CreateProposalBlock(1, state, commit, proposerAddr, nil)
+	assert.Error(t, err)
+}
+
+func TestApplyBlockPipelined(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithPipelinedExecution())
+
+	proposerAddr := state.Validators.Validators[0].Address
+	genesisAppHash := state.AppHash
+
+	// block 1 is executed in the background, so the state keeps the genesis app hash
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	state, _, lastCommit, err = makeAndCommitGoodBlock(state, 1, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+	assert.Equal(t, genesisAppHash, state.AppHash)
+
+	// the app hash and results of block 1 are in the state after block 2
+	state, _, _, err = makeAndCommitGoodBlock(state, 2, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+	require.NoError(t, blockExec.WaitPendingBlock())
+
+	abciResponses1, err := sm.LoadABCIResponses(stateDB, 1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, sm.LoadAppHash(stateDB, 1))
+	assert.Equal(t, sm.LoadAppHash(stateDB, 1), state.AppHash)
+	assert.Equal(t, abciResponses1.ResultsHash(), state.LastResultsHash)
+
+	// block 2 was committed by the app
+	res, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, res.LastBlockHeight)
+	assert.Equal(t, res.LastBlockAppHash, sm.LoadAppHash(stateDB, 2))
+}
+
+func TestApplyBlockHalt(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithHalt(2, time.Time{}))
+	proposerAddr := state.Validators.Validators[0].Address
+
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	state, _, lastCommit, err = makeAndCommitGoodBlock(state, 1, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+	assert.False(t, blockExec.IsHalted(state))
+	select {
+	case <-blockExec.Halted():
+		t.Fatal("halted before the halt height")
+	default:
+	}
+
+	state, _, lastCommit, err = makeAndCommitGoodBlock(state, 2, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+	assert.True(t, blockExec.IsHalted(state))
+	select {
+	case <-blockExec.Halted():
+	default:
+		t.Fatal("not halted at the halt height")
+	}
+
+	// no block is applied after the halt height
+	_, _, _, err = makeAndCommitGoodBlock(state, 3, lastCommit, proposerAddr, blockExec, privVals, nil)
+	assert.Equal(t, sm.ErrHalted{Height: 2}, err)
+	assert.EqualValues(t, 2, sm.LoadState(stateDB).LastBlockHeight)
+
+	// the halt time stops at the first block at or after it
+	state, stateDB, _ = makeState(1, 1)
+	blockExec = sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, sm.BlockExecutorWithHalt(0, state.LastBlockTime))
+	assert.False(t, blockExec.IsHalted(state))
+	state.LastBlockHeight = 1
+	assert.True(t, blockExec.IsHalted(state))
+}
+
+// reapMempool returns txs when reaped.
+type reapMempool struct {
+	mock.Mempool
+	txs types.Txs
+}
+
+func (mem reapMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
+
+func TestCreateProposalBlockPipelinedExcludesPendingTxs(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	pendingTxs := types.Txs(makeTxs(1))
+	newTx := types.Tx("new")
+	mempool := reapMempool{txs: append(pendingTxs, newTx)}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mempool, sm.MockEvidencePool{}, sm.BlockExecutorWithPipelinedExecution())
+
+	proposerAddr := state.Validators.Validators[0].Address
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	state, _, lastCommit, err = makeAndCommitGoodBlock(state, 1, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+
+	// the txs of block 1 are still in the mempool while it's being executed
+	block, _, err := blockExec.CreateProposalBlock(2, state, lastCommit, proposerAddr, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, types.Txs{newTx}, block.Txs)
+	require.NoError(t, blockExec.WaitPendingBlock())
+}
+
+// orderApp records the calls made on it.
+type orderApp struct {
+	*kvstore.Application
+
+	mtx   sync.Mutex
+	calls []string
+}
+
+func (app *orderApp) record(call string) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.calls = append(app.calls, call)
+}
+
+func (app *orderApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.record("BeginBlock")
+	return app.Application.BeginBlock(req)
+}
+
+func (app *orderApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.record("DeliverTx")
+	return app.Application.DeliverTx(req)
+}
+
+func (app *orderApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.record("EndBlock")
+	return app.Application.EndBlock(req)
+}
+
+func (app *orderApp) Commit() abci.ResponseCommit {
+	app.record("Commit")
+	return app.Application.Commit()
+}
+
+func (app *orderApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	app.record("ProcessProposal")
+	return app.Application.ProcessProposal(req)
+}
+
+// lockMempool closes locked the first time it's locked, and blocks until
+// unlock is closed.
+type lockMempool struct {
+	mock.Mempool
+	once   *sync.Once
+	locked chan struct{}
+	unlock chan struct{}
+}
+
+func (mem lockMempool) Lock() {
+	mem.once.Do(func() { close(mem.locked) })
+	<-mem.unlock
+}
+
+func TestApplyBlockPipelinedInterleavesAppCalls(t *testing.T) {
+	app := &orderApp{Application: kvstore.NewApplication()}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	mempool := lockMempool{once: new(sync.Once), locked: make(chan struct{}), unlock: make(chan struct{})}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mempool, sm.MockEvidencePool{}, sm.BlockExecutorWithPipelinedExecution())
+
+	proposerAddr := state.Validators.Validators[0].Address
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	state, _, lastCommit, err = makeAndCommitGoodBlock(state, 1, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+
+	// block 1 is held right before Commit, the proposal for block 2 is
+	// processed nonetheless
+	<-mempool.locked
+	block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, proposerAddr)
+	processed := make(chan error)
+	go func() {
+		_, err := blockExec.ProcessProposal(block)
+		processed <- err
+	}()
+	select {
+	case err := <-processed:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("ProcessProposal waited for the block being executed")
+	}
+	close(mempool.unlock)
+	require.NoError(t, blockExec.WaitPendingBlock())
+
+	calls := []string{"BeginBlock"}
+	for range makeTxs(1) {
+		calls = append(calls, "DeliverTx")
+	}
+	calls = append(calls, "EndBlock", "ProcessProposal", "Commit")
+	assert.Equal(t, calls, app.calls)
+}
+
+// resetAppConn reports the connection as reset on Commit, after running
+// replay as the reconnection handshake would.
+type resetAppConn struct {
+	proxy.AppConnConsensus
+	replay func()
+}
+
+func (app resetAppConn) CommitSync() (*abci.ResponseCommit, error) {
+	app.replay()
+	return nil, proxy.ErrAppConnReset
+}
+
+// updateMempool records the txs it's updated with.
+type updateMempool struct {
+	mock.Mempool
+	txs types.Txs
+}
+
+func (mem *updateMempool) Update(
+	_ int64,
+	txs types.Txs,
+	_ []*abci.ResponseDeliverTx,
+	_ mempl.PreCheckFunc,
+	_ mempl.PostCheckFunc,
+) error {
+	mem.txs = txs
+	return nil
+}
+
+func TestApplyBlockRecoversFromAppConnReset(t *testing.T) {
+	newApp := func() proxy.AppConns {
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+		require.NoError(t, proxyApp.Start())
+		return proxyApp
+	}
+	proxyApp, restartedApp := newApp(), newApp()
+	defer proxyApp.Stop()
+	defer restartedApp.Stop()
+
+	state, stateDB, _ := makeState(1, 1)
+	block := makeBlock(state, 1)
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+
+	replay := func() {
+		replayExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), restartedApp.Consensus(),
+			mock.Mempool{}, sm.MockEvidencePool{})
+		_, err := replayExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+	}
+	mempool := &updateMempool{}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(),
+		resetAppConn{AppConnConsensus: proxyApp.Consensus(), replay: replay}, mempool, sm.MockEvidencePool{})
+
+	newState, err := blockExec.ApplyBlock(state, blockID, block)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, newState.LastBlockHeight)
+	assert.Equal(t, sm.LoadState(stateDB).AppHash, newState.AppHash)
+	assert.Equal(t, block.Txs, mempool.txs)
+
+	// Without the replay, the block can't be recovered.
+	sm.SaveState(stateDB, state)
+	blockExec = sm.NewBlockExecutor(stateDB, log.TestingLogger(),
+		resetAppConn{AppConnConsensus: proxyApp.Consensus(), replay: func() {}}, mempool, sm.MockEvidencePool{})
+	_, err = blockExec.ApplyBlock(state, blockID, block)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wasn't replayed")
+}
+
+// resetEndBlockAppConn reports the connection as reset on the first EndBlock,
+// wrapping the error, after running replay.
+type resetEndBlockAppConn struct {
+	proxy.AppConnConsensus
+	once   *sync.Once
+	replay func()
+}
+
+func (app resetEndBlockAppConn) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	reset := false
+	app.once.Do(func() {
+		app.replay()
+		reset = true
+	})
+	if reset {
+		return nil, errors.Wrap(proxy.ErrAppConnReset, "EndBlock")
+	}
+	return app.AppConnConsensus.EndBlockSync(req)
+}
+
+func TestApplyBlockPipelinedRecoversFromAppConnReset(t *testing.T) {
+	newApp := func() proxy.AppConns {
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+		require.NoError(t, proxyApp.Start())
+		return proxyApp
+	}
+	proxyApp, restartedApp := newApp(), newApp()
+	defer proxyApp.Stop()
+	defer restartedApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	block := makeBlock(state, 1)
+
+	// the handshake replays block 1 once the connection is back
+	replay := func() {
+		_, err := sm.ExecCommitBlockAndSaveResponses(restartedApp.Consensus(), block, log.TestingLogger(), stateDB)
+		require.NoError(t, err)
+	}
+	appConn := resetEndBlockAppConn{AppConnConsensus: proxyApp.Consensus(), once: new(sync.Once), replay: replay}
+	mempool := &updateMempool{}
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), appConn, mempool, sm.MockEvidencePool{},
+		sm.BlockExecutorWithPipelinedExecution())
+
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+	state, err := blockExec.ApplyBlock(state, blockID, block)
+	require.NoError(t, err)
+	lastCommit, err := makeValidCommit(1, blockID, state.Validators, privVals)
+	require.NoError(t, err)
+
+	// applying block 2 picks up the results of the replay
+	proposerAddr := state.Validators.GetProposer().Address
+	state, _, _, err = makeAndCommitGoodBlock(state, 2, lastCommit, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sm.LoadAppHash(stateDB, 1), state.AppHash)
+	require.NoError(t, blockExec.WaitPendingBlock())
+}
+
+func BenchmarkApplyBlockKVStore(b *testing.B) {
+	b.Run("sync", func(b *testing.B) { benchmarkApplyBlockKVStore(b, false) })
+	b.Run("pipelined", func(b *testing.B) { benchmarkApplyBlockKVStore(b, true) })
+}
+
+// benchmarkApplyBlockKVStore decides on and applies blocks full of txs on the
+// kvstore app, and reports the throughput. For each height, the proposal is
+// processed and the precommit extended by the app, as consensus does, and the
+// time spent gossiping the proposal and votes is simulated with networkTime.
+// With pipelined execution, all of it overlaps with the execution of the
+// previous height.
+func benchmarkApplyBlockKVStore(b *testing.B, pipelined bool) {
+	const (
+		txsPerBlock = 2000
+		networkTime = 10 * time.Millisecond
+	)
+
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(b, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(1, 1)
+	state.ConsensusParams.Block.MaxBytes = types.MaxBlockSizeBytes
+	var options []sm.BlockExecutorOption
+	if pipelined {
+		options = append(options, sm.BlockExecutorWithPipelinedExecution())
+	}
+	blockExec := sm.NewBlockExecutor(stateDB, log.NewNopLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{}, options...)
+
+	proposerAddr := state.Validators.Validators[0].Address
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		height := int64(i + 1)
+		txs := make(types.Txs, txsPerBlock)
+		for j := range txs {
+			txs[j] = types.Tx(fmt.Sprintf("key%d-%d=value", height, j))
+		}
+		block, _ := state.MakeBlock(height, txs, lastCommit, nil, proposerAddr)
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: block.MakePartSet(testPartSize).Header()}
+
+		require.NoError(b, blockExec.ValidateBlock(state, block))
+		accepted, err := blockExec.ProcessProposal(block)
+		require.NoError(b, err)
+		require.True(b, accepted)
+		time.Sleep(networkTime)
+		_, err = blockExec.ExtendVote(&types.Vote{Height: height, BlockID: blockID})
+		require.NoError(b, err)
+		time.Sleep(networkTime)
+
+		state, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(b, err)
+		lastCommit, err = makeValidCommit(height, blockID, state.LastValidators, privVals)
+		require.NoError(b, err)
+	}
+	require.NoError(b, blockExec.WaitPendingBlock())
+	b.ReportMetric(float64(b.N*txsPerBlock)/time.Since(start).Seconds(), "txs/s")
+}
@@ -28,7 +28,7 @@ func UpdateState(
 	abciResponses *ABCIResponses,
 	validatorUpdates []*types.Validator,
 ) (State, error) {
-	return updateState(state, blockID, header, abciResponses, validatorUpdates)
+	return updateState(state, blockID, header, abciResponses, validatorUpdates, nil)
 }
 
 // ValidateValidatorUpdates is an alias for validateValidatorUpdates exported
@@ -17,6 +17,9 @@ const (
 type Metrics struct {
 	// Time between BeginBlock and EndBlock.
 	BlockProcessingTime metrics.Histogram
+	// Share of the blocks signed by the validator of the node over the uptime
+	// window, or 0 if it is not a validator.
+	ValidatorUptime metrics.Gauge
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -35,6 +38,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Help:      "Time between BeginBlock and EndBlock in ms.",
 			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
 		}, labels).With(labelsAndValues...),
+		ValidatorUptime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_uptime",
+			Help:      "Share of the blocks signed by the validator of the node over the uptime window, or 0 if not a validator.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
 	}
 }
 
@@ -42,5 +51,6 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 func NopMetrics() *Metrics {
 	return &Metrics{
 		BlockProcessingTime: discard.NewHistogram(),
+		ValidatorUptime:     discard.NewGauge(),
 	}
 }
@@ -0,0 +1,130 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+var rollbackHeightKey = []byte("rollbackHeightKey")
+
+// Rollback rewinds the state by n heights, to the state after the block at
+// LastBlockHeight-n, from the validators, consensus params and ABCI responses
+// saved at each height, and the headers of the blocks. The block store is
+// kept: the blocks above the new height are executed again on the next start,
+// once the app rolled back too (see LoadRollbackHeight). It returns the new
+// height and app hash.
+func Rollback(blockStore BlockStore, stateDB dbm.DB, n int64) (int64, []byte, error) {
+	if n <= 0 {
+		return 0, nil, fmt.Errorf("number of heights to roll back must be positive, got %d", n)
+	}
+	state := LoadState(stateDB)
+	if state.IsEmpty() {
+		return 0, nil, errors.New("no state found")
+	}
+	// The block store is at most one ahead of the state, or more if the
+	// state was already rolled back.
+	storeHeight := blockStore.Height()
+	if storeHeight < state.LastBlockHeight {
+		return 0, nil, fmt.Errorf("block store height %d is below the state height %d",
+			storeHeight, state.LastBlockHeight)
+	}
+	height := state.LastBlockHeight - n
+	if height < 1 {
+		return 0, nil, fmt.Errorf("can't roll back %d heights from height %d, the state must remain at height 1 or above",
+			n, state.LastBlockHeight)
+	}
+
+	blockMeta := blockStore.LoadBlockMeta(height)
+	nextBlockMeta := blockStore.LoadBlockMeta(height + 1)
+	if blockMeta == nil || nextBlockMeta == nil {
+		return 0, nil, fmt.Errorf("blocks %d and %d must be in the block store", height, height+1)
+	}
+
+	lastValidators, err := LoadValidators(stateDB, height)
+	if err != nil {
+		return 0, nil, err
+	}
+	validators, err := LoadValidators(stateDB, height+1)
+	if err != nil {
+		return 0, nil, err
+	}
+	nextValidators, err := LoadValidators(stateDB, height+2)
+	if err != nil {
+		return 0, nil, err
+	}
+	consensusParams, err := LoadConsensusParams(stateDB, height+1)
+	if err != nil {
+		return 0, nil, err
+	}
+	// Like the validators and consensus params, the ABCI responses must have
+	// been saved at height.
+	if _, err := LoadABCIResponses(stateDB, height); err != nil {
+		return 0, nil, err
+	}
+
+	// The header of the next block commits to the state at height.
+	header := nextBlockMeta.Header
+	if !bytes.Equal(nextValidators.Hash(), header.NextValidatorsHash) {
+		return 0, nil, fmt.Errorf("the validators at height %d don't match the next validators hash of block %d",
+			height+2, height+1)
+	}
+
+	version := state.Version
+	version.Consensus = header.Version
+
+	rolledBack := State{
+		Version:         version,
+		ChainID:         state.ChainID,
+		LastBlockHeight: height,
+		LastBlockID:     blockMeta.BlockID,
+		LastBlockTime:   blockMeta.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: loadValidatorsInfo(stateDB, height+2).LastHeightChanged,
+
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: loadConsensusParamsInfo(stateDB, height+1).LastHeightChanged,
+
+		LastResultsHash: header.LastResultsHash,
+		AppHash:         header.AppHash,
+	}
+	SaveState(stateDB, rolledBack)
+	SaveRollbackHeight(stateDB, height)
+	return rolledBack.LastBlockHeight, rolledBack.AppHash, nil
+}
+
+// LoadRollbackHeight returns the height the state was rolled back to by
+// Rollback, until ClearRollbackHeight is called once the app rolled back too,
+// or 0.
+func LoadRollbackHeight(db dbm.DB) int64 {
+	buf, err := db.Get(rollbackHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(buf) == 0 {
+		return 0
+	}
+	var height int64
+	cdc.MustUnmarshalBinaryBare(buf, &height)
+	return height
+}
+
+// ClearRollbackHeight forgets the height saved by Rollback.
+func ClearRollbackHeight(db dbm.DB) {
+	if err := db.DeleteSync(rollbackHeightKey); err != nil {
+		panic(err)
+	}
+}
+
+// SaveRollbackHeight makes the next handshake ask the app to roll back to the
+// given height, and execute the blocks above the state height again.
+func SaveRollbackHeight(db dbm.DB, height int64) {
+	if err := db.SetSync(rollbackHeightKey, cdc.MustMarshalBinaryBare(height)); err != nil {
+		panic(err)
+	}
+}
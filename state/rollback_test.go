@@ -0,0 +1,64 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/mock"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestRollback(t *testing.T) {
+	proxyApp := newTestApp()
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB, privVals := makeState(2, 1)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		mock.Mempool{}, sm.MockEvidencePool{})
+
+	states := make(map[int64]sm.State)
+	lastCommit := new(types.Commit)
+	for height := int64(1); height <= 5; height++ {
+		block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, state.Validators.GetProposer().Address)
+		partSet := block.MakePartSet(testPartSize)
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: partSet.Header()}
+		state, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+		lastCommit, err = makeValidCommit(height, blockID, state.LastValidators, privVals)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, lastCommit)
+		states[height] = state
+	}
+
+	_, _, err = sm.Rollback(blockStore, stateDB, 0)
+	assert.Error(t, err)
+	_, _, err = sm.Rollback(blockStore, stateDB, 5)
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, sm.LoadRollbackHeight(stateDB))
+
+	height, appHash, err := sm.Rollback(blockStore, stateDB, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, height)
+	assert.Equal(t, states[3].AppHash, appHash)
+	assert.Equal(t, states[3].Bytes(), sm.LoadState(stateDB).Bytes())
+	assert.EqualValues(t, 3, sm.LoadRollbackHeight(stateDB))
+
+	// The state can be rolled back further, with the blocks kept.
+	height, _, err = sm.Rollback(blockStore, stateDB, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+	assert.Equal(t, states[2].Bytes(), sm.LoadState(stateDB).Bytes())
+	assert.EqualValues(t, 2, sm.LoadRollbackHeight(stateDB))
+
+	sm.ClearRollbackHeight(stateDB)
+	assert.EqualValues(t, 0, sm.LoadRollbackHeight(stateDB))
+}
@@ -29,6 +29,9 @@ type BlockStoreRPC interface {
 type BlockStore interface {
 	BlockStoreRPC
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+
+	LoadExtendedCommit(height int64) *types.ExtendedCommit
+	SaveExtendedCommit(extCommit *types.ExtendedCommit)
 }
 
 //-----------------------------------------------------------------------------------------------------
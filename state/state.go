@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"time"
@@ -141,9 +142,12 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == 1 {
+	switch {
+	case height == 1:
 		timestamp = state.LastBlockTime // genesis time
-	} else {
+	case commit.Aggregated():
+		timestamp = AggregatedCommitTime(state)
+	default:
 		timestamp = MedianTime(commit, state.LastValidators)
 	}
 
@@ -163,6 +167,9 @@ func (state State) MakeBlock(
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
 // computed value.
+//
+// An aggregated commit doesn't keep the timestamps of the votes, see
+// AggregatedCommitTime.
 func MedianTime(commit *types.Commit, validators *types.ValidatorSet) time.Time {
 	weightedTimes := make([]*tmtime.WeightedTime, len(commit.Signatures))
 	totalVotingPower := int64(0)
@@ -224,7 +231,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 	} else {
 		validators := make([]*types.Validator, len(genDoc.Validators))
 		for i, val := range genDoc.Validators {
-			validators[i] = types.NewValidator(val.PubKey, val.Power)
+			validators[i] = val.Validator()
 		}
 		validatorSet = types.NewValidatorSet(validators)
 		nextValidatorSet = types.NewValidatorSet(validators).CopyIncrementProposerPriority(1)
@@ -249,3 +256,44 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		AppHash: genDoc.AppHash,
 	}, nil
 }
+
+// ExportGenesis returns the genesis of a new chain continuing from state, e.g.
+// once the node halted (see BlockExecutorWithHalt), with appState exported by
+// the app. The validators are those of the height after next, so the updates
+// returned by the app for the last block are included. The genesis time is the
+// time of the last block. A validator which rotated its key keeps its address.
+func (state State) ExportGenesis(appState json.RawMessage) *types.GenesisDoc {
+	validators := make([]types.GenesisValidator, len(state.NextValidators.Validators))
+	for i, val := range state.NextValidators.Validators {
+		validators[i] = types.GenesisValidator{
+			Address: val.Address,
+			PubKey:  val.PubKey,
+			Power:   val.VotingPower,
+		}
+	}
+	consensusParams := state.ConsensusParams
+	return &types.GenesisDoc{
+		GenesisTime:     state.LastBlockTime,
+		ChainID:         state.ChainID,
+		ConsensusParams: &consensusParams,
+		Validators:      validators,
+		AppState:        appState,
+	}
+}
+
+// AggregatedCommitTime returns the time of a block whose LastCommit is
+// aggregated: as the timestamps of the votes are not kept, it is the time of
+// the proposer, but at least the time iota after the last block time.
+//
+// Unlike MedianTime, it can't be checked against the commit. Instead, the
+// validators prevote the block only if its time is close to the time they
+// received the proposal, so a committed block has a time bounded by the
+// clocks of honest validators.
+func AggregatedCommitTime(state State) time.Time {
+	now := tmtime.Now()
+	minTime := state.LastBlockTime.Add(time.Duration(state.ConsensusParams.Block.TimeIotaMs) * time.Millisecond)
+	if now.Before(minTime) {
+		return minTime
+	}
+	return now
+}
@@ -66,6 +66,39 @@ func TestMakeGenesisStateNilValidators(t *testing.T) {
 	require.Equal(t, 0, len(state.NextValidators.Validators))
 }
 
+// TestExportGenesis tests that the genesis exported from a state starts a
+// chain with its next validators and consensus params.
+func TestExportGenesis(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	state.LastBlockHeight = 10
+	state.LastBlockTime = time.Now().UTC()
+	val := types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	rotated := types.NewValidator(ed25519.GenPrivKey().PubKey(), 20)
+	rotated.PubKey = ed25519.GenPrivKey().PubKey()
+	state.NextValidators = types.NewValidatorSet([]*types.Validator{val, rotated})
+	state.ConsensusParams.Block.MaxGas = 1000
+
+	genDoc := state.ExportGenesis([]byte(`{"key":"value"}`))
+	require.NoError(t, genDoc.ValidateAndComplete())
+	assert.Equal(t, state.ChainID, genDoc.ChainID)
+	assert.Equal(t, state.LastBlockTime, genDoc.GenesisTime)
+	assert.Equal(t, state.ConsensusParams, *genDoc.ConsensusParams)
+	assert.JSONEq(t, `{"key":"value"}`, string(genDoc.AppState))
+	// The validator which rotated its key keeps its address.
+	for _, v := range genDoc.Validators {
+		if v.PubKey.Equals(rotated.PubKey) {
+			assert.Equal(t, rotated.Address, v.Address)
+		}
+	}
+
+	newState, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	assert.Equal(t, state.NextValidators.Hash(), newState.Validators.Hash())
+	assert.Equal(t, state.ConsensusParams, newState.ConsensusParams)
+}
+
 // TestStateSaveLoad tests saving and loading State from a db.
 func TestStateSaveLoad(t *testing.T) {
 	tearDown, stateDB, state := setupTestCase(t)
@@ -32,6 +32,14 @@ func calcABCIResponsesKey(height int64) []byte {
 	return []byte(fmt.Sprintf("abciResponsesKey:%v", height))
 }
 
+func calcAppHashKey(height int64) []byte {
+	return []byte(fmt.Sprintf("appHashKey:%v", height))
+}
+
+func calcKeyRotationKey(address types.Address) []byte {
+	return []byte(fmt.Sprintf("keyRotationKey:%X", address))
+}
+
 // LoadStateFromDBOrGenesisFile loads the most recent state from the database,
 // or creates a new one from the given genesisFilePath and persists the result
 // to the database.
@@ -180,6 +188,82 @@ func SaveABCIResponses(db dbm.DB, height int64, abciResponses *ABCIResponses) {
 	db.SetSync(calcABCIResponsesKey(height), abciResponses.Bytes())
 }
 
+// LoadAppHash loads the app hash resulting from the block at the given height
+// from the database. It's only saved with pipelined execution, where the app
+// hash isn't in the state until the next block is applied.
+func LoadAppHash(db dbm.DB, height int64) []byte {
+	appHash, err := db.Get(calcAppHashKey(height))
+	if err != nil {
+		panic(err)
+	}
+	return appHash
+}
+
+// SaveAppHash persists the app hash resulting from the block at the given
+// height to the database.
+func SaveAppHash(db dbm.DB, height int64, appHash []byte) {
+	db.SetSync(calcAppHashKey(height), appHash)
+}
+
+var pipelinedExecutionKey = []byte("pipelinedExecutionKey")
+
+// CheckPipelinedExecution returns an error if the blocks in the database were
+// applied with pipelined execution (see BlockExecutorWithPipelinedExecution)
+// and pipelined is false, or the other way around, as the state and the app
+// can't be switched from one mode to the other. The mode is saved the first
+// time; blocks applied by earlier versions, which didn't save it, were
+// applied without pipelined execution.
+func CheckPipelinedExecution(db dbm.DB, pipelined bool) error {
+	buf, err := db.Get(pipelinedExecutionKey)
+	if err != nil {
+		panic(err)
+	}
+	saved := false
+	if len(buf) > 0 {
+		cdc.MustUnmarshalBinaryBare(buf, &saved)
+	} else if LoadState(db).LastBlockHeight == 0 {
+		saved = pipelined
+		if err := db.SetSync(pipelinedExecutionKey, cdc.MustMarshalBinaryBare(saved)); err != nil {
+			panic(err)
+		}
+	}
+	if saved != pipelined {
+		return fmt.Errorf("blocks were applied with pipelined execution %s, it can't be %s now",
+			enabledOrDisabled(saved), enabledOrDisabled(pipelined))
+	}
+	return nil
+}
+
+func enabledOrDisabled(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// LoadKeyRotationHeight returns the height from which the last key rotation
+// of the validator with the given address applies, or 0 if it never rotated
+// its key.
+func LoadKeyRotationHeight(db dbm.DB, address types.Address) int64 {
+	buf, err := db.Get(calcKeyRotationKey(address))
+	if err != nil {
+		panic(err)
+	}
+	var height int64
+	if len(buf) > 0 {
+		cdc.MustUnmarshalBinaryBare(buf, &height)
+	}
+	return height
+}
+
+// saveKeyRotationHeights saves the height from which the key rotations
+// committed in the block at the given height apply, the next next height.
+func saveKeyRotationHeights(db dbm.DB, height int64, rotations []*types.KeyRotation) {
+	for _, rot := range rotations {
+		db.Set(calcKeyRotationKey(rot.ValidatorAddress), cdc.MustMarshalBinaryBare(height+1+1))
+	}
+}
+
 //-----------------------------------------------------------------------------
 
 // ValidatorsInfo represents the latest validator set, or the last height it changed
@@ -35,6 +35,24 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestCheckPipelinedExecution(t *testing.T) {
+	// the mode is saved on first use
+	stateDB := dbm.NewMemDB()
+	require.NoError(t, sm.CheckPipelinedExecution(stateDB, true))
+	require.NoError(t, sm.CheckPipelinedExecution(stateDB, true))
+	assert.Error(t, sm.CheckPipelinedExecution(stateDB, false))
+
+	stateDB = dbm.NewMemDB()
+	require.NoError(t, sm.CheckPipelinedExecution(stateDB, false))
+	assert.Error(t, sm.CheckPipelinedExecution(stateDB, true))
+
+	// blocks applied before it was saved weren't pipelined
+	stateDB = dbm.NewMemDB()
+	sm.SaveState(stateDB, sm.State{LastBlockHeight: 1})
+	assert.Error(t, sm.CheckPipelinedExecution(stateDB, true))
+	require.NoError(t, sm.CheckPipelinedExecution(stateDB, false))
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
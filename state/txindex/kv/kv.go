@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/tendermint/tendermint/libs/kv"
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 	tmstring "github.com/tendermint/tendermint/libs/strings"
 	"github.com/tendermint/tendermint/state/txindex"
@@ -20,9 +22,15 @@ import (
 
 const (
 	tagKeySeparator = "/"
+
+	// blockEntriesKey prefixes the keys of the entries indexed for each block.
+	blockEntriesKey = "tx.block_entries"
 )
 
-var _ txindex.TxIndexer = (*TxIndex)(nil)
+var (
+	_ txindex.TxIndexer    = (*TxIndex)(nil)
+	_ txindex.SearchProver = (*TxIndex)(nil)
+)
 
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
 type TxIndex struct {
@@ -86,16 +94,17 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 	storeBatch := txi.store.NewBatch()
 	defer storeBatch.Close()
 
+	// the entries of each block, for its index root
+	entries := make(map[int64][]kv.Pair)
 	for _, result := range b.Ops {
 		hash := result.Tx.Hash()
 
-		// index tx by events
-		txi.indexEvents(result, hash, storeBatch)
-
-		// index tx by height
-		if txi.indexAllEvents || tmstring.StringInSlice(types.TxHeightKey, txi.compositeKeysToIndex) {
-			storeBatch.Set(keyForHeight(result), hash)
+		// index tx by events and height
+		es := txi.entries(result, hash)
+		for _, e := range es {
+			storeBatch.Set(e.Key, e.Value)
 		}
+		entries[result.Height] = append(entries[result.Height], es...)
 
 		// index tx by hash
 		rawBytes, err := cdc.MarshalBinaryBare(result)
@@ -105,6 +114,12 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 		storeBatch.Set(hash, rawBytes)
 	}
 
+	for height, es := range entries {
+		if err := txi.saveBlockEntries(height, es, storeBatch); err != nil {
+			return err
+		}
+	}
+
 	storeBatch.WriteSync()
 	return nil
 }
@@ -113,19 +128,19 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 // that indexed from the tx's events is a composite of the event type and the
 // respective attribute's key delimited by a "." (eg. "account.number").
 // Any event with an empty type is not indexed.
+// The index root of the block of the tx is dropped, as it would no longer
+// cover all its entries: use AddBatch to index whole blocks.
 func (txi *TxIndex) Index(result *types.TxResult) error {
 	b := txi.store.NewBatch()
 	defer b.Close()
 
 	hash := result.Tx.Hash()
 
-	// index tx by events
-	txi.indexEvents(result, hash, b)
-
-	// index tx by height
-	if txi.indexAllEvents || tmstring.StringInSlice(types.TxHeightKey, txi.compositeKeysToIndex) {
-		b.Set(keyForHeight(result), hash)
+	// index tx by events and height
+	for _, e := range txi.entries(result, hash) {
+		b.Set(e.Key, e.Value)
 	}
+	b.Delete(keyForBlockEntries(result.Height))
 
 	// index tx by hash
 	rawBytes, err := cdc.MarshalBinaryBare(result)
@@ -139,7 +154,10 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 	return nil
 }
 
-func (txi *TxIndex) indexEvents(result *types.TxResult, hash []byte, store dbm.SetDeleter) {
+// entries returns the index entries of the tx, pointing at its hash: one per
+// event attribute to index, and one for its height if it's indexed.
+func (txi *TxIndex) entries(result *types.TxResult, hash []byte) []kv.Pair {
+	entries := make([]kv.Pair, 0)
 	for _, event := range result.Result.Events {
 		// only index events with a non-empty type
 		if len(event.Type) == 0 {
@@ -153,10 +171,136 @@ func (txi *TxIndex) indexEvents(result *types.TxResult, hash []byte, store dbm.S
 
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
 			if txi.indexAllEvents || tmstring.StringInSlice(compositeTag, txi.compositeKeysToIndex) {
-				store.Set(keyForEvent(compositeTag, attr.Value, result), hash)
+				entries = append(entries, kv.Pair{Key: keyForEvent(compositeTag, attr.Value, result), Value: hash})
 			}
 		}
 	}
+
+	if txi.indexAllEvents || tmstring.StringInSlice(types.TxHeightKey, txi.compositeKeysToIndex) {
+		entries = append(entries, kv.Pair{Key: keyForHeight(result), Value: hash})
+	}
+	return entries
+}
+
+// saveBlockEntries saves the keys of the entries of the block at height, over
+// which the index root of the block is computed. The entries already saved
+// for the block, if it's indexed again, are kept.
+func (txi *TxIndex) saveBlockEntries(height int64, entries []kv.Pair, store dbm.SetDeleter) error {
+	keys := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		keys[string(e.Key)] = true
+	}
+	existing, err := txi.blockEntryKeys(height)
+	if err != nil && err != txindex.ErrNoIndexProof {
+		return err
+	}
+	for _, key := range existing {
+		keys[string(key)] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	bz, err := cdc.MarshalBinaryLengthPrefixed(sorted)
+	if err != nil {
+		return err
+	}
+	store.Set(keyForBlockEntries(height), bz)
+	return nil
+}
+
+// blockEntryKeys returns the keys of the entries indexed for the block at
+// height, sorted, or txindex.ErrNoIndexProof if the block wasn't indexed
+// with AddBatch, ie. it has no tx, or it was indexed before index roots
+// were kept.
+func (txi *TxIndex) blockEntryKeys(height int64) ([]string, error) {
+	bz, err := txi.store.Get(keyForBlockEntries(height))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil, txindex.ErrNoIndexProof
+	}
+	keys := make([]string, 0)
+	if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &keys); err != nil {
+		return nil, fmt.Errorf("error reading entries of block #%d: %v", height, err)
+	}
+	return keys, nil
+}
+
+// blockEntries returns the entries indexed for the block at height, sorted by
+// key, or txindex.ErrNoIndexProof.
+func (txi *TxIndex) blockEntries(height int64) ([]kv.Pair, error) {
+	keys, err := txi.blockEntryKeys(height)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]kv.Pair, len(keys))
+	for i, key := range keys {
+		value, err := txi.store.Get([]byte(key))
+		if err != nil {
+			panic(err)
+		}
+		if value == nil {
+			return nil, fmt.Errorf("entry %q of block #%d is missing", key, height)
+		}
+		entries[i] = kv.Pair{Key: []byte(key), Value: value}
+	}
+	return entries, nil
+}
+
+// IndexRoot returns the root hash of the Merkle tree over the entries indexed
+// for the block at height, or txindex.ErrNoIndexProof if the block has none.
+func (txi *TxIndex) IndexRoot(height int64) ([]byte, error) {
+	entries, err := txi.blockEntries(height)
+	if err != nil {
+		return nil, err
+	}
+	return txindex.EntriesHash(entries), nil
+}
+
+// ProveSearch returns a proof of the entries matching each condition of the
+// query, in order. Only queries with a "tx.height=X" condition, and no other
+// operators than "=", can be proven, on blocks with an index root (see
+// IndexRoot).
+func (txi *TxIndex) ProveSearch(q *query.Query) ([]*txindex.RangeProof, error) {
+	height, prefixes, err := SearchPrefixes(q)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := txi.blockEntries(height)
+	if err != nil {
+		return nil, err
+	}
+	proofs := make([]*txindex.RangeProof, len(prefixes))
+	for i, prefix := range prefixes {
+		proofs[i] = txindex.NewRangeProof(height, prefix, entries)
+	}
+	return proofs, nil
+}
+
+// SearchPrefixes returns the height of a provable query, and the prefix of
+// the keys of the entries matching each of its conditions. It returns
+// txindex.ErrUnprovableQuery if the query can't be proven.
+func SearchPrefixes(q *query.Query) (height int64, prefixes []string, err error) {
+	conditions, err := q.Conditions()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+	height = lookForHeight(conditions)
+	if height <= 0 {
+		return 0, nil, txindex.ErrUnprovableQuery
+	}
+	prefixes = make([]string, len(conditions))
+	for i, c := range conditions {
+		if c.Op != query.OpEqual || c.CompositeKey == types.TxHashKey {
+			return 0, nil, txindex.ErrUnprovableQuery
+		}
+		prefixes[i] = string(startKeyForCondition(c, height))
+	}
+	return height, prefixes, nil
 }
 
 // Search performs a search using the given query.
@@ -548,6 +692,10 @@ func keyForEvent(key string, value []byte, result *types.TxResult) []byte {
 	))
 }
 
+func keyForBlockEntries(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d", blockEntriesKey, height))
+}
+
 func keyForHeight(result *types.TxResult) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%d/%d",
 		types.TxHeightKey,
@@ -274,6 +274,115 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchProof(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexAllEvents())
+
+	// txs at heights 1 and 2, with owners Ana, Ivan, Ivan, Vlad
+	batch := txindex.NewBatch(4)
+	for i, owner := range []string{"Ana", "Ivan", "Ivan", "Vlad"} {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []kv.Pair{{Key: []byte("owner"), Value: []byte(owner)}}},
+			{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte(fmt.Sprint(i))}}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d", i))
+		txResult.Index = uint32(i)
+		require.NoError(t, batch.Add(txResult))
+	}
+	require.NoError(t, indexer.AddBatch(batch))
+	other := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("owner"), Value: []byte("Ivan")}}},
+	})
+	other.Height = 2
+	require.NoError(t, indexer.Index(other))
+
+	root, err := indexer.IndexRoot(1)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		q       string
+		matches []int
+	}{
+		{"tx.height = 1 AND account.owner = 'Ivan'", []int{1, 2}},
+		{"tx.height = 1 AND account.owner = 'Ana'", []int{0}},
+		{"tx.height = 1 AND account.owner = 'Vlad'", []int{3}},
+		{"tx.height = 1 AND account.owner = 'Boris'", []int{}},
+		{"tx.height = 1 AND account.owner = 'Zoe'", []int{}},
+		{"tx.height = 1 AND account.number = 2", []int{2}},
+	}
+	for _, tc := range testCases {
+		proofs, err := indexer.ProveSearch(query.MustParse(tc.q))
+		require.NoError(t, err, tc.q)
+		require.Len(t, proofs, 2, tc.q)
+
+		// the tx.height condition matches every tx of the block
+		hashes, err := proofs[0].Verify(root)
+		require.NoError(t, err, tc.q)
+		assert.Len(t, hashes, 4, tc.q)
+
+		hashes, err = proofs[1].Verify(root)
+		require.NoError(t, err, tc.q)
+		expected := make([][]byte, 0)
+		for _, i := range tc.matches {
+			expected = append(expected, types.Tx(fmt.Sprintf("tx%d", i)).Hash())
+		}
+		assert.ElementsMatch(t, expected, hashes, tc.q)
+
+		// omitting a match, or a neighbour, is caught
+		if len(proofs[1].Entries) > 1 {
+			omitted := *proofs[1]
+			omitted.Entries = omitted.Entries[1:]
+			omitted.Proof.Indices = omitted.Proof.Indices[1:]
+			_, err = omitted.Verify(root)
+			assert.Error(t, err, tc.q)
+		}
+
+		_, err = proofs[1].Verify([]byte("another root"))
+		assert.Error(t, err, tc.q)
+	}
+
+	// indexing the block again keeps its root
+	require.NoError(t, indexer.AddBatch(batch))
+	root1, err := indexer.IndexRoot(1)
+	require.NoError(t, err)
+	assert.Equal(t, root, root1)
+
+	// a block whose txs match no condition
+	batch = txindex.NewBatch(1)
+	require.NoError(t, batch.Add(&types.TxResult{Height: 3, Tx: types.Tx("tx3")}))
+	require.NoError(t, indexer.AddBatch(batch))
+	proofs, err := indexer.ProveSearch(query.MustParse("tx.height = 3 AND account.owner = 'Ivan'"))
+	require.NoError(t, err)
+	root3, err := indexer.IndexRoot(3)
+	require.NoError(t, err)
+	hashes, err := proofs[1].Verify(root3)
+	require.NoError(t, err)
+	assert.Empty(t, hashes)
+
+	// the block of a tx indexed on its own, or a block without txs, has no
+	// index root
+	_, err = indexer.ProveSearch(query.MustParse("tx.height = 2 AND account.owner = 'Ivan'"))
+	assert.Equal(t, txindex.ErrNoIndexProof, err)
+	_, err = indexer.IndexRoot(2)
+	assert.Equal(t, txindex.ErrNoIndexProof, err)
+	_, err = indexer.ProveSearch(query.MustParse("tx.height = 4"))
+	assert.Equal(t, txindex.ErrNoIndexProof, err)
+
+	// indexing a tx on its own drops the root of its block
+	other.Height = 1
+	require.NoError(t, indexer.Index(other))
+	_, err = indexer.IndexRoot(1)
+	assert.Equal(t, txindex.ErrNoIndexProof, err)
+
+	for _, q := range []string{
+		"account.owner = 'Ivan'",
+		"tx.height = 1 AND account.number > 1",
+		"tx.height = 1 AND account.owner CONTAINS 'Iv'",
+	} {
+		_, err := indexer.ProveSearch(query.MustParse(q))
+		assert.Equal(t, txindex.ErrUnprovableQuery, err, q)
+	}
+}
+
 func txResultWithEvents(events []abci.Event) *types.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &types.TxResult{
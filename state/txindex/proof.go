@@ -0,0 +1,163 @@
+package txindex
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+)
+
+// SearchProver is implemented by indexers which keep a Merkle tree over the
+// entries indexed for each block, and can prove that a search returned all
+// the matching transactions of a block.
+type SearchProver interface {
+	// ProveSearch returns a proof of all the entries matching each condition
+	// of the query, ErrUnprovableQuery if the indexer can't prove the query,
+	// or ErrNoIndexProof if it can't prove queries on the block.
+	ProveSearch(q *query.Query) ([]*RangeProof, error)
+}
+
+var (
+	// ErrUnprovableQuery indicates a query whose results can't be proven complete.
+	ErrUnprovableQuery = errors.New("query can't be proven")
+	// ErrNoIndexProof indicates a block whose index has no root to prove
+	// queries against.
+	ErrNoIndexProof = errors.New("no proof available for the block")
+)
+
+// RangeProof proves the entries with the given prefix in the index of the
+// block at Height are exactly the matching ones in Entries.
+//
+// The entries of a block are sorted by key, and the Merkle tree is built from
+// their merkle.KVPair encoding. So the matching entries are consecutive
+// leaves. Entries holds them, and the leaves right before and after them (if
+// any), which don't match. Proof proves the inclusion of all these leaves.
+type RangeProof struct {
+	Height   int64                   `json:"height"`
+	Prefix   string                  `json:"prefix"`
+	RootHash tmbytes.HexBytes        `json:"root_hash"`
+	Entries  []kv.Pair               `json:"entries"`
+	Proof    merkle.SimpleMultiProof `json:"proof"`
+}
+
+// EntriesHash returns the root hash of the Merkle tree over the given entries,
+// which must be sorted by key.
+func EntriesHash(entries []kv.Pair) []byte {
+	return merkle.SimpleHashFromByteSlices(entriesLeaves(entries))
+}
+
+// NewRangeProof returns a proof of the entries with the given prefix among the
+// entries of the block at height, which must be sorted by key.
+func NewRangeProof(height int64, prefix string, entries []kv.Pair) *RangeProof {
+	leaves := entriesLeaves(entries)
+	rp := &RangeProof{Height: height, Prefix: prefix, Entries: []kv.Pair{}}
+	if len(entries) == 0 {
+		// Nothing is indexed, so there's nothing to prove.
+		rp.RootHash = merkle.SimpleHashFromByteSlices(leaves)
+		return rp
+	}
+
+	// Find the matching entries, and their neighbours.
+	start := 0
+	for start < len(entries) && bytes.Compare(entries[start].Key, []byte(prefix)) < 0 {
+		start++
+	}
+	end := start
+	for end < len(entries) && bytes.HasPrefix(entries[end].Key, []byte(prefix)) {
+		end++
+	}
+	if start > 0 {
+		start--
+	}
+	if end < len(entries) {
+		end++
+	}
+	indices := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		indices = append(indices, i)
+	}
+
+	root, proof := merkle.SimpleMultiProofFromByteSlices(leaves, indices)
+	rp.RootHash = root
+	rp.Entries = entries[start:end]
+	rp.Proof = *proof
+	return rp
+}
+
+// Verify checks the proof against the index root of the block, and returns
+// the values (ie. the hashes of the txs) of the entries with the prefix.
+func (rp *RangeProof) Verify(rootHash []byte) ([][]byte, error) {
+	if !bytes.Equal(rootHash, rp.RootHash) {
+		return nil, errors.New("proof matches different index root")
+	}
+	if rp.Proof.Total == 0 {
+		if len(rp.Entries) != 0 || !bytes.Equal(rp.RootHash, merkle.SimpleHashFromByteSlices(nil)) {
+			return nil, errors.New("proof of an empty index has entries")
+		}
+		return [][]byte{}, nil
+	}
+
+	if len(rp.Entries) != len(rp.Proof.Indices) {
+		return nil, fmt.Errorf("proof has %d indices, but %d entries", len(rp.Proof.Indices), len(rp.Entries))
+	}
+	for i, idx := range rp.Proof.Indices {
+		if idx != rp.Proof.Indices[0]+i {
+			return nil, errors.New("proof is for non-consecutive entries")
+		}
+	}
+	if err := rp.Proof.Verify(rp.RootHash, entriesLeaves(rp.Entries)); err != nil {
+		return nil, fmt.Errorf("invalid proof: %v", err)
+	}
+
+	// The matching entries must be surrounded by non-matching ones, or the
+	// ends of the index.
+	var (
+		prefix   = []byte(rp.Prefix)
+		values   = make([][]byte, 0, len(rp.Entries))
+		first    = rp.Proof.Indices[0]
+		last     = rp.Proof.Indices[len(rp.Proof.Indices)-1]
+		hasLeft  bool
+		hasRight bool
+	)
+	for i, e := range rp.Entries {
+		if i > 0 && bytes.Compare(rp.Entries[i-1].Key, e.Key) >= 0 {
+			return nil, errors.New("entries are not sorted")
+		}
+		switch {
+		case bytes.HasPrefix(e.Key, prefix):
+			if hasRight {
+				return nil, errors.New("entries are not sorted")
+			}
+			values = append(values, e.Value)
+		case bytes.Compare(e.Key, prefix) < 0:
+			if i != 0 {
+				return nil, errors.New("entries are not sorted")
+			}
+			hasLeft = true
+		default:
+			if i != len(rp.Entries)-1 {
+				return nil, errors.New("entries are not sorted")
+			}
+			hasRight = true
+		}
+	}
+	if first > 0 && !hasLeft {
+		return nil, errors.New("proof misses the entry before the matching ones")
+	}
+	if last < rp.Proof.Total-1 && !hasRight {
+		return nil, errors.New("proof misses the entry after the matching ones")
+	}
+	return values, nil
+}
+
+func entriesLeaves(entries []kv.Pair) [][]byte {
+	leaves := make([][]byte, len(entries))
+	for i, e := range entries {
+		leaves[i] = merkle.KVPair(e).Bytes()
+	}
+	return leaves
+}
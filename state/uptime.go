@@ -0,0 +1,255 @@
+package state
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/bits"
+	"github.com/tendermint/tendermint/types"
+)
+
+// HeightRange is a range of heights, From and To included.
+type HeightRange struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// ValidatorUptime is the number of blocks a validator signed, for the block or
+// nil, and missed, over the heights it was a validator at in the window of an
+// UptimeTracker.
+type ValidatorUptime struct {
+	Address      crypto.Address `json:"address"`
+	Signed       int64          `json:"signed"`
+	Missed       int64          `json:"missed"`
+	MissedRanges []HeightRange  `json:"missed_ranges"`
+}
+
+// UptimeTracker keeps the signed and missed blocks of each validator over a
+// rolling window of the last heights, from the LastCommit of each block
+// applied by the BlockExecutor. It is safe for concurrent use.
+type UptimeTracker struct {
+	mtx    sync.RWMutex
+	window int64
+
+	// the heights in the window, in ascending order
+	heights []uptimeHeight
+	// by address
+	validators map[string]*validatorUptime
+
+	metrics      *Metrics
+	localAddress crypto.Address
+}
+
+// uptimeHeight is who signed the block at a height.
+type uptimeHeight struct {
+	height int64
+	// the addresses of the validators, shared by the heights with the same
+	// validators
+	addresses []crypto.Address
+	signed    *bits.BitArray
+}
+
+type validatorUptime struct {
+	signed int64
+	// the heights missed, in ascending order
+	missed []int64
+}
+
+// NewUptimeTracker returns a tracker of the last window heights.
+func NewUptimeTracker(window int64) *UptimeTracker {
+	return &UptimeTracker{
+		window:     window,
+		validators: make(map[string]*validatorUptime),
+		metrics:    NopMetrics(),
+	}
+}
+
+// SetMetrics reports the uptime of the validator with the given address, i.e.
+// the one of the node, to the metrics.
+func (t *UptimeTracker) SetMetrics(metrics *Metrics, localAddress crypto.Address) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.metrics = metrics
+	t.localAddress = localAddress
+}
+
+// Load records the heights of the window from the commits of the block store,
+// and the validators of the state db. It must be called before the blocks
+// are applied.
+func (t *UptimeTracker) Load(stateDB dbm.DB, blockStore BlockStoreRPC) error {
+	// The commit of the last block is in the next one.
+	to := blockStore.Height() - 1
+	from := to - t.window + 1
+	if from < 1 {
+		from = 1
+	}
+	for height := from; height <= to; height++ {
+		commit := blockStore.LoadBlockCommit(height)
+		if commit == nil {
+			continue
+		}
+		vals, err := LoadValidators(stateDB, height)
+		if err != nil {
+			return err
+		}
+		t.Record(height, commit, vals)
+	}
+	return nil
+}
+
+// Record records the signatures of the commit of the block at height, by the
+// given validators. Heights older than the last one recorded are ignored.
+func (t *UptimeTracker) Record(height int64, commit *types.Commit, vals *types.ValidatorSet) {
+	if t == nil || commit == nil || vals == nil ||
+		commit.Size() == 0 || commit.Size() != vals.Size() {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	var last *uptimeHeight
+	if len(t.heights) > 0 {
+		last = &t.heights[len(t.heights)-1]
+		if height <= last.height {
+			return
+		}
+	}
+
+	// Drop the heights out of the window.
+	n := 0
+	for n < len(t.heights) && t.heights[n].height <= height-t.window {
+		t.evict(t.heights[n])
+		n++
+	}
+	t.heights = t.heights[n:]
+
+	h := uptimeHeight{
+		height:    height,
+		addresses: make([]crypto.Address, vals.Size()),
+		signed:    bits.NewBitArray(vals.Size()),
+	}
+	for i, val := range vals.Validators {
+		h.addresses[i] = val.Address
+	}
+	if last != nil && sameAddresses(last.addresses, h.addresses) {
+		h.addresses = last.addresses
+	}
+	for i, address := range h.addresses {
+		key := string(address)
+		vu, ok := t.validators[key]
+		if !ok {
+			vu = &validatorUptime{}
+			t.validators[key] = vu
+		}
+		if commit.BlockIDFlag(i) == types.BlockIDFlagAbsent {
+			vu.missed = append(vu.missed, height)
+		} else {
+			vu.signed++
+			h.signed.SetIndex(i, true)
+		}
+	}
+	t.heights = append(t.heights, h)
+
+	if t.localAddress != nil {
+		// The gauge can't be deleted, so it is reset once the validator of the
+		// node left the set, rather than keeping its last value.
+		uptime := 0.0
+		if vu, ok := t.validators[string(t.localAddress)]; ok && h.has(t.localAddress) {
+			uptime = float64(vu.signed) / float64(vu.signed+int64(len(vu.missed)))
+		}
+		t.metrics.ValidatorUptime.With("validator_address", t.localAddress.String()).Set(uptime)
+	}
+}
+
+func (h uptimeHeight) has(address crypto.Address) bool {
+	for _, a := range h.addresses {
+		if bytes.Equal(a, address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *UptimeTracker) evict(h uptimeHeight) {
+	for i, address := range h.addresses {
+		key := string(address)
+		vu := t.validators[key]
+		if h.signed.GetIndex(i) {
+			vu.signed--
+		} else {
+			vu.missed = vu.missed[1:]
+		}
+		if vu.signed == 0 && len(vu.missed) == 0 {
+			delete(t.validators, key)
+		}
+	}
+}
+
+func sameAddresses(a, b []crypto.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Window returns the first and last heights recorded, or 0, 0 if none.
+func (t *UptimeTracker) Window() (from, to int64) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	if len(t.heights) == 0 {
+		return 0, 0
+	}
+	return t.heights[0].height, t.heights[len(t.heights)-1].height
+}
+
+// Uptime returns the uptime of the validator with the given address, or false
+// if it wasn't a validator in the window.
+func (t *UptimeTracker) Uptime(address crypto.Address) (ValidatorUptime, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	vu, ok := t.validators[string(address)]
+	if !ok {
+		return ValidatorUptime{}, false
+	}
+	return vu.uptime(address), true
+}
+
+// Uptimes returns the uptime of all the validators in the window, by address.
+func (t *UptimeTracker) Uptimes() []ValidatorUptime {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	uptimes := make([]ValidatorUptime, 0, len(t.validators))
+	for key, vu := range t.validators {
+		uptimes = append(uptimes, vu.uptime(crypto.Address(key)))
+	}
+	sort.Slice(uptimes, func(i, j int) bool {
+		return bytes.Compare(uptimes[i].Address, uptimes[j].Address) < 0
+	})
+	return uptimes
+}
+
+func (vu *validatorUptime) uptime(address crypto.Address) ValidatorUptime {
+	u := ValidatorUptime{
+		Address:      address,
+		Signed:       vu.signed,
+		Missed:       int64(len(vu.missed)),
+		MissedRanges: []HeightRange{},
+	}
+	for _, height := range vu.missed {
+		if n := len(u.MissedRanges); n > 0 && u.MissedRanges[n-1].To == height-1 {
+			u.MissedRanges[n-1].To = height
+			continue
+		}
+		u.MissedRanges = append(u.MissedRanges, HeightRange{From: height, To: height})
+	}
+	return u
+}
@@ -0,0 +1,132 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// makeUptimeCommit returns a commit of the validators, signed by the ones at
+// the given indexes.
+func makeUptimeCommit(height int64, vals *types.ValidatorSet, signers ...int) *types.Commit {
+	sigs := make([]types.CommitSig, vals.Size())
+	for i := range sigs {
+		sigs[i] = types.NewCommitSigAbsent()
+	}
+	for _, i := range signers {
+		sigs[i] = types.CommitSig{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: vals.Validators[i].Address,
+			Signature:        []byte("signature"),
+		}
+	}
+	return types.NewCommit(height, 0, types.BlockID{}, sigs)
+}
+
+// uptimeGauge keeps the last value set, whatever the labels.
+type uptimeGauge struct {
+	value float64
+}
+
+func (g *uptimeGauge) With(...string) metrics.Gauge { return g }
+func (g *uptimeGauge) Set(value float64)            { g.value = value }
+func (g *uptimeGauge) Add(delta float64)            { g.value += delta }
+
+func TestUptimeTracker(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	addr0, addr1, addr2 := vals.Validators[0].Address, vals.Validators[1].Address, vals.Validators[2].Address
+
+	// A nil tracker records nothing.
+	var tracker *sm.UptimeTracker
+	tracker.Record(1, makeUptimeCommit(1, vals, 0), vals)
+
+	tracker = sm.NewUptimeTracker(5)
+	from, to := tracker.Window()
+	assert.Zero(t, from)
+	assert.Zero(t, to)
+
+	// Validator 1 misses heights 2, 3 and 5, validator 2 misses all of them.
+	for height := int64(1); height <= 5; height++ {
+		signers := []int{0, 1}
+		if height == 2 || height == 3 || height == 5 {
+			signers = []int{0}
+		}
+		tracker.Record(height, makeUptimeCommit(height, vals, signers...), vals)
+	}
+	// Already recorded.
+	tracker.Record(5, makeUptimeCommit(5, vals, 0, 1, 2), vals)
+	// Not a commit of these validators.
+	tracker.Record(6, makeUptimeCommit(6, vals, 0), types.NewValidatorSet(vals.Validators[:1]))
+
+	from, to = tracker.Window()
+	assert.EqualValues(t, 1, from)
+	assert.EqualValues(t, 5, to)
+
+	uptime, ok := tracker.Uptime(addr1)
+	require.True(t, ok)
+	assert.EqualValues(t, 2, uptime.Signed)
+	assert.EqualValues(t, 3, uptime.Missed)
+	assert.Equal(t, []sm.HeightRange{{From: 2, To: 3}, {From: 5, To: 5}}, uptime.MissedRanges)
+
+	uptimes := tracker.Uptimes()
+	require.Len(t, uptimes, 3)
+	for i, u := range uptimes {
+		assert.Equal(t, vals.Validators[i].Address, u.Address)
+	}
+	assert.EqualValues(t, 5, uptimes[0].Signed)
+	assert.Empty(t, uptimes[0].MissedRanges)
+	assert.Equal(t, []sm.HeightRange{{From: 1, To: 5}}, uptimes[2].MissedRanges)
+
+	// Validator 2 leaves the set at height 7, the heights 1 and 2 leave the
+	// window.
+	newVals := types.NewValidatorSet([]*types.Validator{vals.Validators[0].Copy(), vals.Validators[1].Copy()})
+	tracker.Record(7, makeUptimeCommit(7, newVals, 0, 1), newVals)
+	from, to = tracker.Window()
+	assert.EqualValues(t, 3, from)
+	assert.EqualValues(t, 7, to)
+
+	uptime, ok = tracker.Uptime(addr0)
+	require.True(t, ok)
+	assert.EqualValues(t, 4, uptime.Signed)
+	uptime, ok = tracker.Uptime(addr1)
+	require.True(t, ok)
+	assert.EqualValues(t, 2, uptime.Signed)
+	assert.Equal(t, []sm.HeightRange{{From: 3, To: 3}, {From: 5, To: 5}}, uptime.MissedRanges)
+	uptime, ok = tracker.Uptime(addr2)
+	require.True(t, ok)
+	assert.EqualValues(t, 3, uptime.Missed)
+
+	// Once its heights leave the window, validator 2 is forgotten.
+	for height := int64(8); height <= 10; height++ {
+		tracker.Record(height, makeUptimeCommit(height, newVals, 0, 1), newVals)
+	}
+	_, ok = tracker.Uptime(addr2)
+	assert.False(t, ok)
+	assert.Len(t, tracker.Uptimes(), 2)
+}
+
+func TestUptimeTrackerMetrics(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	gauge := &uptimeGauge{}
+	tracker := sm.NewUptimeTracker(5)
+	tracker.SetMetrics(&sm.Metrics{BlockProcessingTime: discard.NewHistogram(), ValidatorUptime: gauge},
+		vals.Validators[0].Address)
+
+	tracker.Record(1, makeUptimeCommit(1, vals, 0, 1, 2), vals)
+	tracker.Record(2, makeUptimeCommit(2, vals, 1, 2), vals)
+	assert.Equal(t, 0.5, gauge.value)
+
+	// The uptime is reset once the validator of the node leaves the set,
+	// though it is still in the window.
+	newVals := types.NewValidatorSet([]*types.Validator{vals.Validators[1].Copy(), vals.Validators[2].Copy()})
+	tracker.Record(3, makeUptimeCommit(3, newVals, 0, 1), newVals)
+	assert.Zero(t, gauge.value)
+	_, ok := tracker.Uptime(vals.Validators[0].Address)
+	assert.True(t, ok)
+}
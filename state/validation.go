@@ -81,12 +81,12 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 
 	// Validate block LastCommit.
 	if block.Height == 1 {
-		if len(block.LastCommit.Signatures) != 0 {
+		if block.LastCommit.Size() != 0 {
 			return errors.New("block at height 1 can't have LastCommit signatures")
 		}
 	} else {
-		if len(block.LastCommit.Signatures) != state.LastValidators.Size() {
-			return types.NewErrInvalidCommitSignatures(state.LastValidators.Size(), len(block.LastCommit.Signatures))
+		if block.LastCommit.Size() != state.LastValidators.Size() {
+			return types.NewErrInvalidCommitSignatures(state.LastValidators.Size(), block.LastCommit.Size())
 		}
 		err := state.LastValidators.VerifyCommit(
 			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit)
@@ -104,12 +104,17 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 			)
 		}
 
-		medianTime := MedianTime(block.LastCommit, state.LastValidators)
-		if !block.Time.Equal(medianTime) {
-			return fmt.Errorf("invalid block time. Expected %v, got %v",
-				medianTime,
-				block.Time,
-			)
+		// The time of a block with an aggregated LastCommit is the proposer's,
+		// see AggregatedCommitTime. The consensus checks it's timely before
+		// prevoting the block.
+		if !block.LastCommit.Aggregated() {
+			medianTime := MedianTime(block.LastCommit, state.LastValidators)
+			if !block.Time.Equal(medianTime) {
+				return fmt.Errorf("invalid block time. Expected %v, got %v",
+					medianTime,
+					block.Time,
+				)
+			}
 		}
 	} else if block.Height == 1 {
 		genesisTime := state.LastBlockTime
@@ -130,6 +135,7 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 	}
 
 	// Validate all evidence.
+	rotations := make(KeyRotationSet)
 	for _, ev := range block.Evidence.Evidence {
 		if err := VerifyEvidence(stateDB, state, ev); err != nil {
 			return types.NewErrEvidenceInvalid(ev, err)
@@ -137,6 +143,11 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 		if evidencePool != nil && evidencePool.IsCommitted(ev) {
 			return types.NewErrEvidenceInvalid(ev, errors.New("evidence was already committed"))
 		}
+		if rot, ok := ev.(*types.KeyRotation); ok {
+			if err := rotations.Add(rot); err != nil {
+				return types.NewErrEvidenceInvalid(ev, err)
+			}
+		}
 	}
 
 	// NOTE: We can't actually verify it's the right proposer because we dont
@@ -157,6 +168,9 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 // - it is from a key who was a validator at the given height
 // - it is internally consistent
 // - it was properly signed by the alleged equivocator
+// - for key rotations, the validator's key hasn't changed since, it was signed
+// after the last rotation of the key took effect, and the new key is allowed
+// and unused
 func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
 	var (
 		height         = state.LastBlockHeight
@@ -198,5 +212,51 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 		return err
 	}
 
+	if rot, ok := evidence.(*types.KeyRotation); ok {
+		return verifyKeyRotation(stateDB, state, rot, val)
+	}
+
+	return nil
+}
+
+// verifyKeyRotation checks the key rotation, signed by val, still applies to
+// the next validator set. As the key may have been rotated back to val's key
+// since, the rotation must be signed after the last rotation took effect, so
+// it can't be replayed.
+func verifyKeyRotation(stateDB dbm.DB, state State, rot *types.KeyRotation, val *types.Validator) error {
+	_, next := state.NextValidators.GetByAddress(rot.ValidatorAddress)
+	if next == nil {
+		return fmt.Errorf("address %X is no longer a validator", rot.ValidatorAddress)
+	}
+	if !next.PubKey.Equals(val.PubKey) {
+		return fmt.Errorf("the key of validator %X changed since height %d", rot.ValidatorAddress, rot.SignedHeight)
+	}
+	if h := LoadKeyRotationHeight(stateDB, rot.ValidatorAddress); rot.SignedHeight < h {
+		return fmt.Errorf("the rotation was signed at height %d, before the last rotation of the key of validator %X "+
+			"took effect at height %d", rot.SignedHeight, rot.ValidatorAddress, h)
+	}
+	if !state.ConsensusParams.Validator.IsValidPubKey(rot.NewPubKey) {
+		return fmt.Errorf("new key %v is unsupported for consensus", rot.NewPubKey)
+	}
+	return state.NextValidators.VerifyKeyRotation(rot.ValidatorAddress, rot.NewPubKey)
+}
+
+// KeyRotationSet tracks the key rotations of a block, which must rotate the key
+// of a validator at most once and must not give two validators the same key.
+type KeyRotationSet map[string]struct{}
+
+// Add adds the rotation to the set, or returns an error if it conflicts with
+// one already added.
+func (set KeyRotationSet) Add(rot *types.KeyRotation) error {
+	validator := "validator/" + string(rot.ValidatorAddress)
+	if _, ok := set[validator]; ok {
+		return fmt.Errorf("the key of validator %X is rotated twice", rot.ValidatorAddress)
+	}
+	newKey := "key/" + string(rot.NewPubKey.Address())
+	if _, ok := set[newKey]; ok {
+		return fmt.Errorf("key %v is given to two validators", rot.NewPubKey)
+	}
+	set[validator] = struct{}{}
+	set[newKey] = struct{}{}
 	return nil
 }
@@ -171,6 +171,31 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit
 }
 
+// LoadExtendedCommit returns the extended commit saved with
+// SaveExtendedCommit for the given height, or nil if there is none, ie. for
+// the blocks which weren't committed by consensus.
+func (bs *BlockStore) LoadExtendedCommit(height int64) *types.ExtendedCommit {
+	var extCommit = new(types.ExtendedCommit)
+	bz, err := bs.db.Get(calcExtendedCommitKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	err = cdc.UnmarshalBinaryBare(bz, extCommit)
+	if err != nil {
+		panic(errors.Wrap(err, "Error reading block extended commit"))
+	}
+	return extCommit
+}
+
+// SaveExtendedCommit persists the seen commit of a block saved with
+// SaveBlock, along with the extensions of its precommits.
+func (bs *BlockStore) SaveExtendedCommit(extCommit *types.ExtendedCommit) {
+	bs.db.SetSync(calcExtendedCommitKey(extCommit.GetHeight()), cdc.MustMarshalBinaryBare(extCommit))
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -225,6 +250,59 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.db.SetSync(nil, nil)
 }
 
+// Repair makes the height saved in BlockStoreStateJSON the height of the
+// last complete block, for a store left inconsistent by a crash: incomplete
+// blocks at the top are dropped, and complete blocks saved above the height
+// are kept. It returns the height before and after the repair.
+func (bs *BlockStore) Repair() (int64, int64) {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+
+	old := bs.height
+	height := old
+	for height > 0 && !bs.hasBlock(height) {
+		height--
+	}
+	if height == old {
+		for bs.hasBlock(height + 1) {
+			height++
+		}
+	}
+	if height != old {
+		BlockStoreStateJSON{Height: height}.Save(bs.db)
+		bs.height = height
+	}
+	return old, height
+}
+
+// hasBlock returns whether everything SaveBlock writes for the block at the
+// given height is in the db.
+func (bs *BlockStore) hasBlock(height int64) bool {
+	blockMeta := bs.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return false
+	}
+	for i := 0; i < blockMeta.BlockID.PartsHeader.Total; i++ {
+		if ok, err := bs.db.Has(calcBlockPartKey(height, i)); err != nil {
+			panic(err)
+		} else if !ok {
+			return false
+		}
+	}
+	keys := [][]byte{calcSeenCommitKey(height)}
+	if height > 1 {
+		keys = append(keys, calcBlockCommitKey(height-1))
+	}
+	for _, key := range keys {
+		if ok, err := bs.db.Has(key); err != nil {
+			panic(err)
+		} else if !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
 	if height != bs.Height()+1 {
 		panic(fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v", bs.Height()+1, height))
@@ -251,6 +329,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtendedCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EC:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
@@ -396,6 +396,20 @@ func TestLoadBlockMeta(t *testing.T) {
 		"expecting successful retrieval of previously saved blockMeta")
 }
 
+func TestSaveLoadExtendedCommit(t *testing.T) {
+	bs, _ := freshBlockStore()
+	require.Nil(t, bs.LoadExtendedCommit(10))
+
+	extCommit := &types.ExtendedCommit{
+		Commit:              makeTestCommit(10, tmtime.Now()),
+		Extensions:          [][]byte{[]byte("extension")},
+		ExtensionSignatures: [][]byte{[]byte("signature")},
+	}
+	bs.SaveExtendedCommit(extCommit)
+	require.Equal(t, cdc.MustMarshalBinaryBare(extCommit), cdc.MustMarshalBinaryBare(bs.LoadExtendedCommit(10)))
+	require.Nil(t, bs.LoadExtendedCommit(9))
+}
+
 func TestBlockFetchAtHeight(t *testing.T) {
 	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
 	defer cleanup()
@@ -448,3 +462,37 @@ func newBlock(hdr types.Header, lastCommit *types.Commit) *types.Block {
 		LastCommit: lastCommit,
 	}
 }
+
+func TestBlockStoreRepair(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	lastCommit := new(types.Commit)
+	for height := int64(1); height <= 3; height++ {
+		block := makeBlock(height, state, lastCommit)
+		partSet := block.MakePartSet(2)
+		lastCommit = makeTestCommit(height, tmtime.Now())
+		bs.SaveBlock(block, partSet, lastCommit)
+	}
+
+	old, height := bs.Repair()
+	assert.EqualValues(t, 3, old)
+	assert.EqualValues(t, 3, height, "a consistent store shouldn't change")
+
+	// A crash before the height was saved: the complete block is kept.
+	BlockStoreStateJSON{Height: 2}.Save(bs.db)
+	bs = NewBlockStore(bs.db)
+	old, height = bs.Repair()
+	assert.EqualValues(t, 2, old)
+	assert.EqualValues(t, 3, height)
+	assert.EqualValues(t, 3, LoadBlockStoreStateJSON(bs.db).Height)
+
+	// A height saved before its block: the incomplete block is dropped.
+	err := bs.db.Delete(calcSeenCommitKey(3))
+	require.NoError(t, err)
+	old, height = bs.Repair()
+	assert.EqualValues(t, 3, old)
+	assert.EqualValues(t, 2, height)
+	assert.EqualValues(t, 2, bs.Height())
+	assert.EqualValues(t, 2, LoadBlockStoreStateJSON(bs.db).Height)
+}
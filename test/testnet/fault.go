@@ -0,0 +1,114 @@
+package testnet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FaultKind is the kind of a fault injected in a testnet.
+type FaultKind string
+
+const (
+	// FaultKill kills the processes of the nodes, without a graceful stop.
+	FaultKill FaultKind = "kill"
+	// FaultRestart starts the nodes killed before.
+	FaultRestart FaultKind = "restart"
+	// FaultPartition cuts the connections between the nodes and all the other
+	// nodes. Partitions accumulate until healed.
+	FaultPartition FaultKind = "partition"
+	// FaultFuzz delays the reads and writes on the connections of the nodes
+	// by up to Value, with p2p.FuzzConn.
+	FaultFuzz FaultKind = "fuzz"
+	// FaultHeal removes all the partitions and fuzzing.
+	FaultHeal FaultKind = "heal"
+	// FaultSkew restarts the nodes with their clock shifted by Value.
+	FaultSkew FaultKind = "skew"
+	// FaultDoubleSign starts a twin of each validator, with the same
+	// validator key and an empty sign state, so both sign votes for the
+	// same heights and rounds.
+	FaultDoubleSign FaultKind = "double-sign"
+)
+
+// defaultFuzzMaxDelay is the max delay of a fuzz fault without a value.
+const defaultFuzzMaxDelay = 100 * time.Millisecond
+
+// Fault is a fault injected in a testnet at a given time after the start.
+type Fault struct {
+	At    time.Duration
+	Kind  FaultKind
+	Nodes []int
+	Value time.Duration
+}
+
+// ParseFault parses a fault given as <at>:<kind>[:<nodes>[:<value>]], where
+// nodes is a comma-separated list of node indexes, e.g. "10s:partition:0,1",
+// "20s:heal", "30s:skew:2:5s" or "40s:fuzz:1:200ms".
+func ParseFault(s string) (Fault, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 4 {
+		return Fault{}, fmt.Errorf("invalid fault %q, expected <at>:<kind>[:<nodes>[:<value>]]", s)
+	}
+	at, err := time.ParseDuration(parts[0])
+	if err != nil {
+		return Fault{}, fmt.Errorf("invalid time of fault %q: %v", s, err)
+	}
+	f := Fault{At: at, Kind: FaultKind(parts[1])}
+	if len(parts) > 2 {
+		for _, node := range strings.Split(parts[2], ",") {
+			i, err := strconv.Atoi(node)
+			if err != nil || i < 0 {
+				return Fault{}, fmt.Errorf("invalid node %q in fault %q", node, s)
+			}
+			f.Nodes = append(f.Nodes, i)
+		}
+	}
+	if len(parts) > 3 {
+		if f.Value, err = time.ParseDuration(parts[3]); err != nil {
+			return Fault{}, fmt.Errorf("invalid value of fault %q: %v", s, err)
+		}
+	}
+
+	switch f.Kind {
+	case FaultHeal:
+		if len(f.Nodes) > 0 {
+			return Fault{}, fmt.Errorf("fault %q takes no nodes", s)
+		}
+	case FaultKill, FaultRestart, FaultPartition, FaultDoubleSign:
+		if len(f.Nodes) == 0 || len(parts) > 3 {
+			return Fault{}, fmt.Errorf("fault %q takes nodes and no value", s)
+		}
+	case FaultSkew:
+		if len(f.Nodes) == 0 || f.Value == 0 {
+			return Fault{}, fmt.Errorf("fault %q takes nodes and a skew", s)
+		}
+	case FaultFuzz:
+		if len(f.Nodes) == 0 {
+			return Fault{}, fmt.Errorf("fault %q takes nodes", s)
+		}
+		if f.Value <= 0 {
+			f.Value = defaultFuzzMaxDelay
+		}
+	default:
+		return Fault{}, fmt.Errorf("unknown fault %q, expected one of %s, %s, %s, %s, %s, %s or %s", f.Kind,
+			FaultKill, FaultRestart, FaultPartition, FaultFuzz, FaultHeal, FaultSkew, FaultDoubleSign)
+	}
+	return f, nil
+}
+
+// String returns the fault formatted like for ParseFault.
+func (f Fault) String() string {
+	s := fmt.Sprintf("%v:%s", f.At, f.Kind)
+	if len(f.Nodes) > 0 {
+		nodes := make([]string, len(f.Nodes))
+		for i, node := range f.Nodes {
+			nodes[i] = strconv.Itoa(node)
+		}
+		s += ":" + strings.Join(nodes, ",")
+	}
+	if f.Value != 0 {
+		s += ":" + f.Value.String()
+	}
+	return s
+}
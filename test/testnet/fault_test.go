@@ -0,0 +1,51 @@
+package testnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFault(t *testing.T) {
+	testCases := []struct {
+		s     string
+		fault Fault
+		err   bool
+	}{
+		{"10s:partition:0,1", Fault{At: 10 * time.Second, Kind: FaultPartition, Nodes: []int{0, 1}}, false},
+		{"20s:heal", Fault{At: 20 * time.Second, Kind: FaultHeal}, false},
+		{"30s:skew:2:5s", Fault{At: 30 * time.Second, Kind: FaultSkew, Nodes: []int{2}, Value: 5 * time.Second}, false},
+		{"30s:skew:2:-5s", Fault{At: 30 * time.Second, Kind: FaultSkew, Nodes: []int{2}, Value: -5 * time.Second}, false},
+		{"40s:fuzz:1", Fault{At: 40 * time.Second, Kind: FaultFuzz, Nodes: []int{1}, Value: defaultFuzzMaxDelay}, false},
+		{"40s:fuzz:1:200ms", Fault{At: 40 * time.Second, Kind: FaultFuzz, Nodes: []int{1}, Value: 200 * time.Millisecond}, false},
+		{"50s:double-sign:0", Fault{At: 50 * time.Second, Kind: FaultDoubleSign, Nodes: []int{0}}, false},
+
+		{"10s", Fault{}, true},
+		{"10s:kill:0:1s:2", Fault{}, true},
+		{"10:kill:0", Fault{}, true},
+		{"10s:explode:0", Fault{}, true},
+		{"10s:kill", Fault{}, true},
+		{"10s:kill:-1", Fault{}, true},
+		{"10s:kill:a", Fault{}, true},
+		{"10s:kill:0:1s", Fault{}, true},
+		{"10s:heal:0", Fault{}, true},
+		{"10s:skew:0", Fault{}, true},
+		{"10s:skew:0:x", Fault{}, true},
+	}
+	for _, tc := range testCases {
+		fault, err := ParseFault(tc.s)
+		if tc.err {
+			assert.Error(t, err, tc.s)
+			continue
+		}
+		require.NoError(t, err, tc.s)
+		assert.Equal(t, tc.fault, fault, tc.s)
+
+		// String is the inverse of ParseFault
+		fault2, err := ParseFault(fault.String())
+		require.NoError(t, err, tc.s)
+		assert.Equal(t, fault, fault2, tc.s)
+	}
+}
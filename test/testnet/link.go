@@ -0,0 +1,145 @@
+package testnet
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// link is a TCP proxy the connections of a node to another node go through,
+// so they can be cut or fuzzed.
+type link struct {
+	from, to int
+	target   string
+	listener net.Listener
+
+	mtx     sync.Mutex
+	blocked bool
+	fuzz    *cfg.FuzzConnConfig
+	conns   map[net.Conn]struct{}
+}
+
+// newLink listens on a free port of localhost for connections to target.
+func newLink(from, to int, target string) (*link, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	l := &link{
+		from:     from,
+		to:       to,
+		target:   target,
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go l.serve()
+	return l, nil
+}
+
+// Addr returns the address to dial instead of the target.
+func (l *link) Addr() string {
+	return l.listener.Addr().String()
+}
+
+// Close stops the link, and closes its connections.
+func (l *link) Close() error {
+	err := l.listener.Close()
+	l.closeConns()
+	return err
+}
+
+// setBlocked cuts the link, closing its connections and refusing new ones,
+// or restores it.
+func (l *link) setBlocked(blocked bool) {
+	l.mtx.Lock()
+	l.blocked = blocked
+	l.mtx.Unlock()
+	if blocked {
+		l.closeConns()
+	}
+}
+
+// setFuzz fuzzes the connections with the given config, or stops fuzzing
+// them if nil. If that changes the fuzzing, the connections are closed, so
+// they are made again with the new config.
+func (l *link) setFuzz(fuzz *cfg.FuzzConnConfig) {
+	l.mtx.Lock()
+	changed := l.fuzz != fuzz
+	l.fuzz = fuzz
+	l.mtx.Unlock()
+	if changed {
+		l.closeConns()
+	}
+}
+
+func (l *link) closeConns() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for conn := range l.conns {
+		conn.Close()
+		delete(l.conns, conn)
+	}
+}
+
+func (l *link) serve() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+		go l.handle(conn)
+	}
+}
+
+func (l *link) handle(conn net.Conn) {
+	l.mtx.Lock()
+	blocked, fuzz := l.blocked, l.fuzz
+	l.mtx.Unlock()
+	if blocked {
+		conn.Close()
+		return
+	}
+	targetConn, err := net.DialTimeout("tcp", l.target, time.Second)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	// The link may have been cut while dialing.
+	l.mtx.Lock()
+	if l.blocked {
+		l.mtx.Unlock()
+		conn.Close()
+		targetConn.Close()
+		return
+	}
+	l.conns[conn] = struct{}{}
+	l.conns[targetConn] = struct{}{}
+	l.mtx.Unlock()
+
+	var fuzzed net.Conn = conn
+	if fuzz != nil {
+		fuzzed = p2p.FuzzConnFromConfig(conn, fuzz)
+	}
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(targetConn, fuzzed) // nolint: errcheck
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(fuzzed, targetConn) // nolint: errcheck
+		done <- struct{}{}
+	}()
+	<-done
+
+	l.mtx.Lock()
+	delete(l.conns, conn)
+	delete(l.conns, targetConn)
+	l.mtx.Unlock()
+	conn.Close()
+	targetConn.Close()
+}
@@ -0,0 +1,65 @@
+package testnet
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLink(t *testing.T) {
+	// an echo server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn) // nolint: errcheck
+		}
+	}()
+
+	l, err := newLink(0, 1, listener.Addr().String())
+	require.NoError(t, err)
+	defer l.Close()
+
+	echo := func(conn net.Conn) error {
+		conn.SetDeadline(time.Now().Add(time.Second)) // nolint: errcheck
+		if _, err := conn.Write([]byte("ping")); err != nil {
+			return err
+		}
+		buf := make([]byte, 4)
+		_, err := io.ReadFull(conn, buf)
+		return err
+	}
+
+	conn, err := net.Dial("tcp", l.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, echo(conn))
+
+	// cutting the link closes the connection, and refuses new ones
+	l.setBlocked(true)
+	assert.Error(t, echo(conn))
+	conn2, err := net.Dial("tcp", l.Addr())
+	require.NoError(t, err)
+	defer conn2.Close()
+	assert.Error(t, echo(conn2))
+
+	// restoring it accepts new connections again
+	l.setBlocked(false)
+	conn3, err := net.Dial("tcp", l.Addr())
+	require.NoError(t, err)
+	defer conn3.Close()
+	require.NoError(t, echo(conn3))
+
+	// stopping fuzzing when not fuzzing keeps the connections
+	l.setFuzz(nil)
+	require.NoError(t, echo(conn3))
+}
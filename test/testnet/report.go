@@ -0,0 +1,178 @@
+package testnet
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// maxViolations is the max number of safety violations reported.
+const maxViolations = 10
+
+// NodeReport is the final state of a node.
+type NodeReport struct {
+	Name    string `json:"name"`
+	Running bool   `json:"running"`
+	Height  int64  `json:"height"`
+}
+
+// Report is the report of a testnet run.
+type Report struct {
+	Duration time.Duration `json:"duration"`
+	Faults   []string      `json:"faults"`
+	Nodes    []NodeReport  `json:"nodes"`
+
+	// Height is the height of the most advanced node.
+	Height int64 `json:"height"`
+	// MaxBlockInterval is the longest time between two blocks, according to
+	// their header time.
+	MaxBlockInterval time.Duration `json:"max_block_interval"`
+	TxsSent          int           `json:"txs_sent"`
+	TxsFailed        int           `json:"txs_failed"`
+	// TxsCommitted is the number of txs in the blocks, which may include
+	// txs whose broadcast failed, e.g. timed out on a fuzzed node.
+	TxsCommitted int `json:"txs_committed"`
+	// Evidence is the number of evidence committed, of the double signing
+	// of twins.
+	Evidence int `json:"evidence"`
+
+	// SafetyViolations are the heights at which the running nodes committed
+	// different blocks.
+	SafetyViolations []string `json:"safety_violations"`
+	// LivenessViolations are the running nodes which didn't commit a block in
+	// the liveness window.
+	LivenessViolations []string `json:"liveness_violations"`
+}
+
+// OK returns whether the safety and liveness invariants held.
+func (r *Report) OK() bool {
+	return len(r.SafetyViolations) == 0 && len(r.LivenessViolations) == 0
+}
+
+// String returns the report in a human readable form.
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Ran for %v with faults %v\n", r.Duration, r.Faults)
+	for _, node := range r.Nodes {
+		state := "running"
+		if !node.Running {
+			state = "stopped"
+		}
+		fmt.Fprintf(&b, "  %s: %s at height %d\n", node.Name, state, node.Height)
+	}
+	fmt.Fprintf(&b, "Committed %d blocks, max %v between blocks\n", r.Height, r.MaxBlockInterval)
+	fmt.Fprintf(&b, "Sent %d txs (%d failed), committed %d\n", r.TxsSent, r.TxsFailed, r.TxsCommitted)
+	fmt.Fprintf(&b, "Committed %d evidence\n", r.Evidence)
+	printViolations := func(name string, violations []string) {
+		if len(violations) == 0 {
+			fmt.Fprintf(&b, "%s: OK\n", name)
+			return
+		}
+		fmt.Fprintf(&b, "%s: FAILED\n", name)
+		for _, v := range violations {
+			fmt.Fprintf(&b, "  %s\n", v)
+		}
+	}
+	printViolations("Safety", r.SafetyViolations)
+	printViolations("Liveness", r.LivenessViolations)
+	return b.String()
+}
+
+// check fills the report from the running nodes, given their heights at the
+// start of the liveness window.
+func (tn *Testnet) check(r *Report, windowHeights map[*Node]int64) {
+	r.TxsSent, r.TxsFailed = tn.txsSent, tn.txsFailed
+
+	heights := tn.heights()
+	var (
+		running   []*Node
+		reference *Node
+		minHeight int64 = -1
+	)
+	for _, node := range tn.Nodes {
+		height, ok := heights[node]
+		r.Nodes = append(r.Nodes, NodeReport{Name: node.Name, Running: ok, Height: height})
+		if !ok {
+			continue
+		}
+		running = append(running, node)
+		if reference == nil || height > heights[reference] {
+			reference = node
+		}
+		if minHeight < 0 || height < minHeight {
+			minHeight = height
+		}
+
+		if windowHeight, ok := windowHeights[node]; ok && height <= windowHeight {
+			r.LivenessViolations = append(r.LivenessViolations, fmt.Sprintf(
+				"%s stayed at height %d in the last %v", node.Name, height, tn.config.LivenessWindow))
+		}
+	}
+	if reference == nil {
+		r.LivenessViolations = append(r.LivenessViolations, "no node is running")
+		return
+	}
+	r.Height = heights[reference]
+
+	// The blocks of the most advanced node, and those of the other nodes up
+	// to the lowest height, which must be the same.
+	metas := make(map[*Node][]*types.BlockMeta)
+	for _, node := range running {
+		maxHeight := minHeight
+		if node == reference {
+			maxHeight = r.Height
+		}
+		m, err := blockMetas(node, maxHeight)
+		if err != nil {
+			r.LivenessViolations = append(r.LivenessViolations, fmt.Sprintf(
+				"failed to get the blocks of %s: %v", node.Name, err))
+			return
+		}
+		metas[node] = m
+	}
+	for h := int64(1); h <= minHeight && len(r.SafetyViolations) < maxViolations; h++ {
+		want := metas[reference][h-1].BlockID.Hash
+		for _, node := range running {
+			if got := metas[node][h-1].BlockID.Hash; !bytes.Equal(got, want) {
+				r.SafetyViolations = append(r.SafetyViolations, fmt.Sprintf(
+					"height %d: %s committed block %X, %s committed block %X", h, reference.Name, want, node.Name, got))
+			}
+		}
+	}
+
+	for i, meta := range metas[reference] {
+		r.TxsCommitted += meta.NumTxs
+		if i > 0 {
+			if d := meta.Header.Time.Sub(metas[reference][i-1].Header.Time); d > r.MaxBlockInterval {
+				r.MaxBlockInterval = d
+			}
+		}
+		if len(meta.Header.EvidenceHash) > 0 {
+			height := meta.Header.Height
+			if block, err := reference.block(height); err == nil {
+				r.Evidence += len(block.Evidence.Evidence)
+			}
+		}
+	}
+}
+
+// blockMetas returns the metas of the blocks of the node from height 1 to
+// maxHeight.
+func blockMetas(node *Node, maxHeight int64) ([]*types.BlockMeta, error) {
+	metas := make([]*types.BlockMeta, 0, maxHeight)
+	for min := int64(1); min <= maxHeight; min += 20 {
+		max := min + 19
+		if max > maxHeight {
+			max = maxHeight
+		}
+		m, err := node.blockMetas(min, max)
+		if err != nil {
+			return nil, err
+		}
+		metas = append(metas, m...)
+	}
+	return metas, nil
+}
@@ -0,0 +1,716 @@
+// Package testnet runs a testnet of local nodes, as processes or in-process,
+// sends txs to them, injects faults and checks the safety and liveness of the
+// chain. It's used by "tendermint testnet run".
+package testnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	mempl "github.com/tendermint/tendermint/mempool"
+	nm "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Config is the configuration of a testnet.
+type Config struct {
+	// Dir is the directory of the homes of the nodes. It must not exist.
+	Dir string
+	// Binary is the tendermint binary running the nodes.
+	Binary string
+	// InProcess runs the nodes in this process with node.NewNode instead of
+	// as processes of Binary. Their RPC servers are disabled, as rpc/core
+	// serves a single node. Killed nodes are stopped rather than killed, and
+	// clock skew can't be injected, as the nodes share the clock.
+	InProcess bool
+	// Validators and NonValidators are the numbers of nodes.
+	Validators    int
+	NonValidators int
+	// ProxyApp is the ABCI app of the nodes, like the proxy_app option.
+	ProxyApp string
+	// Duration is the time the testnet runs for, once the first block is
+	// committed.
+	Duration time.Duration
+	// TxRate is the number of txs per second sent to the nodes.
+	TxRate int
+	// Faults are the faults injected during the run.
+	Faults []Fault
+	// LivenessWindow is the time at the end of the run, after all the
+	// faults, in which every running node must commit a block.
+	LivenessWindow time.Duration
+}
+
+// ValidateBasic performs basic validation.
+func (c Config) ValidateBasic() error {
+	if c.Validators < 1 {
+		return errors.New("at least one validator is required")
+	}
+	if c.NonValidators < 0 {
+		return errors.New("the number of non-validators can't be negative")
+	}
+	if c.TxRate < 0 {
+		return errors.New("the tx rate can't be negative")
+	}
+	if c.LivenessWindow <= 0 || c.LivenessWindow >= c.Duration {
+		return errors.New("the liveness window must be positive and shorter than the duration")
+	}
+	for _, f := range c.Faults {
+		if f.At > c.Duration-c.LivenessWindow {
+			return fmt.Errorf("fault %v is in the liveness window, after %v", f, c.Duration-c.LivenessWindow)
+		}
+		for _, node := range f.Nodes {
+			if node >= c.Validators+c.NonValidators {
+				return fmt.Errorf("fault %v is on an unknown node", f)
+			}
+			if f.Kind == FaultDoubleSign && node >= c.Validators {
+				return fmt.Errorf("fault %v is on a non-validator", f)
+			}
+		}
+		if f.Kind == FaultSkew && c.InProcess {
+			return fmt.Errorf("fault %v can't be injected in-process", f)
+		}
+	}
+	return nil
+}
+
+// Node is a node of a testnet, run as a process of Config.Binary, or
+// in-process.
+type Node struct {
+	Name      string
+	Validator bool
+	Home      string
+	ID        p2p.ID
+	P2PAddr   string
+	// RPCAddr is empty for the nodes run in-process.
+	RPCAddr string
+
+	config *cfg.Config
+	client *rpcclient.HTTP
+
+	mtx  sync.Mutex
+	cmd  *exec.Cmd
+	done chan struct{}
+	skew time.Duration
+
+	// The node run in-process, and its app and databases, which are kept
+	// across restarts.
+	node *nm.Node
+	app  proxy.ClientCreator
+	dbs  map[string]dbm.DB
+}
+
+// Running returns whether the node is running.
+func (n *Node) Running() bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.done == nil {
+		return false
+	}
+	select {
+	case <-n.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Testnet is a testnet of local nodes.
+type Testnet struct {
+	config Config
+	logger log.Logger
+
+	Nodes []*Node
+	twins []*Node
+	links []*link
+
+	txsSent   int
+	txsFailed int
+}
+
+// Setup writes the homes of the nodes in config.Dir, and starts the links
+// the nodes connect to each other through.
+func Setup(config Config, logger log.Logger) (*Testnet, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(config.Dir); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s exists", config.Dir)
+	}
+
+	tn := &Testnet{config: config, logger: logger}
+	genDoc := &types.GenesisDoc{
+		ChainID:         "testnet-" + tmrand.Str(6),
+		ConsensusParams: types.DefaultConsensusParams(),
+		GenesisTime:     tmtime.Now(),
+	}
+	for i := 0; i < config.Validators+config.NonValidators; i++ {
+		node, err := tn.newNode(fmt.Sprintf("node%d", i), i < config.Validators)
+		if err != nil {
+			return nil, err
+		}
+		if node.Validator {
+			pubKey := privval.LoadFilePV(node.config.PrivValidatorKeyFile(),
+				node.config.PrivValidatorStateFile()).GetPubKey()
+			genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{
+				Address: pubKey.Address(),
+				PubKey:  pubKey,
+				Power:   1,
+				Name:    node.Name,
+			})
+		}
+		tn.Nodes = append(tn.Nodes, node)
+	}
+
+	// Each node dials the others through a link.
+	for i, node := range tn.Nodes {
+		var peers []string
+		for j, peer := range tn.Nodes {
+			if i == j {
+				continue
+			}
+			l, err := newLink(i, j, peer.P2PAddr)
+			if err != nil {
+				tn.closeLinks()
+				return nil, err
+			}
+			tn.links = append(tn.links, l)
+			peers = append(peers, p2p.IDAddressString(peer.ID, l.Addr()))
+		}
+		node.config.P2P.PersistentPeers = strings.Join(peers, ",")
+		if err := genDoc.SaveAs(node.config.GenesisFile()); err != nil {
+			tn.closeLinks()
+			return nil, err
+		}
+		cfg.WriteConfigFile(filepath.Join(node.Home, "config", "config.toml"), node.config)
+	}
+	return tn, nil
+}
+
+// newNode generates the keys of a node, and its config with free ports.
+func (tn *Testnet) newNode(name string, validator bool) (*Node, error) {
+	home := filepath.Join(tn.config.Dir, name)
+	config := cfg.DefaultConfig()
+	config.SetRoot(home)
+	cfg.EnsureRoot(home)
+
+	if validator {
+		privval.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()).Save()
+	}
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	if err != nil {
+		return nil, err
+	}
+	p2pPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	rpcPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	config.Moniker = name
+	config.ProxyApp = tn.config.ProxyApp
+	config.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", p2pPort)
+	config.P2P.PexReactor = false
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+	config.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", rpcPort)
+
+	var client *rpcclient.HTTP
+	if tn.config.InProcess {
+		config.RPC.ListenAddress = ""
+	} else {
+		client, err = rpcclient.NewHTTP(config.RPC.ListenAddress, "/websocket")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Node{
+		Name:      name,
+		Validator: validator,
+		Home:      home,
+		ID:        nodeKey.ID(),
+		P2PAddr:   fmt.Sprintf("127.0.0.1:%d", p2pPort),
+		RPCAddr:   config.RPC.ListenAddress,
+		config:    config,
+		client:    client,
+	}, nil
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// Run starts the nodes, waits for the first block, then sends txs and
+// injects the faults for config.Duration, and stops the nodes. It returns
+// the report of the run.
+func (tn *Testnet) Run(ctx context.Context) (*Report, error) {
+	defer tn.closeLinks()
+	defer tn.stopAll()
+
+	for _, node := range tn.Nodes {
+		if err := tn.start(node); err != nil {
+			return nil, err
+		}
+	}
+	if err := tn.waitForFirstBlock(ctx, time.Minute); err != nil {
+		return nil, err
+	}
+	tn.logger.Info("Testnet started", "nodes", len(tn.Nodes), "duration", tn.config.Duration)
+
+	ctx, cancel := context.WithTimeout(ctx, tn.config.Duration)
+	defer cancel()
+	var wg sync.WaitGroup
+	if tn.config.TxRate > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tn.sendTxs(ctx)
+		}()
+	}
+
+	report := &Report{Duration: tn.config.Duration}
+	faults := make([]Fault, len(tn.config.Faults))
+	copy(faults, tn.config.Faults)
+	sort.SliceStable(faults, func(i, j int) bool { return faults[i].At < faults[j].At })
+
+	start := time.Now()
+	var windowHeights map[*Node]int64
+	windowStart := tn.config.Duration - tn.config.LivenessWindow
+	for windowHeights == nil {
+		next := windowStart
+		if len(faults) > 0 && faults[0].At <= windowStart {
+			next = faults[0].At
+		}
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		case <-time.After(time.Until(start.Add(next))):
+		}
+		if len(faults) > 0 && faults[0].At == next {
+			f := faults[0]
+			faults = faults[1:]
+			tn.logger.Info("Injecting fault", "fault", f)
+			if err := tn.inject(f); err != nil {
+				wg.Wait()
+				return nil, fmt.Errorf("failed to inject fault %v: %v", f, err)
+			}
+			report.Faults = append(report.Faults, f.String())
+			continue
+		}
+		windowHeights = tn.heights()
+	}
+
+	<-ctx.Done()
+	wg.Wait()
+	tn.check(report, windowHeights)
+	return report, nil
+}
+
+func (tn *Testnet) waitForFirstBlock(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		for _, node := range tn.Nodes {
+			if height, err := node.height(); err == nil && height > 0 {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return errors.New("timed out waiting for the first block, see the node.log file of the nodes")
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// start starts the node, logging to node.log in its home.
+func (tn *Testnet) start(node *Node) error {
+	node.mtx.Lock()
+	defer node.mtx.Unlock()
+
+	logFile, err := os.OpenFile(filepath.Join(node.Home, "node.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if tn.config.InProcess {
+		if err := node.startInProcess(logFile); err != nil {
+			logFile.Close()
+			return err
+		}
+		return nil
+	}
+	args := []string{"node", "--home", node.Home}
+	if node.skew != 0 {
+		args = append(args, "--clock-skew", node.skew.String())
+	}
+	cmd := exec.Command(tn.config.Binary, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		logFile.Close()
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		cmd.Wait() // nolint: errcheck
+		logFile.Close()
+		close(done)
+	}()
+	node.cmd = cmd
+	node.done = done
+	return nil
+}
+
+// startInProcess creates and starts the node in-process. The node must be
+// locked.
+func (node *Node) startInProcess(logFile *os.File) error {
+	nodeKey, err := p2p.LoadNodeKey(node.config.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+	if node.app == nil {
+		node.app = proxy.DefaultClientCreator(node.config.ProxyApp, node.config.ABCI,
+			node.config.DBDir(), node.config.ABCIConcurrency)
+		node.dbs = make(map[string]dbm.DB)
+	}
+	// The databases aren't closed when the node stops, so they're reused by
+	// the next start.
+	dbProvider := func(ctx *nm.DBContext) (dbm.DB, error) {
+		if db, ok := node.dbs[ctx.ID]; ok {
+			return db, nil
+		}
+		db, err := nm.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		node.dbs[ctx.ID] = db
+		return db, nil
+	}
+	n, err := nm.NewNode(node.config,
+		privval.LoadFilePV(node.config.PrivValidatorKeyFile(), node.config.PrivValidatorStateFile()),
+		nodeKey,
+		node.app,
+		nm.DefaultGenesisDocProviderFunc(node.config),
+		dbProvider,
+		nm.DefaultMetricsProvider(node.config.Instrumentation),
+		log.NewTMLogger(log.NewSyncWriter(logFile)),
+	)
+	if err != nil {
+		return err
+	}
+	if err := n.Start(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		// Like "tendermint node", stop if the validator runs elsewhere.
+		select {
+		case <-n.Quit():
+		case <-n.DoubleSignRisk():
+			n.Stop() // nolint: errcheck
+		}
+		logFile.Close()
+		close(done)
+	}()
+	node.node = n
+	node.done = done
+	return nil
+}
+
+// kill kills the process of the node, or stops the node run in-process.
+func (tn *Testnet) kill(node *Node) {
+	if !node.Running() {
+		return
+	}
+	if node.node != nil {
+		node.node.Stop() // nolint: errcheck
+	} else {
+		node.cmd.Process.Kill() // nolint: errcheck
+	}
+	<-node.done
+}
+
+// stopAll stops the nodes gracefully, killing those which don't stop in
+// time.
+func (tn *Testnet) stopAll() {
+	nodes := append(append([]*Node{}, tn.Nodes...), tn.twins...)
+	for _, node := range nodes {
+		if !node.Running() {
+			continue
+		}
+		if node.node != nil {
+			node.node.Stop() // nolint: errcheck
+		} else {
+			node.cmd.Process.Signal(syscall.SIGTERM) // nolint: errcheck
+		}
+	}
+	for _, node := range nodes {
+		if node.done == nil {
+			continue
+		}
+		select {
+		case <-node.done:
+		case <-time.After(10 * time.Second):
+			tn.kill(node)
+		}
+	}
+}
+
+func (tn *Testnet) closeLinks() {
+	for _, l := range tn.links {
+		l.Close()
+	}
+}
+
+// inject injects the fault.
+func (tn *Testnet) inject(f Fault) error {
+	switch f.Kind {
+	case FaultKill:
+		for _, i := range f.Nodes {
+			tn.kill(tn.Nodes[i])
+		}
+	case FaultRestart:
+		for _, i := range f.Nodes {
+			if !tn.Nodes[i].Running() {
+				if err := tn.start(tn.Nodes[i]); err != nil {
+					return err
+				}
+			}
+		}
+	case FaultSkew:
+		for _, i := range f.Nodes {
+			node := tn.Nodes[i]
+			tn.kill(node)
+			node.skew = f.Value
+			if err := tn.start(node); err != nil {
+				return err
+			}
+		}
+	case FaultPartition:
+		isolated := make(map[int]bool)
+		for _, i := range f.Nodes {
+			isolated[i] = true
+		}
+		for _, l := range tn.links {
+			if isolated[l.from] != isolated[l.to] {
+				l.setBlocked(true)
+			}
+		}
+	case FaultFuzz:
+		fuzzed := make(map[int]bool)
+		for _, i := range f.Nodes {
+			fuzzed[i] = true
+		}
+		fuzz := cfg.DefaultFuzzConnConfig()
+		fuzz.Mode = cfg.FuzzModeDelay
+		fuzz.MaxDelay = f.Value
+		for _, l := range tn.links {
+			if fuzzed[l.from] || fuzzed[l.to] {
+				l.setFuzz(fuzz)
+			}
+		}
+	case FaultHeal:
+		for _, l := range tn.links {
+			l.setBlocked(false)
+			l.setFuzz(nil)
+		}
+	case FaultDoubleSign:
+		for _, i := range f.Nodes {
+			if err := tn.startTwin(tn.Nodes[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// startTwin starts a node with the validator key of the given node, a new
+// node key and an empty sign state, connected directly to all the nodes.
+func (tn *Testnet) startTwin(node *Node) error {
+	twin, err := tn.newNode(fmt.Sprintf("%s-twin%d", node.Name, len(tn.twins)), true)
+	if err != nil {
+		return err
+	}
+	keyJSON, err := ioutil.ReadFile(node.config.PrivValidatorKeyFile())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(twin.config.PrivValidatorKeyFile(), keyJSON, 0600); err != nil {
+		return err
+	}
+	privval.LoadFilePVEmptyState(twin.config.PrivValidatorKeyFile(), twin.config.PrivValidatorStateFile()).Save()
+	genDoc, err := ioutil.ReadFile(node.config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(twin.config.GenesisFile(), genDoc, 0644); err != nil {
+		return err
+	}
+
+	var peers []string
+	for _, peer := range tn.Nodes {
+		peers = append(peers, p2p.IDAddressString(peer.ID, peer.P2PAddr))
+	}
+	twin.config.P2P.PersistentPeers = strings.Join(peers, ",")
+	cfg.WriteConfigFile(filepath.Join(twin.Home, "config", "config.toml"), twin.config)
+
+	tn.twins = append(tn.twins, twin)
+	return tn.start(twin)
+}
+
+// sendTxs sends config.TxRate txs per second to the running nodes, in turn,
+// until ctx is done.
+func (tn *Testnet) sendTxs(ctx context.Context) {
+	ticker := time.NewTicker(time.Second / time.Duration(tn.config.TxRate))
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		node := tn.Nodes[i%len(tn.Nodes)]
+		if !node.Running() {
+			continue
+		}
+		tx := types.Tx(fmt.Sprintf("tx%d=%X", i, tmrand.Bytes(8)))
+		if err := node.broadcastTx(tx); err == nil {
+			tn.txsSent++
+		} else {
+			tn.txsFailed++
+		}
+	}
+}
+
+// heights returns the heights of the running nodes.
+func (tn *Testnet) heights() map[*Node]int64 {
+	heights := make(map[*Node]int64)
+	for _, node := range tn.Nodes {
+		if !node.Running() {
+			continue
+		}
+		if height, err := node.height(); err == nil {
+			heights[node] = height
+		}
+	}
+	return heights
+}
+
+// inProcess returns the node run in-process, or nil if it runs as a process.
+func (n *Node) inProcess() *nm.Node {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.node
+}
+
+// height returns the height of the last block committed by the node.
+func (n *Node) height() (int64, error) {
+	if node := n.inProcess(); node != nil {
+		return node.BlockStore().Height(), nil
+	}
+	status, err := n.client.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// broadcastTx adds tx to the mempool of the node, and returns an error if
+// it's rejected.
+func (n *Node) broadcastTx(tx types.Tx) error {
+	if node := n.inProcess(); node != nil {
+		resCh := make(chan *abci.Response, 1)
+		err := node.Mempool().CheckTx(tx, func(res *abci.Response) {
+			resCh <- res
+		}, mempl.TxInfo{})
+		if err != nil {
+			return err
+		}
+		if res := (<-resCh).GetCheckTx(); res.IsErr() {
+			return fmt.Errorf("tx rejected with code %d: %s", res.Code, res.Log)
+		}
+		return nil
+	}
+	res, err := n.client.BroadcastTxSync(tx)
+	if err != nil {
+		return err
+	}
+	if res.Code != abci.CodeTypeOK {
+		return fmt.Errorf("tx rejected with code %d: %s", res.Code, res.Log)
+	}
+	return nil
+}
+
+// blockMetas returns the metas of the blocks of the node from minHeight to
+// maxHeight, in ascending order.
+func (n *Node) blockMetas(minHeight, maxHeight int64) ([]*types.BlockMeta, error) {
+	if node := n.inProcess(); node != nil {
+		metas := make([]*types.BlockMeta, 0, maxHeight-minHeight+1)
+		for h := minHeight; h <= maxHeight; h++ {
+			meta := node.BlockStore().LoadBlockMeta(h)
+			if meta == nil {
+				return nil, fmt.Errorf("no block at height %d", h)
+			}
+			metas = append(metas, meta)
+		}
+		return metas, nil
+	}
+	res, err := n.client.BlockchainInfo(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(res.BlockMetas)) != maxHeight-minHeight+1 {
+		return nil, fmt.Errorf("got %d blocks from %d to %d", len(res.BlockMetas), minHeight, maxHeight)
+	}
+	// The metas are in descending order.
+	metas := make([]*types.BlockMeta, 0, len(res.BlockMetas))
+	for i := len(res.BlockMetas) - 1; i >= 0; i-- {
+		metas = append(metas, res.BlockMetas[i])
+	}
+	return metas, nil
+}
+
+// block returns the block of the node at height.
+func (n *Node) block(height int64) (*types.Block, error) {
+	if node := n.inProcess(); node != nil {
+		block := node.BlockStore().LoadBlock(height)
+		if block == nil {
+			return nil, fmt.Errorf("no block at height %d", height)
+		}
+		return block, nil
+	}
+	res, err := n.client.Block(&height, false)
+	if err != nil {
+		return nil, err
+	}
+	return res.Block, nil
+}
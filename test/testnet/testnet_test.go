@@ -0,0 +1,61 @@
+package testnet
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestRunInProcess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the testnet run in short mode")
+	}
+	dir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := Config{
+		Dir:            filepath.Join(dir, "net"),
+		InProcess:      true,
+		Validators:     3,
+		ProxyApp:       "kvstore",
+		Duration:       15 * time.Second,
+		TxRate:         10,
+		LivenessWindow: 7 * time.Second,
+		Faults: []Fault{
+			{At: 2 * time.Second, Kind: FaultPartition, Nodes: []int{2}},
+			{At: 5 * time.Second, Kind: FaultHeal},
+		},
+	}
+	tn, err := Setup(config, log.TestingLogger())
+	require.NoError(t, err)
+	report, err := tn.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, report.OK(), report.String())
+	assert.NotZero(t, report.TxsCommitted)
+	for _, node := range report.Nodes {
+		assert.True(t, node.Running, node.Name)
+	}
+}
+
+func TestInProcessRefusesClockSkew(t *testing.T) {
+	config := Config{
+		InProcess:      true,
+		Validators:     1,
+		Duration:       time.Minute,
+		LivenessWindow: 10 * time.Second,
+		Faults:         []Fault{{At: time.Second, Kind: FaultSkew, Nodes: []int{0}, Value: time.Second}},
+	}
+	assert.Error(t, config.ValidateBasic())
+	config.InProcess = false
+	assert.NoError(t, config.ValidateBasic())
+}
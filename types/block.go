@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/bits"
@@ -553,6 +554,12 @@ func (cs CommitSig) ValidateBasic() error {
 
 // Commit contains the evidence that a block was committed by a set of validators.
 // NOTE: Commit is empty for height 1, but never nil.
+//
+// If all the validators have BLS12-381 keys, the commit included in a block
+// may be aggregated (see VoteSet.MakeAggregatedCommit): it only keeps the
+// precommits for BlockID, with a bit per validator in Signers, and the
+// aggregate of their AggregationSignatures in AggregatedSignature, instead of
+// Signatures.
 type Commit struct {
 	// NOTE: The signatures are in order of address to preserve the bonded
 	// ValidatorSet order.
@@ -563,6 +570,9 @@ type Commit struct {
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
 
+	Signers             *bits.BitArray `json:"signers,omitempty"`
+	AggregatedSignature []byte         `json:"aggregated_signature,omitempty"`
+
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
 	// unmarshaling.
@@ -580,8 +590,21 @@ func NewCommit(height int64, round int, blockID BlockID, commitSigs []CommitSig)
 	}
 }
 
+// NewAggregatedCommit returns a new aggregated Commit.
+func NewAggregatedCommit(height int64, round int, blockID BlockID, signers *bits.BitArray, aggSig []byte) *Commit {
+	return &Commit{
+		Height:              height,
+		Round:               round,
+		BlockID:             blockID,
+		Signers:             signers,
+		AggregatedSignature: aggSig,
+	}
+}
+
 // CommitToVoteSet constructs a VoteSet from the Commit and validator set.
 // Panics if signatures from the commit can't be added to the voteset.
+// The precommits of an aggregated commit are not kept, so the VoteSet is
+// empty.
 // Inverse of VoteSet.MakeCommit().
 func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
@@ -597,9 +620,27 @@ func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSe
 	return voteSet
 }
 
+// ExtendedCommitToVoteSet is CommitToVoteSet for an ExtendedCommit: the votes
+// keep their extensions.
+func ExtendedCommitToVoteSet(chainID string, extCommit *ExtendedCommit, vals *ValidatorSet) *VoteSet {
+	commit := extCommit.Commit
+	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.Absent() {
+			continue // OK, some precommits can be missing.
+		}
+		added, err := voteSet.AddVote(extCommit.GetByIndex(idx))
+		if !added || err != nil {
+			panic(fmt.Sprintf("Failed to reconstruct LastCommit: %v", err))
+		}
+	}
+	return voteSet
+}
+
 // GetVote converts the CommitSig for the given valIdx to a Vote.
 // Returns nil if the precommit at valIdx is nil.
-// Panics if valIdx >= commit.Size().
+// Panics if valIdx >= commit.Size(), or if the commit is aggregated, since its
+// precommits are not kept.
 func (commit *Commit) GetVote(valIdx int) *Vote {
 	commitSig := commit.Signatures[valIdx]
 	return &Vote{
@@ -614,6 +655,39 @@ func (commit *Commit) GetVote(valIdx int) *Vote {
 	}
 }
 
+// Aggregated returns true if the signatures of the commit were aggregated.
+func (commit *Commit) Aggregated() bool {
+	return commit != nil && len(commit.AggregatedSignature) != 0
+}
+
+// BlockIDFlag returns the BlockIDFlag of the precommit of the validator at
+// valIdx. The precommits for nil are not kept in an aggregated commit, so
+// they're reported as absent.
+// Panics if valIdx >= commit.Size().
+func (commit *Commit) BlockIDFlag(valIdx int) BlockIDFlag {
+	if commit.Aggregated() {
+		if valIdx >= commit.Signers.Size() {
+			panic(fmt.Sprintf("validator index %d out of range for %d signers", valIdx, commit.Signers.Size()))
+		}
+		if commit.Signers.GetIndex(valIdx) {
+			return BlockIDFlagCommit
+		}
+		return BlockIDFlagAbsent
+	}
+	return commit.Signatures[valIdx].BlockIDFlag
+}
+
+// AggregateSignBytes returns the bytes signed over by all the signers of an
+// aggregated commit, see Vote.AggregationSignBytes.
+func (commit *Commit) AggregateSignBytes(chainID string) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(
+		CanonicalizeAggregatePrecommit(chainID, commit.Height, commit.Round, commit.BlockID))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
 // VoteSignBytes constructs the SignBytes for the given CommitSig.
 // The only unique part of the SignBytes is the Timestamp - all other fields
 // signed over are otherwise the same for all validators.
@@ -640,18 +714,26 @@ func (commit *Commit) GetRound() int {
 	return commit.Round
 }
 
-// Size returns the number of signatures in the commit.
+// Size returns the number of signatures in the commit, or the size of the
+// validator set if it is aggregated.
 // Implements VoteSetReader.
 func (commit *Commit) Size() int {
 	if commit == nil {
 		return 0
 	}
+	if commit.Aggregated() {
+		return commit.Signers.Size()
+	}
 	return len(commit.Signatures)
 }
 
 // BitArray returns a BitArray of which validators voted for BlockID or nil in this commit.
+// An aggregated commit only has the validators which voted for BlockID.
 // Implements VoteSetReader.
 func (commit *Commit) BitArray() *bits.BitArray {
+	if commit.Aggregated() {
+		return commit.Signers
+	}
 	if commit.bitArray == nil {
 		commit.bitArray = bits.NewBitArray(len(commit.Signatures))
 		for i, commitSig := range commit.Signatures {
@@ -673,6 +755,9 @@ func (commit *Commit) GetByIndex(valIdx int) *Vote {
 // IsCommit returns true if there is at least one signature.
 // Implements VoteSetReader.
 func (commit *Commit) IsCommit() bool {
+	if commit.Aggregated() {
+		return !commit.Signers.IsEmpty()
+	}
 	return len(commit.Signatures) != 0
 }
 
@@ -690,6 +775,13 @@ func (commit *Commit) ValidateBasic() error {
 		return errors.New("commit cannot be for nil block")
 	}
 
+	if commit.Aggregated() {
+		return commit.validateBasicAggregated()
+	}
+	if commit.Signers != nil {
+		return errors.New("signers in a commit that is not aggregated")
+	}
+
 	if len(commit.Signatures) == 0 {
 		return errors.New("no signatures in commit")
 	}
@@ -702,6 +794,23 @@ func (commit *Commit) ValidateBasic() error {
 	return nil
 }
 
+func (commit *Commit) validateBasicAggregated() error {
+	if len(commit.Signatures) != 0 {
+		return errors.New("signatures in an aggregated commit")
+	}
+	if commit.Signers == nil || commit.Signers.IsEmpty() {
+		return errors.New("no signers in aggregated commit")
+	}
+	if len(commit.Signers.Elems) != (commit.Signers.Bits+63)/64 {
+		return fmt.Errorf("signers has %d elements for %d bits", len(commit.Signers.Elems), commit.Signers.Bits)
+	}
+	if len(commit.AggregatedSignature) != bls12381.SignatureSize {
+		return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+			bls12381.SignatureSize, len(commit.AggregatedSignature))
+	}
+	return nil
+}
+
 // Hash returns the hash of the commit
 func (commit *Commit) Hash() tmbytes.HexBytes {
 	if commit == nil {
@@ -712,6 +821,9 @@ func (commit *Commit) Hash() tmbytes.HexBytes {
 		for i, commitSig := range commit.Signatures {
 			bs[i] = cdcEncode(commitSig)
 		}
+		if commit.Aggregated() {
+			bs = append(bs, cdcEncode(commit.Signers), cdcEncode(commit.AggregatedSignature))
+		}
 		commit.hash = merkle.SimpleHashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -732,17 +844,95 @@ func (commit *Commit) StringIndented(indent string) string {
 %s  BlockID:    %v
 %s  Signatures:
 %s    %v
+%s  Signers:    %v
+%s  AggregatedSignature: %X
 %s}#%v`,
 		indent, commit.Height,
 		indent, commit.Round,
 		indent, commit.BlockID,
 		indent,
 		indent, strings.Join(commitSigStrings, "\n"+indent+"    "),
+		indent, commit.Signers,
+		indent, tmbytes.Fingerprint(commit.AggregatedSignature),
 		indent, commit.hash)
 }
 
 //-----------------------------------------------------------------------------
 
+// ExtendedCommit is a Commit along with the extensions of its precommits,
+// which the Commit doesn't keep. It is saved next to the seen commit, so the
+// precommits can still be sent with their extensions to the peers catching
+// up, or after a restart, and be accepted.
+type ExtendedCommit struct {
+	Commit *Commit `json:"commit"`
+	// Extensions and ExtensionSignatures are indexed by validator index, and
+	// nil for the precommits without an extension.
+	Extensions          [][]byte `json:"extensions"`
+	ExtensionSignatures [][]byte `json:"extension_signatures"`
+	// AggregationSignatures are indexed by validator index too, so the commit
+	// can still be aggregated. See Vote.AggregationSignature.
+	AggregationSignatures [][]byte `json:"aggregation_signatures,omitempty"`
+}
+
+// GetHeight returns height of the commit.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) GetHeight() int64 {
+	return extCommit.Commit.GetHeight()
+}
+
+// GetRound returns round of the commit.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) GetRound() int {
+	return extCommit.Commit.GetRound()
+}
+
+// Type returns the vote type of the commit, which is always VoteTypePrecommit
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) Type() byte {
+	return extCommit.Commit.Type()
+}
+
+// Size returns the number of signatures in the commit.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) Size() int {
+	if extCommit == nil {
+		return 0
+	}
+	return extCommit.Commit.Size()
+}
+
+// BitArray returns a BitArray of which validators voted for BlockID or nil in this commit.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) BitArray() *bits.BitArray {
+	return extCommit.Commit.BitArray()
+}
+
+// GetByIndex returns the vote corresponding to a given validator index, with
+// its extension and aggregation signature.
+// Panics if `index >= commit.Size()`.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) GetByIndex(valIdx int) *Vote {
+	vote := extCommit.Commit.GetVote(valIdx)
+	if valIdx < len(extCommit.Extensions) {
+		vote.Extension = extCommit.Extensions[valIdx]
+	}
+	if valIdx < len(extCommit.ExtensionSignatures) {
+		vote.ExtensionSignature = extCommit.ExtensionSignatures[valIdx]
+	}
+	if valIdx < len(extCommit.AggregationSignatures) {
+		vote.AggregationSignature = extCommit.AggregationSignatures[valIdx]
+	}
+	return vote
+}
+
+// IsCommit returns true if there is at least one signature.
+// Implements VoteSetReader.
+func (extCommit *ExtendedCommit) IsCommit() bool {
+	return extCommit.Commit.IsCommit()
+}
+
+//-----------------------------------------------------------------------------
+
 // SignedHeader is a header along with the commits that prove it.
 // It is the basis of the lite client.
 type SignedHeader struct {
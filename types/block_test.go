@@ -223,7 +223,7 @@ func TestCommit(t *testing.T) {
 	require.NotNil(t, commit.BitArray())
 	assert.Equal(t, bits.NewBitArray(10).Size(), commit.BitArray().Size())
 
-	assert.Equal(t, voteSet.GetByIndex(0), commit.GetByIndex(0))
+	assert.Equal(t, withoutExtension(voteSet.GetByIndex(0)), commit.GetByIndex(0))
 	assert.True(t, commit.IsCommit())
 }
 
@@ -438,7 +438,8 @@ func TestCommitToVoteSet(t *testing.T) {
 	voteSet2 := CommitToVoteSet(chainID, commit, valSet)
 
 	for i := 0; i < len(vals); i++ {
-		vote1 := voteSet.GetByIndex(i)
+		// vote extensions are not part of the commit
+		vote1 := withoutExtension(voteSet.GetByIndex(i))
 		vote2 := voteSet2.GetByIndex(i)
 		vote3 := commit.GetVote(i)
 
@@ -450,6 +451,13 @@ func TestCommitToVoteSet(t *testing.T) {
 	}
 }
 
+func withoutExtension(vote *Vote) *Vote {
+	vote = vote.Copy()
+	vote.Extension = nil
+	vote.ExtensionSignature = nil
+	return vote
+}
+
 func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
 
@@ -41,6 +41,37 @@ type CanonicalVote struct {
 	ChainID   string
 }
 
+// CanonicalVoteExtension binds the extension to the precommit it's sent
+// with, so it can't be replayed onto a precommit for another block, or nil.
+type CanonicalVoteExtension struct {
+	Extension []byte
+	Type      SignedMsgType // type alias for byte
+	Height    int64         `binary:"fixed64"`
+	Round     int64         `binary:"fixed64"`
+	BlockID   CanonicalBlockID
+	ChainID   string
+}
+
+// CanonicalAggregatePrecommit is signed by the precommits for a block of
+// BLS12-381 validators, along with the vote itself. It has no timestamp, so
+// all the validators sign the same message, and the signatures of a commit
+// can be verified at once when aggregated.
+type CanonicalAggregatePrecommit struct {
+	Type    SignedMsgType // type alias for byte
+	Height  int64         `binary:"fixed64"`
+	Round   int64         `binary:"fixed64"`
+	BlockID CanonicalBlockID
+	ChainID string
+}
+
+type CanonicalKeyRotation struct {
+	ValidatorAddress bytes.HexBytes
+	Height           int64 `binary:"fixed64"`
+	Time             time.Time
+	NewPubKey        []byte
+	ChainID          string
+}
+
 //-----------------------------------
 // Canonicalize the structs
 
@@ -81,6 +112,37 @@ func CanonicalizeVote(chainID string, vote *Vote) CanonicalVote {
 	}
 }
 
+func CanonicalizeVoteExtension(chainID string, vote *Vote) CanonicalVoteExtension {
+	return CanonicalVoteExtension{
+		Extension: vote.Extension,
+		Type:      vote.Type,
+		Height:    vote.Height,
+		Round:     int64(vote.Round), // cast int->int64 to make amino encode it fixed64 (does not work for int)
+		BlockID:   CanonicalizeBlockID(vote.BlockID),
+		ChainID:   chainID,
+	}
+}
+
+func CanonicalizeAggregatePrecommit(chainID string, height int64, round int, blockID BlockID) CanonicalAggregatePrecommit {
+	return CanonicalAggregatePrecommit{
+		Type:    AggregatePrecommitType,
+		Height:  height,
+		Round:   int64(round), // cast int->int64 to make amino encode it fixed64 (does not work for int)
+		BlockID: CanonicalizeBlockID(blockID),
+		ChainID: chainID,
+	}
+}
+
+func CanonicalizeKeyRotation(chainID string, rot *KeyRotation) CanonicalKeyRotation {
+	return CanonicalKeyRotation{
+		ValidatorAddress: rot.ValidatorAddress,
+		Height:           rot.SignedHeight,
+		Time:             rot.Timestamp,
+		NewPubKey:        rot.NewPubKey.Bytes(),
+		ChainID:          chainID,
+	}
+}
+
 // CanonicalTime can be used to stringify time in a canonical way.
 func CanonicalTime(t time.Time) string {
 	// Note that sending time over amino resets it to
@@ -54,7 +54,9 @@ func (err *ErrEvidenceOverflow) Error() string {
 
 //-------------------------------------------
 
-// Evidence represents any provable malicious activity by a validator
+// Evidence represents any provable malicious activity by a validator.
+// KeyRotation is also gossiped and committed as evidence, though it's not
+// malicious.
 type Evidence interface {
 	Height() int64                                     // height of the equivocation
 	Time() time.Time                                   // time of the equivocation
@@ -71,6 +73,7 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&KeyRotation{}, "tendermint/KeyRotation", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -142,9 +145,10 @@ func (dve *DuplicateVoteEvidence) Time() time.Time {
 	return dve.VoteA.Timestamp
 }
 
-// Address returns the address of the validator.
+// Address returns the address of the validator, which is not the address of
+// its key if it rotated it.
 func (dve *DuplicateVoteEvidence) Address() []byte {
-	return dve.PubKey.Address()
+	return dve.VoteA.ValidatorAddress
 }
 
 // Hash returns the hash of the evidence.
@@ -193,11 +197,12 @@ func (dve *DuplicateVoteEvidence) Verify(chainID string, pubKey crypto.PubKey) e
 		)
 	}
 
-	// pubkey must match address (this should already be true, sanity check)
-	addr := dve.VoteA.ValidatorAddress
-	if !bytes.Equal(pubKey.Address(), addr) {
-		return fmt.Errorf("duplicateVoteEvidence FAILED SANITY CHECK - address (%X) doesn't match pubkey (%v - %X)",
-			addr, pubKey, pubKey.Address())
+	// pubkey must be the key of the validator at the height, which is not the
+	// key of its address if it rotated it (sanity check, the validator is
+	// looked up by the address of the votes)
+	if dve.PubKey != nil && !pubKey.Equals(dve.PubKey) {
+		return fmt.Errorf("duplicateVoteEvidence Error: PubKey (%v) is not the key of the validator (%v)",
+			dve.PubKey, pubKey)
 	}
 
 	// Signatures must be valid
@@ -32,6 +32,18 @@ type GenesisValidator struct {
 	PubKey  crypto.PubKey `json:"pub_key"`
 	Power   int64         `json:"power"`
 	Name    string        `json:"name"`
+	// Required for BLS12-381 keys, see VerifyProofOfPossession.
+	ProofOfPossession tmbytes.HexBytes `json:"proof_of_possession,omitempty"`
+}
+
+// Validator returns the validator. Its address is that of the public key,
+// unless the validator rotated its key (see KeyRotation).
+func (v GenesisValidator) Validator() *Validator {
+	val := NewValidator(v.PubKey, v.Power)
+	if len(v.Address) > 0 {
+		val.Address = v.Address
+	}
+	return val
 }
 
 // GenesisDoc defines the initial conditions for a tendermint blockchain, in particular its validator set.
@@ -57,7 +69,7 @@ func (genDoc *GenesisDoc) SaveAs(file string) error {
 func (genDoc *GenesisDoc) ValidatorHash() []byte {
 	vals := make([]*Validator, len(genDoc.Validators))
 	for i, v := range genDoc.Validators {
-		vals[i] = NewValidator(v.PubKey, v.Power)
+		vals[i] = v.Validator()
 	}
 	vset := NewValidatorSet(vals)
 	return vset.Hash()
@@ -79,16 +91,34 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 		return err
 	}
 
+	// A validator which rotated its key keeps the address of its first key, so
+	// the address may differ from that of the key, but it must not be the
+	// address of another validator's key.
+	addresses := make(map[string]bool, len(genDoc.Validators))
 	for i, v := range genDoc.Validators {
 		if v.Power == 0 {
 			return errors.Errorf("the genesis file cannot contain validators with no voting power: %v", v)
 		}
-		if len(v.Address) > 0 && !bytes.Equal(v.PubKey.Address(), v.Address) {
-			return errors.Errorf("incorrect address for validator %v in the genesis file, should be %v", v, v.PubKey.Address())
+		if len(v.Address) > 0 && len(v.Address) != crypto.AddressSize {
+			return errors.Errorf("incorrect address size for validator %v in the genesis file, should be %d",
+				v, crypto.AddressSize)
 		}
 		if len(v.Address) == 0 {
 			genDoc.Validators[i].Address = v.PubKey.Address()
 		}
+		addr := string(genDoc.Validators[i].Address)
+		if addresses[addr] {
+			return errors.Errorf("duplicate address for validator %v in the genesis file", v)
+		}
+		addresses[addr] = true
+		if err := VerifyProofOfPossession(v.PubKey, v.ProofOfPossession); err != nil {
+			return errors.Wrapf(err, "validator %v in the genesis file", v)
+		}
+	}
+	for _, v := range genDoc.Validators {
+		if !bytes.Equal(v.Address, v.PubKey.Address()) && addresses[string(v.PubKey.Address())] {
+			return errors.Errorf("the key of validator %v in the genesis file has the address of another validator", v)
+		}
 	}
 
 	if genDoc.GenesisTime.IsZero() {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
@@ -71,7 +72,7 @@ func TestGenesisGood(t *testing.T) {
 	// create a base gendoc from struct
 	baseGenDoc := &GenesisDoc{
 		ChainID:    "abc",
-		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators: []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", nil}},
 	}
 	genDocBytes, err = cdc.MarshalJSON(baseGenDoc)
 	assert.NoError(t, err, "error marshalling genDoc")
@@ -111,6 +112,51 @@ func TestGenesisGood(t *testing.T) {
 	}
 }
 
+func TestGenesisProofOfPossession(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	genDoc := &GenesisDoc{
+		ChainID: "abc",
+		Validators: []GenesisValidator{{
+			PubKey:            privKey.PubKey(),
+			Power:             10,
+			ProofOfPossession: privKey.ProofOfPossession(),
+		}},
+	}
+	assert.NoError(t, genDoc.ValidateAndComplete())
+
+	// A BLS12-381 key requires a proof of possession of the private key.
+	genDoc.Validators[0].ProofOfPossession = nil
+	assert.Error(t, genDoc.ValidateAndComplete())
+	genDoc.Validators[0].ProofOfPossession = bls12381.GenPrivKey().ProofOfPossession()
+	assert.Error(t, genDoc.ValidateAndComplete())
+}
+
+func TestGenesisRotatedKey(t *testing.T) {
+	pubKey1, pubKey2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	rotatedKey := ed25519.GenPrivKey().PubKey()
+	genDoc := &GenesisDoc{
+		ChainID: "abc",
+		Validators: []GenesisValidator{
+			{Address: pubKey1.Address(), PubKey: pubKey1, Power: 10},
+			{Address: pubKey2.Address(), PubKey: rotatedKey, Power: 10},
+		},
+	}
+	// A validator which rotated its key keeps its address.
+	require.NoError(t, genDoc.ValidateAndComplete())
+	val := genDoc.Validators[1].Validator()
+	assert.Equal(t, pubKey2.Address(), val.Address)
+	assert.True(t, val.KeyRotated())
+
+	// The address must not be the address of another validator, or of the
+	// key of another validator.
+	genDoc.Validators[1].Address = pubKey1.Address()
+	assert.Error(t, genDoc.ValidateAndComplete())
+	genDoc.Validators[1].Address = pubKey2.Address()
+	genDoc.Validators[0].PubKey = ed25519.GenPrivKey().PubKey()
+	genDoc.Validators[1].PubKey = pubKey1
+	assert.Error(t, genDoc.ValidateAndComplete())
+}
+
 func TestGenesisSaveAs(t *testing.T) {
 	tmpfile, err := ioutil.TempFile("", "genesis")
 	require.NoError(t, err)
@@ -148,7 +194,7 @@ func randomGenesisDoc() *GenesisDoc {
 	return &GenesisDoc{
 		GenesisTime:     tmtime.Now(),
 		ChainID:         "abc",
-		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval", nil}},
 		ConsensusParams: DefaultConsensusParams(),
 	}
 }
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// KeyRotation replaces the key of a validator, which keeps its address and
+// voting power. It's signed by the validator's key at SignedHeight, and by the
+// new key.
+//
+// Key rotations are gossiped, verified and committed in blocks like evidence,
+// but are not reported to the app as misbehaviour. A rotation committed in
+// block H takes effect along with the validator updates of H, at H+2: the
+// handover height. From then on the validator set maps the validator's
+// address, which is the address of its first key, to the new key, and the old
+// key can no longer sign.
+//
+// A rotation must be signed at or after the handover height of the
+// validator's previous rotation, so it can't be replayed once the key was
+// rotated back to the key which signed it.
+type KeyRotation struct {
+	ValidatorAddress Address       `json:"validator_address"`
+	SignedHeight     int64         `json:"signed_height"`
+	Timestamp        time.Time     `json:"timestamp"`
+	NewPubKey        crypto.PubKey `json:"new_pub_key"`
+
+	// Required if NewPubKey is a bls12_381 key, see VerifyProofOfPossession.
+	ProofOfPossession tmbytes.HexBytes `json:"proof_of_possession,omitempty"`
+
+	OldSignature []byte `json:"old_signature"`
+	NewSignature []byte `json:"new_signature"`
+}
+
+var _ Evidence = &KeyRotation{}
+
+// SignBytes returns the bytes signed by both the old and the new key.
+func (rot *KeyRotation) SignBytes(chainID string) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(CanonicalizeKeyRotation(chainID, rot))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Height returns the height at which the rotation was signed.
+func (rot *KeyRotation) Height() int64 {
+	return rot.SignedHeight
+}
+
+// Time returns the time at which the rotation was signed.
+func (rot *KeyRotation) Time() time.Time {
+	return rot.Timestamp
+}
+
+// Address returns the address of the validator.
+func (rot *KeyRotation) Address() []byte {
+	return rot.ValidatorAddress
+}
+
+// Bytes returns the amino encoding of the rotation.
+func (rot *KeyRotation) Bytes() []byte {
+	return cdcEncode(rot)
+}
+
+// Hash returns the hash of the rotation.
+func (rot *KeyRotation) Hash() []byte {
+	return tmhash.Sum(cdcEncode(rot))
+}
+
+// Verify checks the rotation is signed by pubKey, the key of the validator at
+// SignedHeight, and by the new key.
+func (rot *KeyRotation) Verify(chainID string, pubKey crypto.PubKey) error {
+	if pubKey.Equals(rot.NewPubKey) {
+		return errors.New("the new key is the same as the old key")
+	}
+	signBytes := rot.SignBytes(chainID)
+	if !pubKey.VerifyBytes(signBytes, rot.OldSignature) {
+		return errors.New("invalid signature of the old key")
+	}
+	if !rot.NewPubKey.VerifyBytes(signBytes, rot.NewSignature) {
+		return errors.New("invalid signature of the new key")
+	}
+	return VerifyProofOfPossession(rot.NewPubKey, rot.ProofOfPossession)
+}
+
+// Equal checks if two pieces of evidence are the same key rotation.
+func (rot *KeyRotation) Equal(ev Evidence) bool {
+	if _, ok := ev.(*KeyRotation); !ok {
+		return false
+	}
+	return bytes.Equal(rot.Hash(), ev.Hash())
+}
+
+// ValidateBasic performs basic validation.
+func (rot *KeyRotation) ValidateBasic() error {
+	if len(rot.ValidatorAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ValidatorAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize, len(rot.ValidatorAddress))
+	}
+	if rot.SignedHeight <= 0 {
+		return errors.New("height must be positive")
+	}
+	if rot.NewPubKey == nil {
+		return errors.New("missing NewPubKey")
+	}
+	if len(rot.OldSignature) == 0 || len(rot.OldSignature) > MaxSignatureSize {
+		return fmt.Errorf("OldSignature must be between 1 and %d bytes", MaxSignatureSize)
+	}
+	if len(rot.NewSignature) == 0 || len(rot.NewSignature) > MaxSignatureSize {
+		return fmt.Errorf("NewSignature must be between 1 and %d bytes", MaxSignatureSize)
+	}
+	if len(rot.ProofOfPossession) > MaxSignatureSize {
+		return fmt.Errorf("ProofOfPossession is longer than %d bytes", MaxSignatureSize)
+	}
+	return nil
+}
+
+// String returns a string representation of the rotation.
+func (rot *KeyRotation) String() string {
+	return fmt.Sprintf("KeyRotation{%v H:%d %v}", rot.ValidatorAddress, rot.SignedHeight, rot.NewPubKey)
+}
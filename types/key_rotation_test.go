@@ -0,0 +1,102 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func makeKeyRotation(t *testing.T, chainID string, oldKey, newKey crypto.PrivKey) *KeyRotation {
+	rot := &KeyRotation{
+		ValidatorAddress: oldKey.PubKey().Address(),
+		SignedHeight:     10,
+		Timestamp:        time.Now().UTC(),
+		NewPubKey:        newKey.PubKey(),
+	}
+	if blsKey, ok := newKey.(bls12381.PrivKeyBls12381); ok {
+		rot.ProofOfPossession = blsKey.ProofOfPossession()
+	}
+	var err error
+	rot.OldSignature, err = oldKey.Sign(rot.SignBytes(chainID))
+	require.NoError(t, err)
+	rot.NewSignature, err = newKey.Sign(rot.SignBytes(chainID))
+	require.NoError(t, err)
+	return rot
+}
+
+func TestKeyRotationVerify(t *testing.T) {
+	const chainID = "mychain"
+	oldKey, newKey := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+
+	rot := makeKeyRotation(t, chainID, oldKey, newKey)
+	require.NoError(t, rot.ValidateBasic())
+	assert.NoError(t, rot.Verify(chainID, oldKey.PubKey()))
+	assert.Error(t, rot.Verify("otherchain", oldKey.PubKey()))
+	assert.Error(t, rot.Verify(chainID, ed25519.GenPrivKey().PubKey()))
+	assert.Error(t, rot.Verify(chainID, newKey.PubKey()))
+
+	// The new key must sign.
+	rot2 := *rot
+	rot2.NewSignature = rot.OldSignature
+	assert.Error(t, rot2.Verify(chainID, oldKey.PubKey()))
+
+	// A bls12_381 key must come with its proof of possession.
+	blsRot := makeKeyRotation(t, chainID, oldKey, bls12381.GenPrivKey())
+	assert.NoError(t, blsRot.Verify(chainID, oldKey.PubKey()))
+	blsRot.ProofOfPossession = nil
+	assert.Error(t, blsRot.Verify(chainID, oldKey.PubKey()))
+
+	assert.True(t, rot.Equal(rot))
+	assert.False(t, rot.Equal(blsRot))
+}
+
+func TestKeyRotationValidateBasic(t *testing.T) {
+	rot := makeKeyRotation(t, "mychain", ed25519.GenPrivKey(), ed25519.GenPrivKey())
+
+	testCases := []struct {
+		testName string
+		malleate func(*KeyRotation)
+		expErr   bool
+	}{
+		{"Good KeyRotation", func(rot *KeyRotation) {}, false},
+		{"Invalid address", func(rot *KeyRotation) { rot.ValidatorAddress = []byte{1} }, true},
+		{"Invalid height", func(rot *KeyRotation) { rot.SignedHeight = 0 }, true},
+		{"Missing new key", func(rot *KeyRotation) { rot.NewPubKey = nil }, true},
+		{"Missing old signature", func(rot *KeyRotation) { rot.OldSignature = nil }, true},
+		{"Too big new signature", func(rot *KeyRotation) {
+			rot.NewSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			rot := *rot
+			tc.malleate(&rot)
+			assert.Equal(t, tc.expErr, rot.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestKeyRotationMaxBytes(t *testing.T) {
+	rot := &KeyRotation{
+		ValidatorAddress:  tmhash.SumTruncated([]byte("validator")),
+		SignedHeight:      math.MaxInt64,
+		Timestamp:         time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		NewPubKey:         bls12381.GenPrivKey().PubKey(),
+		ProofOfPossession: make([]byte, MaxSignatureSize),
+		OldSignature:      make([]byte, MaxSignatureSize),
+		NewSignature:      make([]byte, MaxSignatureSize),
+	}
+	var ev Evidence = rot
+	bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, int64(len(bz)), MaxEvidenceBytes)
+}
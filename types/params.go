@@ -6,6 +6,8 @@ import (
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmstrings "github.com/tendermint/tendermint/libs/strings"
 )
@@ -100,6 +102,20 @@ func (params *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	return false
 }
 
+// IsValidPubKey checks if the type of pubKey is one of PubKeyTypes.
+func (params *ValidatorParams) IsValidPubKey(pubKey crypto.PubKey) bool {
+	name, ok := cryptoamino.PubkeyAminoName(cdc, pubKey)
+	if !ok {
+		return false
+	}
+	for _, keyType := range params.PubKeyTypes {
+		if ABCIPubKeyTypesToAminoNames[keyType] == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate validates the ConsensusParams to ensure all values are within their
 // allowed limits, and returns an error if they are not.
 func (params *ConsensusParams) Validate() error {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
@@ -19,6 +20,33 @@ type PrivValidator interface {
 	SignProposal(chainID string, proposal *Proposal) error
 }
 
+// RotatingPrivValidator is a PrivValidator which can rotate its key, see
+// KeyRotation.
+type RotatingPrivValidator interface {
+	PrivValidator
+
+	// GetAddress returns the address of the validator, which is the address
+	// of its first key.
+	GetAddress() Address
+	// GetNextPubKey returns the key the validator is rotating to, or nil.
+	GetNextPubKey() crypto.PubKey
+	// PendingKeyRotation returns the rotation to the next key, to commit in
+	// a block, or nil.
+	PendingKeyRotation() *KeyRotation
+	// HandOver replaces the key with the next key, which signs from height
+	// on.
+	HandOver(height int64) error
+}
+
+// PrivValidatorAddress returns the address of the validator of pv, which is
+// not the address of its key if it rotated it.
+func PrivValidatorAddress(pv PrivValidator) Address {
+	if rpv, ok := pv.(RotatingPrivValidator); ok {
+		return rpv.GetAddress()
+	}
+	return pv.GetPubKey().Address()
+}
+
 //----------------------------------------
 // Misc.
 
@@ -77,6 +105,22 @@ func (pv *MockPV) SignVote(chainID string, vote *Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	if vote.IsExtendable() {
+		extSig, err := pv.privKey.Sign(vote.ExtensionSignBytes(useChainID))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+
+		if _, ok := pv.privKey.(bls12381.PrivKeyBls12381); ok {
+			aggSig, err := pv.privKey.Sign(vote.AggregationSignBytes(useChainID))
+			if err != nil {
+				return err
+			}
+			vote.AggregationSignature = aggSig
+		}
+	}
 	return nil
 }
 
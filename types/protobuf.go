@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
@@ -24,6 +25,7 @@ const (
 	ABCIPubKeyTypeEd25519   = "ed25519"
 	ABCIPubKeyTypeSr25519   = "sr25519"
 	ABCIPubKeyTypeSecp256k1 = "secp256k1"
+	ABCIPubKeyTypeBls12381  = "bls12_381"
 )
 
 // TODO: Make non-global by allowing for registration of more pubkey types
@@ -32,6 +34,7 @@ var ABCIPubKeyTypesToAminoNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyAminoName,
 	ABCIPubKeyTypeSr25519:   sr25519.PubKeyAminoName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyAminoName,
+	ABCIPubKeyTypeBls12381:  bls12381.PubKeyAminoName,
 }
 
 //-------------------------------------------------------
@@ -70,7 +73,7 @@ func (tm2pb) Header(header *Header) abci.Header {
 
 func (tm2pb) Validator(val *Validator) abci.Validator {
 	return abci.Validator{
-		Address: val.PubKey.Address(),
+		Address: val.Address,
 		Power:   val.VotingPower,
 	}
 }
@@ -116,6 +119,11 @@ func (tm2pb) PubKey(pubKey crypto.PubKey) abci.PubKey {
 			Type: ABCIPubKeyTypeSecp256k1,
 			Data: pk[:],
 		}
+	case bls12381.PubKeyBls12381:
+		return abci.PubKey{
+			Type: ABCIPubKeyTypeBls12381,
+			Data: pk[:],
+		}
 	default:
 		panic(fmt.Sprintf("unknown pubkey type: %v %v", pubKey, reflect.TypeOf(pubKey)))
 	}
@@ -220,11 +228,21 @@ func (pb2tm) PubKey(pubKey abci.PubKey) (crypto.PubKey, error) {
 		var pk secp256k1.PubKeySecp256k1
 		copy(pk[:], pubKey.Data)
 		return pk, nil
+	case ABCIPubKeyTypeBls12381:
+		if len(pubKey.Data) != bls12381.PubKeyBls12381Size {
+			return nil, fmt.Errorf("invalid size for PubKeyBls12381. Got %d, expected %d",
+				len(pubKey.Data), bls12381.PubKeyBls12381Size)
+		}
+		var pk bls12381.PubKeyBls12381
+		copy(pk[:], pubKey.Data)
+		return pk, nil
 	default:
 		return nil, fmt.Errorf("unknown pubkey type %v", pubKey.Type)
 	}
 }
 
+// ValidatorUpdates converts the updates, and checks the proof of possession
+// of the BLS12-381 keys being added.
 func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error) {
 	tmVals := make([]*Validator, len(vals))
 	for i, v := range vals {
@@ -232,6 +250,11 @@ func (pb2tm) ValidatorUpdates(vals []abci.ValidatorUpdate) ([]*Validator, error)
 		if err != nil {
 			return nil, err
 		}
+		if v.Power > 0 {
+			if err := VerifyProofOfPossession(pub, v.ProofOfPossession); err != nil {
+				return nil, err
+			}
+		}
 		tmVals[i] = NewValidator(pub, v.Power)
 	}
 	return tmVals, nil
@@ -10,6 +10,7 @@ import (
 	amino "github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/version"
@@ -20,6 +21,7 @@ func TestABCIPubKey(t *testing.T) {
 	pkSecp := secp256k1.GenPrivKey().PubKey()
 	testABCIPubKey(t, pkEd, ABCIPubKeyTypeEd25519)
 	testABCIPubKey(t, pkSecp, ABCIPubKeyTypeSecp256k1)
+	testABCIPubKey(t, bls12381.GenPrivKey().PubKey(), ABCIPubKeyTypeBls12381)
 }
 
 func testABCIPubKey(t *testing.T, pk crypto.PubKey, typeStr string) {
@@ -59,6 +61,21 @@ func TestABCIValidators(t *testing.T) {
 	tmVals, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
 	assert.NotNil(t, err)
 	assert.Nil(t, tmVals)
+
+	// BLS12-381 keys require a proof of possession, unless they're removed
+	privKeyBls := bls12381.GenPrivKey()
+	abciVal = TM2PB.ValidatorUpdate(NewValidator(privKeyBls.PubKey(), 10))
+	_, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
+	assert.Error(t, err)
+	abciVal.ProofOfPossession = bls12381.GenPrivKey().ProofOfPossession()
+	_, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
+	assert.Error(t, err)
+	abciVal.ProofOfPossession = privKeyBls.ProofOfPossession()
+	_, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
+	assert.NoError(t, err)
+	abciVal = TM2PB.ValidatorUpdate(NewValidator(privKeyBls.PubKey(), 0))
+	_, err = PB2TM.ValidatorUpdates([]abci.ValidatorUpdate{abciVal})
+	assert.NoError(t, err)
 }
 
 func TestABCIConsensusParams(t *testing.T) {
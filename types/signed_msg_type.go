@@ -8,6 +8,9 @@ const (
 	PrevoteType   SignedMsgType = 0x01
 	PrecommitType SignedMsgType = 0x02
 
+	// Precommits for aggregation, see CanonicalAggregatePrecommit
+	AggregatePrecommitType SignedMsgType = 0x03
+
 	// Proposals
 	ProposalType SignedMsgType = 0x20
 )
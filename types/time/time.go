@@ -2,12 +2,41 @@ package time
 
 import (
 	"sort"
+	"sync/atomic"
 	"time"
 )
 
+var (
+	// clock holds the func() time.Time returning the time, before the skew.
+	clock atomic.Value
+	// skew is the time.Duration added to the time returned by Now, accessed
+	// atomically.
+	skew int64
+)
+
+func init() {
+	clock.Store(time.Now)
+}
+
 // Now returns the current time in UTC with no monotonic component.
 func Now() time.Time {
-	return Canonical(time.Now())
+	return Canonical(clock.Load().(func() time.Time)().Add(time.Duration(atomic.LoadInt64(&skew))))
+}
+
+// SetClock makes Now return the time of c instead of the system time, or the
+// system time again if c is nil, e.g. to run nodes on the virtual clock of a
+// simulation. As the clock is global, a single one can be used at a time.
+func SetClock(c func() time.Time) {
+	if c == nil {
+		c = time.Now
+	}
+	clock.Store(c)
+}
+
+// SetSkew shifts the time returned by Now by d, to run a node with a wrong
+// clock in a testnet.
+func SetSkew(d time.Duration) {
+	atomic.StoreInt64(&skew, int64(d))
 }
 
 // Canonical returns UTC time with no monotonic component.
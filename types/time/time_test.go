@@ -54,3 +54,34 @@ func TestWeightedMedian(t *testing.T) {
 	assert.Equal(t, true, (median.After(t1) || median.Equal(t1)) &&
 		(median.Before(t4) || median.Equal(t4)))
 }
+
+func TestSetClock(t *testing.T) {
+	defer SetClock(nil)
+
+	virtual := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return virtual })
+	assert.Equal(t, virtual, Now())
+
+	SetClock(nil)
+	assert.WithinDuration(t, time.Now(), Now(), time.Second)
+}
+
+func TestSetSkew(t *testing.T) {
+	defer SetClock(nil)
+	defer SetSkew(0)
+
+	virtual := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return virtual })
+
+	// the skew can be changed while the time is read
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			Now()
+		}
+	}()
+	SetSkew(time.Minute)
+	<-done
+	assert.Equal(t, virtual.Add(time.Minute), Now())
+}
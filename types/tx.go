@@ -63,6 +63,36 @@ func (txs Txs) IndexByHash(hash []byte) int {
 	return -1
 }
 
+// ToSliceOfBytes converts the transactions to a slice of byte slices, as used
+// in the ABCI messages.
+func (txs Txs) ToSliceOfBytes() [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i := 0; i < len(txs); i++ {
+		txBzs[i] = txs[i]
+	}
+	return txBzs
+}
+
+// TotalAminoSize returns the number of bytes the transactions take up in a
+// block's data, including the amino overhead of each transaction.
+func (txs Txs) TotalAminoSize() int64 {
+	var size int64
+	for _, tx := range txs {
+		size += int64(len(tx)) + ComputeAminoOverhead(tx, 1)
+	}
+	return size
+}
+
+// ToTxs converts a slice of byte slices, as returned by the ABCI application,
+// to Txs.
+func ToTxs(txBzs [][]byte) Txs {
+	txs := make(Txs, len(txBzs))
+	for i := 0; i < len(txBzs); i++ {
+		txs[i] = txBzs[i]
+	}
+	return txs
+}
+
 // Proof returns a simple merkle proof for this node.
 // Panics if i < 0 or i >= len(txs)
 // TODO: optimize this!
@@ -112,6 +142,77 @@ func (tp TxProof) Validate(dataHash []byte) error {
 	return nil
 }
 
+// MultiProof returns a merkle proof of the inclusion of the txs at the given
+// indices, which are sorted and deduplicated.
+// Panics if an index is out of range.
+func (txs Txs) MultiProof(indices []int) TxMultiProof {
+	l := len(txs)
+	bzs := make([][]byte, l)
+	for i := 0; i < l; i++ {
+		bzs[i] = txs[i].Hash()
+	}
+	root, proof := merkle.SimpleMultiProofFromByteSlices(bzs, indices)
+
+	data := make(Txs, len(proof.Indices))
+	for i, idx := range proof.Indices {
+		data[i] = txs[idx]
+	}
+	return TxMultiProof{
+		RootHash: root,
+		Data:     data,
+		Proof:    *proof,
+	}
+}
+
+// TxMultiProof represents a Merkle proof of the presence of several
+// transactions in the Merkle tree. Data holds the transactions at
+// Proof.Indices, in the same order.
+type TxMultiProof struct {
+	RootHash tmbytes.HexBytes        `json:"root_hash"`
+	Data     Txs                     `json:"data"`
+	Proof    merkle.SimpleMultiProof `json:"proof"`
+}
+
+// Leaves returns the hashes of the txs, which are the leaves in the merkle
+// tree which this proof refers to.
+func (tp TxMultiProof) Leaves() [][]byte {
+	leaves := make([][]byte, len(tp.Data))
+	for i, tx := range tp.Data {
+		leaves[i] = tx.Hash()
+	}
+	return leaves
+}
+
+// Validate verifies the proof. It returns nil if the RootHash matches the
+// dataHash argument, and if the proof is internally consistent. Otherwise, it
+// returns a sensible error.
+func (tp TxMultiProof) Validate(dataHash []byte) error {
+	if !bytes.Equal(dataHash, tp.RootHash) {
+		return errors.New("proof matches different data hash")
+	}
+	if err := tp.Proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof: %v", err)
+	}
+	if len(tp.Data) != len(tp.Proof.Indices) {
+		return fmt.Errorf("proof has %d indices, but %d txs", len(tp.Proof.Indices), len(tp.Data))
+	}
+	if err := tp.Proof.Verify(tp.RootHash, tp.Leaves()); err != nil {
+		return errors.New("proof is not internally consistent")
+	}
+	return nil
+}
+
+// Index returns the index of the given tx in the block, or -1 if the proof
+// doesn't include it.
+func (tp TxMultiProof) Index(tx Tx) int {
+	for i := range tp.Data {
+		if bytes.Equal(tp.Data[i], tx) {
+			return tp.Proof.Indices[i]
+		}
+	}
+	return -1
+}
+
 // TxResult contains results of executing the transaction.
 //
 // One usage is indexing transaction results.
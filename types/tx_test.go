@@ -88,6 +88,37 @@ func TestValidTxProof(t *testing.T) {
 	}
 }
 
+func TestValidTxMultiProof(t *testing.T) {
+	txs := makeTxs(61, 15)
+	root := txs.Hash()
+
+	for _, indices := range [][]int{{0}, {60}, {3, 4, 5, 6}, {59, 1, 30, 1}} {
+		proof := txs.MultiProof(indices)
+		assert.EqualValues(t, root, proof.RootHash, "%v", indices)
+		assert.Equal(t, len(proof.Proof.Indices), len(proof.Data), "%v", indices)
+		for i, idx := range proof.Proof.Indices {
+			assert.EqualValues(t, txs[idx], proof.Data[i], "%v", indices)
+			assert.Equal(t, idx, proof.Index(txs[idx]), "%v", indices)
+		}
+		assert.Nil(t, proof.Validate(root), "%v", indices)
+		assert.NotNil(t, proof.Validate([]byte("foobar")), "%v", indices)
+
+		// read-write must also work
+		var p2 TxMultiProof
+		bin, err := cdc.MarshalBinaryLengthPrefixed(proof)
+		assert.Nil(t, err)
+		err = cdc.UnmarshalBinaryLengthPrefixed(bin, &p2)
+		if assert.Nil(t, err, "%v: %+v", indices, err) {
+			assert.Nil(t, p2.Validate(root), "%v", indices)
+		}
+
+		// a tx which isn't at its index
+		p2.Data[0] = txs[(p2.Proof.Indices[0]+1)%len(txs)]
+		assert.NotNil(t, p2.Validate(root), "%v", indices)
+	}
+	assert.Equal(t, -1, txs.MultiProof([]int{1}).Index(txs[2]))
+}
+
 func TestTxProofUnchangable(t *testing.T) {
 	// run the other test a bunch...
 	for i := 0; i < 40; i++ {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
@@ -29,6 +30,24 @@ func NewValidator(pubKey crypto.PubKey, votingPower int64) *Validator {
 	}
 }
 
+// VerifyProofOfPossession checks the proof that the holder of a BLS12-381 key
+// knows the private key, which is required to add the key to a validator set,
+// since commits of BLS12-381 validators are aggregated. Other keys need no
+// proof.
+func VerifyProofOfPossession(pubKey crypto.PubKey, pop []byte) error {
+	blsKey, ok := pubKey.(bls12381.PubKeyBls12381)
+	if !ok {
+		return nil
+	}
+	if len(pop) == 0 {
+		return fmt.Errorf("missing proof of possession of %v", pubKey)
+	}
+	if !blsKey.VerifyProofOfPossession(pop) {
+		return fmt.Errorf("invalid proof of possession of %v", pubKey)
+	}
+	return nil
+}
+
 // Creates a new copy of the validator so we can mutate ProposerPriority.
 // Panics if the validator is nil.
 func (v *Validator) Copy() *Validator {
@@ -82,9 +101,20 @@ func ValidatorListString(vals []*Validator) string {
 
 // Bytes computes the unique encoding of a validator with a given voting power.
 // These are the bytes that gets hashed in consensus. It excludes address
-// as its redundant with the pubkey. This also excludes ProposerPriority
-// which changes every round.
+// as its redundant with the pubkey, unless the validator rotated its key. This
+// also excludes ProposerPriority which changes every round.
 func (v *Validator) Bytes() []byte {
+	if v.KeyRotated() {
+		return cdcEncode(struct {
+			Address     Address
+			PubKey      crypto.PubKey
+			VotingPower int64
+		}{
+			v.Address,
+			v.PubKey,
+			v.VotingPower,
+		})
+	}
 	return cdcEncode(struct {
 		PubKey      crypto.PubKey
 		VotingPower int64
@@ -94,6 +124,12 @@ func (v *Validator) Bytes() []byte {
 	})
 }
 
+// KeyRotated returns true if the validator rotated its key, so its address is
+// the address of its first key rather than of PubKey. See KeyRotation.
+func (v *Validator) KeyRotated() bool {
+	return v.PubKey != nil && !bytes.Equal(v.Address, v.PubKey.Address())
+}
+
 //----------------------------------------
 // RandValidator
 
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 )
@@ -563,6 +565,9 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 		return nil
 	}
 
+	// Validators which rotated their key may be referred to by either key.
+	changes = vals.resolveRotatedKeys(changes)
+
 	// Check for duplicates within changes, split in 'updates' and 'deletes' lists (sorted).
 	updates, deletes, err := processChanges(changes)
 	if err != nil {
@@ -608,6 +613,64 @@ func (vals *ValidatorSet) updateWithChangeSet(changes []*Validator, allowDeletes
 	return nil
 }
 
+// resolveRotatedKeys returns a copy of the changes where the changes of
+// validators which rotated their key, given with either their first or their
+// current key, have the address of the validator and its current key.
+func (vals *ValidatorSet) resolveRotatedKeys(changes []*Validator) []*Validator {
+	resolved := validatorListCopy(changes)
+	for _, change := range resolved {
+		if _, val := vals.GetByAddress(change.Address); val != nil {
+			if val.KeyRotated() {
+				change.PubKey = val.PubKey
+			}
+			continue
+		}
+		for _, val := range vals.Validators {
+			if val.KeyRotated() && val.PubKey.Equals(change.PubKey) {
+				change.Address = val.Address
+				break
+			}
+		}
+	}
+	return resolved
+}
+
+// VerifyKeyRotation checks the validator with the given address can rotate
+// its key to pubKey: pubKey must not be the key, nor have the address, of any
+// validator.
+func (vals *ValidatorSet) VerifyKeyRotation(address Address, pubKey crypto.PubKey) error {
+	if !vals.HasAddress(address) {
+		return fmt.Errorf("%X is not a validator", address)
+	}
+	if vals.HasAddress(pubKey.Address()) {
+		return fmt.Errorf("the address of the new key %X is the address of a validator", pubKey.Address())
+	}
+	for _, val := range vals.Validators {
+		if val.PubKey.Equals(pubKey) {
+			return fmt.Errorf("the new key is the key of validator %X", val.Address)
+		}
+	}
+	return nil
+}
+
+// RotateKey replaces the key of the validator with the given address, which
+// keeps its address, voting power and priority. See VerifyKeyRotation.
+func (vals *ValidatorSet) RotateKey(address Address, pubKey crypto.PubKey) error {
+	if err := vals.VerifyKeyRotation(address, pubKey); err != nil {
+		return err
+	}
+	// The validators are copied by Copy, so this doesn't change other sets,
+	// but the proposer is shared.
+	idx, _ := vals.GetByAddress(address)
+	vals.Validators[idx].PubKey = pubKey
+	if vals.Proposer != nil && bytes.Equal(vals.Proposer.Address, address) {
+		proposer := vals.Proposer.Copy()
+		proposer.PubKey = pubKey
+		vals.Proposer = proposer
+	}
+	return nil
+}
+
 // UpdateWithChangeSet attempts to update the validator set with 'changes'.
 // It performs the following steps:
 // - validates the changes making sure there are no duplicates and splits them in updates and deletes
@@ -624,17 +687,42 @@ func (vals *ValidatorSet) UpdateWithChangeSet(changes []*Validator) error {
 	return vals.updateWithChangeSet(changes, true)
 }
 
+// SupportsAggregation returns true if all the validators have BLS12-381 keys,
+// so the signatures of their commits can be aggregated.
+func (vals *ValidatorSet) SupportsAggregation() bool {
+	if vals.IsNilOrEmpty() {
+		return false
+	}
+	for _, val := range vals.Validators {
+		if _, ok := val.PubKey.(bls12381.PubKeyBls12381); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // VerifyCommit verifies +2/3 of the set had signed the given commit.
 func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	height int64, commit *Commit) error {
 
-	if vals.Size() != len(commit.Signatures) {
-		return NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+	if vals.Size() != commit.Size() {
+		return NewErrInvalidCommitSignatures(vals.Size(), commit.Size())
 	}
 	if err := verifyCommitBasic(commit, height, blockID); err != nil {
 		return err
 	}
 
+	if commit.Aggregated() {
+		talliedVotingPower, err := vals.verifyAggregatedSignature(chainID, commit)
+		if err != nil {
+			return err
+		}
+		if got, needed := talliedVotingPower, vals.TotalVotingPower()*2/3; got <= needed {
+			return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+		}
+		return nil
+	}
+
 	talliedVotingPower := int64(0)
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
@@ -667,6 +755,32 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	return nil
 }
 
+// verifyAggregatedSignature checks the signature of an aggregated commit
+// against the keys of its signers, which all signed the same message, so it
+// takes two pairings whatever their number. It returns their voting power.
+// The commit must have been checked to be as large as the set.
+func (vals *ValidatorSet) verifyAggregatedSignature(chainID string, commit *Commit) (int64, error) {
+	var (
+		pubKeys     = make([]bls12381.PubKeyBls12381, 0, vals.Size())
+		votingPower int64
+	)
+	for idx, val := range vals.Validators {
+		if !commit.Signers.GetIndex(idx) {
+			continue
+		}
+		pubKey, ok := val.PubKey.(bls12381.PubKeyBls12381)
+		if !ok {
+			return 0, fmt.Errorf("signer #%d of an aggregated commit has a %T key", idx, val.PubKey)
+		}
+		pubKeys = append(pubKeys, pubKey)
+		votingPower += val.VotingPower
+	}
+	if !bls12381.FastAggregateVerify(pubKeys, commit.AggregateSignBytes(chainID), commit.AggregatedSignature) {
+		return 0, fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return votingPower, nil
+}
+
 // VerifyFutureCommit will check to see if the set would be valid with a different
 // validator set.
 //
@@ -710,6 +824,23 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 	oldVotingPower := int64(0)
 	seen := map[int]bool{}
 
+	if commit.Aggregated() {
+		// The aggregated signature was checked against the keys of newSet, so
+		// only the signers which kept their key count.
+		for idx, newVal := range newSet.Validators {
+			if !commit.Signers.GetIndex(idx) {
+				continue
+			}
+			if _, val := oldVals.GetByAddress(newVal.Address); val != nil && val.PubKey.Equals(newVal.PubKey) {
+				oldVotingPower += val.VotingPower
+			}
+		}
+		if got, needed := oldVotingPower, oldVals.TotalVotingPower()*2/3; got <= needed {
+			return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+		}
+		return nil
+	}
+
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some signatures can be absent.
@@ -747,6 +878,8 @@ func (vals *ValidatorSet) VerifyFutureCommit(newSet *ValidatorSet, chainID strin
 // set signed this commit.
 // NOTE the given validators do not necessarily correspond to the validator set
 // for this commit, but there may be some intersection.
+// NOTE the signers of an aggregated commit are only known by their index in
+// the validator set of the commit, see VerifyAggregatedCommitTrusting.
 func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 	height int64, commit *Commit, trustLevel tmmath.Fraction) error {
 
@@ -759,6 +892,10 @@ func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 		return err
 	}
 
+	if commit.Aggregated() {
+		return errors.New("the validator set of an aggregated commit is needed to verify it")
+	}
+
 	var (
 		talliedVotingPower int64
 		seenVals           = make(map[int]int, len(commit.Signatures)) // validator index -> commit index
@@ -807,6 +944,52 @@ func (vals *ValidatorSet) VerifyCommitTrusting(chainID string, blockID BlockID,
 	return nil
 }
 
+// VerifyAggregatedCommitTrusting is VerifyCommitTrusting for an aggregated
+// commit, whose signers are resolved through commitVals, the validator set of
+// the commit. The aggregated signature is checked against their keys, and the
+// signers which are in vals with the same key are tallied.
+// NOTE commitVals must have been checked to be the validator set of the
+// header of the commit.
+func (vals *ValidatorSet) VerifyAggregatedCommitTrusting(chainID string, blockID BlockID,
+	height int64, commit *Commit, commitVals *ValidatorSet, trustLevel tmmath.Fraction) error {
+
+	if trustLevel.Numerator*3 < trustLevel.Denominator || // < 1/3
+		trustLevel.Numerator > trustLevel.Denominator { // > 1
+		panic(fmt.Sprintf("trustLevel must be within [1/3, 1], given %v", trustLevel))
+	}
+
+	if err := verifyCommitBasic(commit, height, blockID); err != nil {
+		return err
+	}
+	if !commit.Aggregated() {
+		return errors.New("commit is not aggregated")
+	}
+	if commitVals.Size() != commit.Size() {
+		return NewErrInvalidCommitSignatures(commitVals.Size(), commit.Size())
+	}
+	if _, err := commitVals.verifyAggregatedSignature(chainID, commit); err != nil {
+		return err
+	}
+
+	var talliedVotingPower int64
+	for idx, commitVal := range commitVals.Validators {
+		if !commit.Signers.GetIndex(idx) {
+			continue
+		}
+		if _, val := vals.GetByAddress(commitVal.Address); val != nil && val.PubKey.Equals(commitVal.PubKey) {
+			talliedVotingPower += val.VotingPower
+		}
+	}
+
+	got := talliedVotingPower
+	needed := (vals.TotalVotingPower() * trustLevel.Numerator) / trustLevel.Denominator
+	if got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	return nil
+}
+
 func verifyCommitBasic(commit *Commit, height int64, blockID BlockID) error {
 	if err := commit.ValidateBasic(); err != nil {
 		return err
@@ -10,8 +10,10 @@ import (
 	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -640,6 +642,88 @@ func TestValidatorSetVerifyCommit(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestValidatorSetVerifyAggregatedCommit(t *testing.T) {
+	var (
+		chainID = "mychainID"
+		blockID = makeBlockIDRandom()
+		height  = int64(5)
+		vals    = make([]*Validator, 4)
+		pvs     = make(map[string]PrivValidator, 4)
+		trust   = tmmath.Fraction{Numerator: 1, Denominator: 3}
+	)
+	for i := range vals {
+		pv := NewMockPVWithParams(bls12381.GenPrivKey(), false, false)
+		vals[i] = NewValidator(pv.GetPubKey(), 10)
+		pvs[string(vals[i].Address)] = pv
+	}
+	vset := NewValidatorSet(vals)
+	voteSet := NewVoteSet(chainID, height, 0, PrecommitType, vset)
+	// The last validator doesn't sign.
+	privVals := make([]PrivValidator, 3)
+	for i := range privVals {
+		privVals[i] = pvs[string(vset.Validators[i].Address)]
+	}
+	commit, err := MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	require.NoError(t, vset.VerifyCommit(chainID, blockID, height, commit))
+
+	aggCommit, err := voteSet.MakeAggregatedCommit()
+	require.NoError(t, err)
+	require.NoError(t, aggCommit.ValidateBasic())
+	assert.Empty(t, aggCommit.Signatures)
+	assert.Equal(t, vset.Size(), aggCommit.Size())
+	assert.Equal(t, BlockIDFlagAbsent, aggCommit.BlockIDFlag(3))
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+	assert.NoError(t, vset.VerifyCommit(chainID, blockID, height, aggCommit))
+	assert.NoError(t, vset.VerifyFutureCommit(vset, chainID, blockID, height, aggCommit))
+
+	// The aggregated signature covers all the signers.
+	tampered := *aggCommit
+	tampered.Signers = aggCommit.Signers.Copy()
+	tampered.Signers.SetIndex(3, true)
+	assert.Error(t, vset.VerifyCommit(chainID, blockID, height, &tampered))
+	tampered.Signers.SetIndex(3, false)
+	tampered.Signers.SetIndex(0, false)
+	assert.Error(t, vset.VerifyCommit(chainID, blockID, height, &tampered))
+	tampered.Round = 1
+	tampered.Signers = aggCommit.Signers
+	assert.Error(t, vset.VerifyCommit(chainID, blockID, height, &tampered))
+
+	// The signers are only known by their index in the set of the commit,
+	// which is needed to check the commit against another set.
+	assert.Error(t, vset.VerifyCommitTrusting(chainID, blockID, height, aggCommit, trust))
+	assert.NoError(t, vset.VerifyCommitTrusting(chainID, blockID, height, commit, trust))
+	assert.NoError(t, vset.VerifyAggregatedCommitTrusting(chainID, blockID, height, aggCommit, vset, trust))
+	assert.Error(t, vset.VerifyAggregatedCommitTrusting(chainID, blockID, height, commit, vset, trust))
+	assert.Error(t, vset.VerifyAggregatedCommitTrusting(chainID, blockID, height, aggCommit,
+		NewValidatorSet(vset.Validators[:3]), trust))
+	assert.Error(t, vset.VerifyAggregatedCommitTrusting(chainID, blockID, height, &tampered, vset, trust))
+
+	// Only the signers with the same key in the trusted set are tallied.
+	signer, nonSigner := vset.Validators[0].Copy(), vset.Validators[3].Copy()
+	assert.NoError(t, NewValidatorSet([]*Validator{signer}).VerifyAggregatedCommitTrusting(
+		chainID, blockID, height, aggCommit, vset, trust))
+	err = NewValidatorSet([]*Validator{nonSigner}).VerifyAggregatedCommitTrusting(
+		chainID, blockID, height, aggCommit, vset, trust)
+	assert.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+	signer.PubKey = bls12381.GenPrivKey().PubKey()
+	err = NewValidatorSet([]*Validator{signer}).VerifyAggregatedCommitTrusting(
+		chainID, blockID, height, aggCommit, vset, trust)
+	assert.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+
+	// Aggregated commits carry no individual signatures.
+	tampered = *aggCommit
+	tampered.Signatures = commit.Signatures
+	assert.Error(t, tampered.ValidateBasic())
+
+	// Only BLS12-381 signatures can be aggregated.
+	voteSet, _, privVals = randVoteSet(height, 0, PrecommitType, 4, 10)
+	_, err = MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	_, err = voteSet.MakeAggregatedCommit()
+	assert.Error(t, err)
+}
+
 func TestEmptySet(t *testing.T) {
 
 	var valList []*Validator
@@ -1395,3 +1479,44 @@ func BenchmarkUpdates(b *testing.B) {
 		assert.NoError(b, valSetCopy.UpdateWithChangeSet(newValList))
 	}
 }
+
+func TestValidatorSetRotateKey(t *testing.T) {
+	val1, val2 := NewValidator(randPubKey(), 10), NewValidator(randPubKey(), 20)
+	vals := NewValidatorSet([]*Validator{val1, val2})
+	original := vals.Copy()
+	newKey := randPubKey()
+
+	// The new key must be unused, and the validator must exist.
+	assert.Error(t, vals.RotateKey(val1.Address, val2.PubKey))
+	assert.Error(t, vals.RotateKey(randPubKey().Address(), newKey))
+	require.NoError(t, vals.RotateKey(val1.Address, newKey))
+
+	_, rotated := vals.GetByAddress(val1.Address)
+	require.NotNil(t, rotated)
+	assert.True(t, newKey.Equals(rotated.PubKey))
+	assert.True(t, rotated.KeyRotated())
+	assert.EqualValues(t, 10, rotated.VotingPower)
+	assert.False(t, vals.HasAddress(newKey.Address()))
+	assert.NotEqual(t, original.Hash(), vals.Hash())
+
+	// The copy is unchanged.
+	_, val := original.GetByAddress(val1.Address)
+	assert.True(t, val1.PubKey.Equals(val.PubKey))
+
+	// The key can't be rotated back to the key of the address.
+	assert.Error(t, vals.RotateKey(val1.Address, val1.PubKey))
+
+	// Updates from the app refer to the validator by either key.
+	require.NoError(t, vals.UpdateWithChangeSet([]*Validator{NewValidator(newKey, 15)}))
+	_, val = vals.GetByAddress(val1.Address)
+	assert.EqualValues(t, 15, val.VotingPower)
+	assert.True(t, newKey.Equals(val.PubKey))
+	require.NoError(t, vals.UpdateWithChangeSet([]*Validator{NewValidator(val1.PubKey, 5)}))
+	_, val = vals.GetByAddress(val1.Address)
+	assert.EqualValues(t, 5, val.VotingPower)
+	assert.True(t, newKey.Equals(val.PubKey))
+	assert.Equal(t, 2, vals.Size())
+
+	require.NoError(t, vals.UpdateWithChangeSet([]*Validator{NewValidator(newKey, 0)}))
+	assert.False(t, vals.HasAddress(val1.Address))
+}
@@ -12,18 +12,25 @@ import (
 
 const (
 	// MaxVoteBytes is a maximum vote size (including amino overhead).
+	// NOTE: it does not account for vote extensions and aggregation
+	// signatures, which are not part of the commit.
 	MaxVoteBytes int64  = 223
 	nilVoteStr   string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension.
+	MaxVoteExtensionSize int = 16384
 )
 
 var (
-	ErrVoteUnexpectedStep            = errors.New("unexpected step")
-	ErrVoteInvalidValidatorIndex     = errors.New("invalid validator index")
-	ErrVoteInvalidValidatorAddress   = errors.New("invalid validator address")
-	ErrVoteInvalidSignature          = errors.New("invalid signature")
-	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
-	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
-	ErrVoteNil                       = errors.New("nil vote")
+	ErrVoteUnexpectedStep              = errors.New("unexpected step")
+	ErrVoteInvalidValidatorIndex       = errors.New("invalid validator index")
+	ErrVoteInvalidValidatorAddress     = errors.New("invalid validator address")
+	ErrVoteInvalidSignature            = errors.New("invalid signature")
+	ErrVoteInvalidExtensionSignature   = errors.New("invalid vote extension signature")
+	ErrVoteInvalidAggregationSignature = errors.New("invalid aggregation signature")
+	ErrVoteInvalidBlockHash            = errors.New("invalid block hash")
+	ErrVoteNonDeterministicSignature   = errors.New("non-deterministic signature")
+	ErrVoteNil                         = errors.New("nil vote")
 )
 
 type ErrVoteConflictingVotes struct {
@@ -45,15 +52,26 @@ type Address = crypto.Address
 
 // Vote represents a prevote, precommit, or commit vote from validators for
 // consensus.
+//
+// A precommit for a block may carry an Extension with application data. It is
+// signed separately (ExtensionSignature), so the commit, which only keeps
+// Signature, does not depend on it.
+//
+// The precommit for a block of a BLS12-381 validator also carries an
+// AggregationSignature, over a message without the timestamp, which is the
+// same for all the validators: see Commit.Aggregated.
 type Vote struct {
-	Type             SignedMsgType `json:"type"`
-	Height           int64         `json:"height"`
-	Round            int           `json:"round"`
-	BlockID          BlockID       `json:"block_id"` // zero if vote is nil.
-	Timestamp        time.Time     `json:"timestamp"`
-	ValidatorAddress Address       `json:"validator_address"`
-	ValidatorIndex   int           `json:"validator_index"`
-	Signature        []byte        `json:"signature"`
+	Type                 SignedMsgType `json:"type"`
+	Height               int64         `json:"height"`
+	Round                int           `json:"round"`
+	BlockID              BlockID       `json:"block_id"` // zero if vote is nil.
+	Timestamp            time.Time     `json:"timestamp"`
+	ValidatorAddress     Address       `json:"validator_address"`
+	ValidatorIndex       int           `json:"validator_index"`
+	Signature            []byte        `json:"signature"`
+	Extension            []byte        `json:"extension"`
+	ExtensionSignature   []byte        `json:"extension_signature"`
+	AggregationSignature []byte        `json:"aggregation_signature"`
 }
 
 // CommitSig converts the Vote to a CommitSig.
@@ -88,6 +106,31 @@ func (vote *Vote) SignBytes(chainID string) []byte {
 	return bz
 }
 
+// ExtensionSignBytes returns the bytes signed over by ExtensionSignature.
+func (vote *Vote) ExtensionSignBytes(chainID string) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(CanonicalizeVoteExtension(chainID, vote))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// AggregationSignBytes returns the bytes signed over by AggregationSignature.
+func (vote *Vote) AggregationSignBytes(chainID string) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(
+		CanonicalizeAggregatePrecommit(chainID, vote.Height, vote.Round, vote.BlockID))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// IsExtendable returns true if the vote can carry an extension, ie. it is a
+// precommit for a block.
+func (vote *Vote) IsExtendable() bool {
+	return vote.Type == PrecommitType && !vote.BlockID.IsZero()
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
@@ -122,7 +165,17 @@ func (vote *Vote) String() string {
 }
 
 func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
-	if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
+	return vote.verify(chainID, pubKey.Address(), pubKey)
+}
+
+// VerifyValidator is like Verify, but for a vote of val, whose address is not
+// the address of its key if it rotated it. See KeyRotation.
+func (vote *Vote) VerifyValidator(chainID string, val *Validator) error {
+	return vote.verify(chainID, val.Address, val.PubKey)
+}
+
+func (vote *Vote) verify(chainID string, addr Address, pubKey crypto.PubKey) error {
+	if !bytes.Equal(addr, vote.ValidatorAddress) {
 		return ErrVoteInvalidValidatorAddress
 	}
 
@@ -132,6 +185,42 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyExtension checks the extension signature. It does not check the vote
+// signature itself, see Verify.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	return vote.verifyExtension(chainID, pubKey.Address(), pubKey)
+}
+
+// VerifyValidatorExtension is like VerifyExtension, but for a vote of val.
+// See VerifyValidator.
+func (vote *Vote) VerifyValidatorExtension(chainID string, val *Validator) error {
+	return vote.verifyExtension(chainID, val.Address, val.PubKey)
+}
+
+func (vote *Vote) verifyExtension(chainID string, addr Address, pubKey crypto.PubKey) error {
+	if !bytes.Equal(addr, vote.ValidatorAddress) {
+		return ErrVoteInvalidValidatorAddress
+	}
+
+	if !pubKey.VerifyBytes(vote.ExtensionSignBytes(chainID), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
+// VerifyValidatorAggregation checks the aggregation signature of a vote of
+// val. It does not check the vote signature itself, see VerifyValidator.
+func (vote *Vote) VerifyValidatorAggregation(chainID string, val *Validator) error {
+	if !bytes.Equal(val.Address, vote.ValidatorAddress) {
+		return ErrVoteInvalidValidatorAddress
+	}
+
+	if !val.PubKey.VerifyBytes(vote.AggregationSignBytes(chainID), vote.AggregationSignature) {
+		return ErrVoteInvalidAggregationSignature
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -169,5 +258,27 @@ func (vote *Vote) ValidateBasic() error {
 	if len(vote.Signature) > MaxSignatureSize {
 		return fmt.Errorf("signature is too big (max: %d)", MaxSignatureSize)
 	}
+
+	if !vote.IsExtendable() {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return errors.New("only precommits for a block can have an extension")
+		}
+		if len(vote.AggregationSignature) > 0 {
+			return errors.New("only precommits for a block can have an aggregation signature")
+		}
+		return nil
+	}
+	if len(vote.AggregationSignature) > MaxSignatureSize {
+		return fmt.Errorf("aggregation signature is too big (max: %d)", MaxSignatureSize)
+	}
+	if len(vote.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("extension is too big (max: %d)", MaxVoteExtensionSize)
+	}
+	if len(vote.Extension) > 0 && len(vote.ExtensionSignature) == 0 {
+		return errors.New("extension signature is missing")
+	}
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("extension signature is too big (max: %d)", MaxSignatureSize)
+	}
 	return nil
 }
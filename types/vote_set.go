@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/libs/bits"
 )
 
@@ -198,10 +199,27 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	}
 
 	// Check signature.
-	if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+	if err := vote.VerifyValidator(voteSet.chainID, val); err != nil {
 		return false, errors.Wrapf(err, "Failed to verify vote with ChainID %s and PubKey %s", voteSet.chainID, val.PubKey)
 	}
 
+	// Check the extension signature, if any. Votes rebuilt from a commit don't
+	// have one.
+	if len(vote.ExtensionSignature) > 0 {
+		if err := vote.VerifyValidatorExtension(voteSet.chainID, val); err != nil {
+			return false, errors.Wrapf(err, "Failed to verify vote extension with ChainID %s and PubKey %s",
+				voteSet.chainID, val.PubKey)
+		}
+	}
+
+	// Check the aggregation signature, if any.
+	if len(vote.AggregationSignature) > 0 {
+		if err := vote.VerifyValidatorAggregation(voteSet.chainID, val); err != nil {
+			return false, errors.Wrapf(err, "Failed to verify aggregation signature with ChainID %s and PubKey %s",
+				voteSet.chainID, val.PubKey)
+		}
+	}
+
 	// Add vote and get conflicting vote if any.
 	added, conflicting := voteSet.addVerifiedVote(vote, blockKey, val.VotingPower)
 	if conflicting != nil {
@@ -571,6 +589,98 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	return NewCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, commitSigs)
 }
 
+// MakeExtendedCommit is MakeCommit, along with the extensions of the
+// precommits for the +2/3 majority block.
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeExtendedCommit() *ExtendedCommit {
+	commit := voteSet.MakeCommit()
+
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	extCommit := &ExtendedCommit{
+		Commit:                commit,
+		Extensions:            make([][]byte, len(voteSet.votes)),
+		ExtensionSignatures:   make([][]byte, len(voteSet.votes)),
+		AggregationSignatures: make([][]byte, len(voteSet.votes)),
+	}
+	for i, v := range voteSet.votes {
+		if v != nil && v.BlockID.Equals(*voteSet.maj23) {
+			extCommit.Extensions[i] = v.Extension
+			extCommit.ExtensionSignatures[i] = v.ExtensionSignature
+			extCommit.AggregationSignatures[i] = v.AggregationSignature
+		}
+	}
+	return extCommit
+}
+
+// MakeAggregatedCommit constructs an aggregated Commit from the precommits for
+// the +2/3 majority block, see Commit.Aggregated. The other precommits are
+// left out.
+// It fails if one of them has no aggregation signature, e.g. if its validator
+// doesn't have a BLS12-381 key, in which case MakeCommit must be used.
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeAggregatedCommit() (*Commit, error) {
+	if voteSet.signedMsgType != PrecommitType {
+		panic("Cannot MakeAggregatedCommit() unless VoteSet.Type is PrecommitType")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	// Make sure we have a 2/3 majority
+	if voteSet.maj23 == nil {
+		panic("Cannot MakeAggregatedCommit() unless a blockhash has +2/3")
+	}
+
+	var (
+		signers = bits.NewBitArray(len(voteSet.votes))
+		sigs    = make([][]byte, 0, len(voteSet.votes))
+	)
+	for i, v := range voteSet.votes {
+		if v == nil || !v.BlockID.Equals(*voteSet.maj23) {
+			continue
+		}
+		if len(v.AggregationSignature) == 0 {
+			return nil, fmt.Errorf("precommit #%d has no aggregation signature", i)
+		}
+		signers.SetIndex(i, true)
+		sigs = append(sigs, v.AggregationSignature)
+	}
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAggregatedCommit(voteSet.GetHeight(), voteSet.GetRound(), *voteSet.maj23, signers, aggSig), nil
+}
+
+// VoteExtensions returns the extensions of the precommits for the +2/3
+// majority block, indexed by validator index. Validators whose precommit is
+// missing, is for another block or has no extension have a nil entry.
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) VoteExtensions() [][]byte {
+	if voteSet.signedMsgType != PrecommitType {
+		panic("Cannot get VoteExtensions() unless VoteSet.Type is PrecommitType")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	if voteSet.maj23 == nil {
+		panic("Cannot get VoteExtensions() unless a blockhash has +2/3")
+	}
+
+	exts := make([][]byte, len(voteSet.votes))
+	for i, v := range voteSet.votes {
+		if v != nil && v.BlockID.Equals(*voteSet.maj23) {
+			exts[i] = v.Extension
+		}
+	}
+	return exts
+}
+
 //--------------------------------------------------------------------------------
 
 /*
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
@@ -546,3 +548,121 @@ func TestMakeCommit(t *testing.T) {
 		t.Errorf("error in Commit.ValidateBasic(): %v", err)
 	}
 }
+
+func TestVoteExtensions(t *testing.T) {
+	height, round := int64(1), 0
+	voteSet, _, privValidators := randVoteSet(height, round, PrecommitType, 4, 1)
+	blockHash, blockPartsHeader := crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             PrecommitType,
+		BlockID:          BlockID{blockHash, blockPartsHeader},
+	}
+
+	// 3 out of 4 voted for the block, with an extension.
+	for i := 0; i < 3; i++ {
+		addr := privValidators[i].GetPubKey().Address()
+		vote := withValidator(voteProto, addr, i)
+		vote.Extension = []byte{byte(i)}
+		_, err := signAddVote(privValidators[i], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	// The 4th voted for nil.
+	{
+		addr := privValidators[3].GetPubKey().Address()
+		vote := withValidator(voteProto, addr, 3)
+		vote.BlockID = BlockID{}
+		_, err := signAddVote(privValidators[3], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, [][]byte{{0}, {1}, {2}, nil}, voteSet.VoteExtensions())
+
+	// the extended commit keeps the extensions, and the votes rebuilt from it
+	// are accepted again
+	extCommit := voteSet.MakeExtendedCommit()
+	assert.Equal(t, [][]byte{{0}, {1}, {2}, nil}, extCommit.Extensions)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, voteSet.GetByIndex(i), extCommit.GetByIndex(i))
+	}
+	voteSet2 := ExtendedCommitToVoteSet(voteSet.ChainID(), extCommit, voteSet.valSet)
+	assert.Equal(t, voteSet.VoteExtensions(), voteSet2.VoteExtensions())
+
+	// a vote with a tampered extension is rejected
+	voteSet, _, privValidators = randVoteSet(height, round, PrecommitType, 1, 1)
+	vote := withValidator(voteProto, privValidators[0].GetPubKey().Address(), 0)
+	vote.Extension = []byte("extension")
+	err := privValidators[0].SignVote(voteSet.ChainID(), vote)
+	require.NoError(t, err)
+	vote.Extension = []byte("tampered")
+	added, err := voteSet.AddVote(vote)
+	assert.False(t, added)
+	assert.Error(t, err)
+}
+
+func TestMakeAggregatedCommit(t *testing.T) {
+	height, round := int64(1), 0
+	pvs := make(map[string]PrivValidator, 4)
+	vals := make([]*Validator, 4)
+	for i := range vals {
+		pv := NewMockPVWithParams(bls12381.GenPrivKey(), false, false)
+		vals[i] = NewValidator(pv.GetPubKey(), 1)
+		pvs[string(vals[i].Address)] = pv
+	}
+	valSet := NewValidatorSet(vals)
+	voteSet := NewVoteSet("test_chain_id", height, round, PrecommitType, valSet)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             PrecommitType,
+		BlockID:          blockID,
+	}
+
+	// 3 out of 4 voted for the block, the 4th voted for nil.
+	for i, val := range valSet.Validators {
+		vote := withValidator(voteProto, val.Address, i)
+		if i == 3 {
+			vote.BlockID = BlockID{}
+		}
+		_, err := signAddVote(pvs[string(val.Address)], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	aggCommit, err := voteSet.MakeAggregatedCommit()
+	require.NoError(t, err)
+	assert.Equal(t, "BA{4:xxx_}", aggCommit.Signers.String())
+	assert.NoError(t, valSet.VerifyCommit(voteSet.ChainID(), blockID, height, aggCommit))
+
+	// the extended commit keeps the aggregation signatures
+	voteSet2 := ExtendedCommitToVoteSet(voteSet.ChainID(), voteSet.MakeExtendedCommit(), valSet)
+	aggCommit2, err := voteSet2.MakeAggregatedCommit()
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.AggregatedSignature, aggCommit2.AggregatedSignature)
+
+	// the precommits rebuilt from a commit have no aggregation signature
+	voteSet3 := CommitToVoteSet(voteSet.ChainID(), voteSet.MakeCommit(), valSet)
+	_, err = voteSet3.MakeAggregatedCommit()
+	assert.Error(t, err)
+
+	// a vote with a wrong aggregation signature is rejected
+	voteSet = NewVoteSet("test_chain_id", height, round, PrecommitType, valSet)
+	val := valSet.Validators[0]
+	vote := withValidator(voteProto, val.Address, 0)
+	err = pvs[string(val.Address)].SignVote(voteSet.ChainID(), vote)
+	require.NoError(t, err)
+	vote.AggregationSignature = vote.Signature
+	added, err := voteSet.AddVote(vote)
+	assert.False(t, added)
+	assert.Error(t, err)
+}
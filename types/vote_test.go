@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
@@ -209,6 +210,60 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVoteVerifyExtension(t *testing.T) {
+	privVal := NewMockPV()
+	pubkey := privVal.GetPubKey()
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	err := privVal.SignVote("test_chain_id", vote)
+	require.NoError(t, err)
+
+	assert.NoError(t, vote.VerifyExtension("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidValidatorAddress, vote.VerifyExtension("test_chain_id", ed25519.GenPrivKey().PubKey()))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("other_chain_id", pubkey))
+
+	// the extension is not covered by the vote signature
+	vote.Extension = []byte("tampered")
+	assert.NoError(t, vote.Verify("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+
+	// the extension can't be replayed onto a precommit for another block
+	vote.Extension = []byte("extension")
+	vote.BlockID.Hash = tmhash.Sum([]byte("other_block"))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+}
+
+func TestVoteVerifyAggregation(t *testing.T) {
+	privVal := NewMockPVWithParams(bls12381.GenPrivKey(), false, false)
+	val := NewValidator(privVal.GetPubKey(), 10)
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = val.Address
+	err := privVal.SignVote("test_chain_id", vote)
+	require.NoError(t, err)
+	require.NotEmpty(t, vote.AggregationSignature)
+	assert.NoError(t, vote.VerifyValidatorAggregation("test_chain_id", val))
+	assert.Equal(t, ErrVoteInvalidAggregationSignature, vote.VerifyValidatorAggregation("other_chain_id", val))
+
+	// the signed message is the same for all the precommits for the block
+	other := examplePrecommit()
+	other.ValidatorAddress = val.Address
+	other.Timestamp = other.Timestamp.Add(time.Second)
+	assert.Equal(t, vote.AggregationSignBytes("test_chain_id"), other.AggregationSignBytes("test_chain_id"))
+	assert.NotEqual(t, vote.SignBytes("test_chain_id"), vote.AggregationSignBytes("test_chain_id"))
+
+	// but not for another block
+	vote.BlockID.Hash = tmhash.Sum([]byte("other_block"))
+	assert.Equal(t, ErrVoteInvalidAggregationSignature, vote.VerifyValidatorAggregation("test_chain_id", val))
+
+	// only BLS12-381 keys sign for aggregation
+	vote = examplePrecommit()
+	require.NoError(t, NewMockPV().SignVote("test_chain_id", vote))
+	assert.Empty(t, vote.AggregationSignature)
+}
+
 func TestMaxVoteBytes(t *testing.T) {
 	// time is varint encoded so need to pick the max.
 	// year int, month Month, day, hour, min, sec, nsec int, loc *Location
@@ -272,6 +327,27 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension", func(v *Vote) { v.Extension = []byte("extension") }, false},
+		{"Too big Extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionSize+1) }, true},
+		{"Extension without signature", func(v *Vote) {
+			v.Extension = []byte("extension")
+			v.ExtensionSignature = nil
+		}, true},
+		{"Extension on nil precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension = []byte("extension")
+		}, true},
+		{"Extension on prevote", func(v *Vote) {
+			v.Type = PrevoteType
+			v.Extension = []byte("extension")
+		}, true},
+		{"Aggregation signature on nil precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.AggregationSignature = []byte("signature")
+		}, true},
+		{"Too big aggregation signature", func(v *Vote) {
+			v.AggregationSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc